@@ -442,38 +442,55 @@ func (s *SpecInfoGatherer) handleEvent(event fsnotify.Event, watcher *fsnotify.W
 func (s *SpecInfoGatherer) watchForFileChanges() {
 	s.waitGroup.Add(1)
 
-	watcher, err := fsnotify.NewWatcher()
+	var allDirsToWatch []string
+	var specDir string
+
+	for _, dir := range s.SpecDirs {
+		specDir = filepath.Join(config.ProjectRoot, dir)
+		allDirsToWatch = append(allDirsToWatch, specDir)
+		allDirsToWatch = append(allDirsToWatch, util.FindAllNestedDirs(specDir)...)
+	}
+
+	watcher, err := WatchDirs(allDirsToWatch, s.handleEvent)
 	if err != nil {
 		logger.Errorf(false, "Error creating fileWatcher: %s", err)
+		s.waitGroup.Done()
+		return
 	}
 	defer watcher.Close()
 
 	done := make(chan bool)
+	s.waitGroup.Done()
+	<-done
+}
+
+// WatchDirs creates a file watcher on the given directories and calls handle for every file change in them.
+// Events are handled till the returned watcher is closed.
+func WatchDirs(dirs []string, handle func(fsnotify.Event, *fsnotify.Watcher)) (*fsnotify.Watcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	for _, dir := range dirs {
+		addDirToFileWatcher(watcher, dir)
+	}
 	go func() {
 		for {
 			select {
-			case event := <-watcher.Events:
-				s.handleEvent(event, watcher)
-			case err := <-watcher.Errors:
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				handle(event, watcher)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
 				logger.Errorf(false, "Error event while watching specs %s", err)
 			}
 		}
 	}()
-
-	var allDirsToWatch []string
-	var specDir string
-
-	for _, dir := range s.SpecDirs {
-		specDir = filepath.Join(config.ProjectRoot, dir)
-		allDirsToWatch = append(allDirsToWatch, specDir)
-		allDirsToWatch = append(allDirsToWatch, util.FindAllNestedDirs(specDir)...)
-	}
-
-	for _, dir := range allDirsToWatch {
-		addDirToFileWatcher(watcher, dir)
-	}
-	s.waitGroup.Done()
-	<-done
+	return watcher, nil
 }
 
 // GetAvailableSpecs returns the list of all the specs in the gauge project
@@ -41,6 +41,8 @@ const (
 	runInParallelCodeLens = "Run in parallel"
 	runScenarioCodeLens   = "Run Scenario"
 	debugScenarioCodeLens = "Debug Scenario"
+	runRowCodeLens        = "Run Row"
+	debugRowCodeLens      = "Debug Row"
 	referenceCodeLens     = "%s reference(s)"
 )
 
@@ -141,6 +143,21 @@ func getScenarioCodeLenses(spec *gauge.Specification) []lsp.CodeLens {
 			debugCodeLens := createCodeLens(sce.Heading.LineNo-1, debugScenarioCodeLens, debugCommand, args)
 			lenses = append(lenses, debugCodeLens)
 		}
+		lenses = append(lenses, getScenarioDataTableLenses(spec.FileName, sce)...)
+	}
+	return lenses
+}
+
+// getScenarioDataTableLenses gives the lenses to execute the scenario for a row of its data table, gauge executes only
+// that row when the line of the row is given.
+func getScenarioDataTableLenses(file string, sce *gauge.Scenario) []lsp.CodeLens {
+	var lenses []lsp.CodeLens
+	for _, lineNo := range sce.DataTable.RowLineNos {
+		args := getExecutionArgs(fmt.Sprintf("%s:%d", file, lineNo))
+		lenses = append(lenses, createCodeLens(lineNo-1, runRowCodeLens, executeCommand, args))
+		if lRunner.lspID != "" {
+			lenses = append(lenses, createCodeLens(lineNo-1, debugRowCodeLens, debugCommand, args))
+		}
 	}
 	return lenses
 }
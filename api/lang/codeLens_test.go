@@ -356,3 +356,41 @@ Scenario Heading
 		t.Errorf("want: `%v`,\n got: `%v`", want, got)
 	}
 }
+
+func TestGetCodeLensWithScenarioDataTable(t *testing.T) {
+	specText := `Specification Heading
+=====================
+
+Scenario Heading
+----------------
+
+   |id|
+   |--|
+   |1 |
+   |2 |
+
+* Step text <id>
+`
+
+	lRunner.lspID = ""
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", specText)
+
+	b, _ := json.Marshal(lsp.CodeLensParams{TextDocument: lsp.TextDocumentIdentifier{URI: "foo.spec"}})
+	p := json.RawMessage(b)
+	got, err := codeLenses(&jsonrpc2.Request{Params: &p})
+	if err != nil {
+		t.Errorf("Expected error to be nil. got : %s", err.Error())
+	}
+
+	want := []lsp.CodeLens{
+		createCodeLens(3, runScenarioCodeLens, executeCommand, getExecutionArgs("foo.spec:4")),
+		createCodeLens(8, runRowCodeLens, executeCommand, getExecutionArgs("foo.spec:9")),
+		createCodeLens(9, runRowCodeLens, executeCommand, getExecutionArgs("foo.spec:10")),
+		createCodeLens(0, runSpecCodeLens, executeCommand, getExecutionArgs("foo.spec")),
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	"github.com/getgauge/gauge/gauge"
+	gm "github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/parser"
 	"github.com/sourcegraph/go-langserver/pkg/lsp"
 )
@@ -36,9 +37,10 @@ func stepCompletion(line, pLine string, params lsp.TextDocumentPositionParams) (
 		return nil, err
 	}
 	for _, c := range provider.Concepts() {
+		label, params := withParamDefaults(c.StepValue, provider.SearchConceptDictionary(c.StepValue.StepValue))
 		fText := prefix + getStepFilterText(c.StepValue.StepValue, c.StepValue.Parameters, givenArgs)
-		cText := prefix + addPlaceHolders(c.StepValue.StepValue, c.StepValue.Parameters)
-		list.Items = append(list.Items, newStepCompletionItem(c.StepValue.ParameterizedStepValue, cText, concept, fText, editRange))
+		cText := prefix + addPlaceHolders(c.StepValue.StepValue, params)
+		list.Items = append(list.Items, newStepCompletionItem(label, cText, concept, fText, editRange))
 	}
 	s, err := allImplementedStepValues()
 	allSteps := append(allUsedStepValues(), s...)
@@ -50,6 +52,28 @@ func stepCompletion(line, pLine string, params lsp.TextDocumentPositionParams) (
 	return list, err
 }
 
+// withParamDefaults returns the label and the snippet placeholders of a concept, showing the default values of its parameters.
+func withParamDefaults(sv *gm.ProtoStepValue, cpt *gauge.Concept) (string, []string) {
+	if cpt == nil {
+		return sv.ParameterizedStepValue, sv.Parameters
+	}
+	label := sv.StepValue
+	params := append([]string{}, sv.Parameters...)
+	hasDefaults := false
+	for i, p := range sv.Parameters {
+		if i < len(cpt.ConceptStep.Args) && cpt.ConceptStep.Args[i].Default != nil {
+			params[i] = *cpt.ConceptStep.Args[i].Default
+			p = fmt.Sprintf("%s = \"%s\"", p, params[i])
+			hasDefaults = true
+		}
+		label = strings.Replace(label, "{}", fmt.Sprintf("<%s>", p), 1)
+	}
+	if !hasDefaults {
+		return sv.ParameterizedStepValue, sv.Parameters
+	}
+	return label, params
+}
+
 func removeDuplicates(steps []gauge.StepValue) []gauge.StepValue {
 	encountered := map[string]bool{}
 	result := []gauge.StepValue{}
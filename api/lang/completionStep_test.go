@@ -225,3 +225,23 @@ func contains(list []gauge.StepValue, v gauge.StepValue) bool {
 	}
 	return false
 }
+
+func TestWithParamDefaultsShowsDefaultValuesOfConceptParams(t *testing.T) {
+	password := "secret"
+	sv := &gauge_messages.ProtoStepValue{StepValue: "login as {} with {}", ParameterizedStepValue: "login as <user> with <password>", Parameters: []string{"user", "password"}}
+	cpt := &gauge.Concept{ConceptStep: &gauge.Step{Value: "login as {} with {}", Args: []*gauge.StepArg{
+		{Name: "user", Value: "user", ArgType: gauge.Dynamic},
+		{Name: "password", Value: "password", ArgType: gauge.Dynamic, Default: &password},
+	}}}
+
+	label, params := withParamDefaults(sv, cpt)
+
+	wantLabel := `login as <user> with <password = "secret">`
+	if label != wantLabel {
+		t.Errorf("want: `%s`,\n got: `%s`", wantLabel, label)
+	}
+	want := `login as "${1:user}" with "${0:secret}"`
+	if got := addPlaceHolders(sv.StepValue, params); got != want {
+		t.Errorf("want: `%s`,\n got: `%s`", want, got)
+	}
+}
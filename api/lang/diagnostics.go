@@ -67,13 +67,17 @@ func publishDiagnostic(uri lsp.DocumentURI, diagnostics []lsp.Diagnostic, conn j
 
 func getDiagnostics() (map[lsp.DocumentURI][]lsp.Diagnostic, error) {
 	diagnostics := make(map[lsp.DocumentURI][]lsp.Diagnostic, 0)
-	conceptDictionary, err := validateConcepts(diagnostics)
+	conceptDictionary, conceptsOk, err := validateConcepts(diagnostics)
 	if err != nil {
 		return nil, err
 	}
-	if err = validateSpecs(conceptDictionary, diagnostics); err != nil {
+	specs, specsOk, err := validateSpecs(conceptDictionary, diagnostics)
+	if err != nil {
 		return nil, err
 	}
+	if conceptsOk && specsOk {
+		createUnusedDiagnostics(specs, conceptDictionary, diagnostics)
+	}
 	return diagnostics, nil
 }
 
@@ -97,9 +101,10 @@ func validateSpecifications(specs []*gauge.Specification, conceptDictionary *gau
 	return validation.FilterDuplicates(vErrs)
 }
 
-func validateSpecs(conceptDictionary *gauge.ConceptDictionary, diagnostics map[lsp.DocumentURI][]lsp.Diagnostic) error {
+func validateSpecs(conceptDictionary *gauge.ConceptDictionary, diagnostics map[lsp.DocumentURI][]lsp.Diagnostic) ([]*gauge.Specification, bool, error) {
 	specFiles := util.GetSpecFiles(util.GetSpecDirs())
 	specs := make([]*gauge.Specification, 0)
+	parseOk := true
 	for _, specFile := range specFiles {
 		uri := util.ConvertPathToURI(specFile)
 		if _, ok := diagnostics[uri]; !ok {
@@ -107,24 +112,122 @@ func validateSpecs(conceptDictionary *gauge.ConceptDictionary, diagnostics map[l
 		}
 		content, err := getContentFromFileOrDisk(specFile)
 		if err != nil {
-			return fmt.Errorf("Unable to read file %s", err)
+			return nil, false, fmt.Errorf("Unable to read file %s", err)
 		}
 		spec, res, err := new(parser.SpecParser).Parse(content, conceptDictionary, specFile)
 		if err != nil {
-			return err
+			return nil, false, err
 		}
 		createDiagnostics(res, diagnostics)
 		if res.Ok {
 			specs = append(specs, spec)
 		}
+		parseOk = parseOk && res.Ok
 	}
 	createValidationDiagnostics(validateSpecifications(specs, conceptDictionary), diagnostics)
+	return specs, parseOk, nil
+}
+
+// createUnusedDiagnostics warns about the concepts and step implementations which are not used by the specs.
+// It should be called only if all specs and concepts are parsed, otherwise used ones could be reported.
+// Step implementations are checked only if the runner is connected.
+func createUnusedDiagnostics(specs []*gauge.Specification, conceptDictionary *gauge.ConceptDictionary, diagnostics map[lsp.DocumentURI][]lsp.Diagnostic) {
+	usedSteps, usedConcepts := validation.UsedSteps(specs)
+	for _, c := range validation.UnusedConcepts(conceptDictionary, usedConcepts) {
+		uri := util.ConvertPathToURI(c.FileName)
+		diagnostics[uri] = append(diagnostics[uri], createDiagnostic(uri, "Concept is not used by any spec", c.ConceptStep.LineNo-1, 2))
+	}
+	if lRunner.runner == nil {
+		return
+	}
+	if err := createUnusedStepDiagnostics(usedSteps, diagnostics); err != nil {
+		logError(nil, "Unable to find unused step implementations, error : %s", err.Error())
+	}
+}
+
+func createUnusedStepDiagnostics(usedSteps map[string]bool, diagnostics map[lsp.DocumentURI][]lsp.Diagnostic) error {
+	steps, err := implementedSteps.stepNames()
+	if err != nil {
+		return err
+	}
+	unused := make(map[string]bool)
+	for _, stepValue := range validation.UnusedStepImplementations(steps, usedSteps) {
+		unused[stepValue.StepValue] = true
+	}
+	if len(unused) == 0 {
+		return nil
+	}
+	positions, err := implementedSteps.stepPositions()
+	if err != nil {
+		return err
+	}
+	for uri, filePositions := range positions {
+		for _, p := range filePositions {
+			if unused[p.GetStepValue()] {
+				diagnostics[uri] = append(diagnostics[uri], createDiagnostic(uri, "Step implementation is not used by any spec", int(p.GetSpan().GetStart())-1, 2))
+			}
+		}
+	}
 	return nil
 }
 
-func validateConcepts(diagnostics map[lsp.DocumentURI][]lsp.Diagnostic) (*gauge.ConceptDictionary, error) {
+// implementedSteps caches the step implementations known to the runner, so that the runner is not asked for them
+// every time diagnostics are published. It is cleared whenever an implementation file changes.
+var implementedSteps = &stepImplementations{}
+
+type stepImplementations struct {
+	sync.Mutex
+	steps     []string
+	positions map[lsp.DocumentURI][]*gm.StepPositionsResponse_StepPosition
+}
+
+func (s *stepImplementations) stepNames() ([]string, error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.steps != nil {
+		return s.steps, nil
+	}
+	res, err := getAllStepsResponse()
+	if err != nil {
+		return nil, err
+	}
+	s.steps = append([]string{}, res.GetSteps()...)
+	return s.steps, nil
+}
+
+func (s *stepImplementations) stepPositions() (map[lsp.DocumentURI][]*gm.StepPositionsResponse_StepPosition, error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.positions != nil {
+		return s.positions, nil
+	}
+	implFiles, err := getImplementationFileList()
+	if err != nil {
+		return nil, err
+	}
+	positions := make(map[lsp.DocumentURI][]*gm.StepPositionsResponse_StepPosition)
+	for _, file := range implFiles.GetImplementationFilePaths() {
+		uri := util.ConvertPathToURI(file)
+		p, err := getStepPositionResponse(uri)
+		if err != nil {
+			return nil, err
+		}
+		positions[uri] = p.GetStepPositions()
+	}
+	s.positions = positions
+	return s.positions, nil
+}
+
+func (s *stepImplementations) clear() {
+	s.Lock()
+	defer s.Unlock()
+	s.steps, s.positions = nil, nil
+}
+
+func validateConcepts(diagnostics map[lsp.DocumentURI][]lsp.Diagnostic) (*gauge.ConceptDictionary, bool, error) {
 	conceptFiles := util.GetConceptFiles()
 	conceptDictionary := gauge.NewConceptDictionary()
+	parseOk := true
 	for _, conceptFile := range conceptFiles {
 		uri := util.ConvertPathToURI(conceptFile)
 		if _, ok := diagnostics[uri]; !ok {
@@ -132,18 +235,20 @@ func validateConcepts(diagnostics map[lsp.DocumentURI][]lsp.Diagnostic) (*gauge.
 		}
 		content, err := getContentFromFileOrDisk(conceptFile)
 		if err != nil {
-			return nil, fmt.Errorf("Unable to read file %s", err)
+			return nil, false, fmt.Errorf("Unable to read file %s", err)
 		}
 		cpts, pRes := new(parser.ConceptParser).Parse(content, conceptFile)
 		pErrs, err := parser.AddConcept(cpts, conceptFile, conceptDictionary)
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 		pRes.ParseErrors = append(pRes.ParseErrors, pErrs...)
 		createDiagnostics(pRes, diagnostics)
+		parseOk = parseOk && len(pRes.ParseErrors) == 0
 	}
-	createDiagnostics(parser.ValidateConcepts(conceptDictionary), diagnostics)
-	return conceptDictionary, nil
+	res := parser.ValidateConcepts(conceptDictionary)
+	createDiagnostics(res, diagnostics)
+	return conceptDictionary, parseOk && len(res.ParseErrors) == 0, nil
 }
 
 func createDiagnostics(res *parser.ParseResult, diagnostics map[lsp.DocumentURI][]lsp.Diagnostic) {
@@ -47,6 +47,7 @@ func setup() {
 	openFilesCache.add(util.ConvertPathToURI(specFile), "")
 	responses := map[gauge_messages.Message_MessageType]interface{}{}
 	responses[gauge_messages.Message_StepValidateResponse] = &gauge_messages.StepValidateResponse{IsValid: true}
+	responses[gauge_messages.Message_StepNamesResponse] = &gauge_messages.StepNamesResponse{}
 	lRunner.runner = &runner.GrpcRunner{Client: &mockLspClient{responses: responses}, Timeout: time.Second * 30}
 
 	util.GetConceptFiles = func() []string {
@@ -135,7 +136,7 @@ func TestParseConcept(t *testing.T) {
 
 	diagnostics := make(map[lsp.DocumentURI][]lsp.Diagnostic, 0)
 
-	dictionary, err := validateConcepts(diagnostics)
+	dictionary, _, err := validateConcepts(diagnostics)
 	if err != nil {
 		t.Errorf("expected no error.\n Got: %s", err.Error())
 	}
@@ -197,6 +198,61 @@ func TestDiagnosticOfConceptsWithCircularReference(t *testing.T) {
 	containsDiagnostics(got, 1, 0, "Circular reference found in concept.", t)
 }
 
+func TestDiagnosticsForUnusedConceptsAndStepImplementations(t *testing.T) {
+	setup()
+	implFile := "step_impl.js"
+	responses := map[gauge_messages.Message_MessageType]interface{}{}
+	responses[gauge_messages.Message_StepValidateResponse] = &gauge_messages.StepValidateResponse{IsValid: true}
+	responses[gauge_messages.Message_StepNamesResponse] = &gauge_messages.StepNamesResponse{Steps: []string{"foo", "say <what>"}}
+	responses[gauge_messages.Message_ImplementationFileListResponse] = &gauge_messages.ImplementationFileListResponse{ImplementationFilePaths: []string{implFile}}
+	responses[gauge_messages.Message_StepPositionsResponse] = &gauge_messages.StepPositionsResponse{StepPositions: []*gauge_messages.StepPositionsResponse_StepPosition{
+		{StepValue: "foo", Span: &gauge_messages.Span{Start: 1}},
+		{StepValue: "say {}", Span: &gauge_messages.Span{Start: 5}},
+	}}
+	lRunner.runner = &runner.GrpcRunner{Client: &mockLspClient{responses: responses}, Timeout: time.Second * 30}
+	implementedSteps.clear()
+	cptText := `# used concept
+* foo
+
+# unused concept
+* foo
+`
+	specText := `Specification Heading
+=====================
+
+Scenario Heading
+----------------
+
+* used concept
+`
+	cptURI := util.ConvertPathToURI(conceptFile)
+	openFilesCache.add(cptURI, cptText)
+	openFilesCache.add(util.ConvertPathToURI(specFile), specText)
+
+	diagnostics, err := getDiagnostics()
+	if err != nil {
+		t.Fatalf("expected no error.\n Got: %s", err.Error())
+	}
+
+	want := []lsp.Diagnostic{{
+		Range:    lsp.Range{Start: lsp.Position{Line: 3, Character: 0}, End: lsp.Position{Line: 3, Character: 16}},
+		Message:  "Concept is not used by any spec",
+		Severity: 2,
+	}}
+	if !reflect.DeepEqual(diagnostics[cptURI], want) {
+		t.Errorf("want: `%+v`,\n got: `%+v`", want, diagnostics[cptURI])
+	}
+	want = []lsp.Diagnostic{{
+		Range:    lsp.Range{Start: lsp.Position{Line: 4, Character: 0}, End: lsp.Position{Line: 4, Character: 10000}},
+		Message:  "Step implementation is not used by any spec",
+		Severity: 2,
+	}}
+	implURI := util.ConvertPathToURI(implFile)
+	if !reflect.DeepEqual(diagnostics[implURI], want) {
+		t.Errorf("want: `%+v`,\n got: `%+v`", want, diagnostics[implURI])
+	}
+}
+
 var containsDiagnostics = func(diagnostics []lsp.Diagnostic, line1, line2 int, startMessage string, t *testing.T) {
 	for _, diagnostic := range diagnostics {
 		if !strings.Contains(diagnostic.Message, startMessage) {
@@ -213,3 +269,25 @@ var containsDiagnostics = func(diagnostics []lsp.Diagnostic, line1, line2 int, s
 		}
 	}
 }
+
+func TestStepImplementationsAreCachedTillAnImplementationFileChanges(t *testing.T) {
+	responses := map[gauge_messages.Message_MessageType]interface{}{}
+	responses[gauge_messages.Message_StepNamesResponse] = &gauge_messages.StepNamesResponse{Steps: []string{"foo"}}
+	lRunner.runner = &runner.GrpcRunner{Client: &mockLspClient{responses: responses}, Timeout: time.Second * 30}
+	defer func() { lRunner.runner = nil }()
+	implementedSteps.clear()
+
+	if steps, err := implementedSteps.stepNames(); err != nil || !reflect.DeepEqual(steps, []string{"foo"}) {
+		t.Fatalf("want: [foo], got: %v, %v", steps, err)
+	}
+	responses[gauge_messages.Message_StepNamesResponse] = &gauge_messages.StepNamesResponse{Steps: []string{"foo", "bar"}}
+	if steps, _ := implementedSteps.stepNames(); !reflect.DeepEqual(steps, []string{"foo"}) {
+		t.Errorf("want cached steps [foo], got: %v", steps)
+	}
+
+	cacheFileOnRunner(util.ConvertPathToURI("step_impl.js"), "", false, gauge_messages.CacheFileRequest_CHANGED)
+
+	if steps, _ := implementedSteps.stepNames(); !reflect.DeepEqual(steps, []string{"foo", "bar"}) {
+		t.Errorf("want steps [foo bar] after the implementation file changed, got: %v", steps)
+	}
+}
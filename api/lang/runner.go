@@ -75,6 +75,7 @@ func cacheFileOnRunner(uri lsp.DocumentURI, text string, isClosed bool, status g
 		},
 	}
 	_, err := lRunner.runner.ExecuteMessageWithTimeout(r)
+	implementedSteps.clear()
 	return err
 }
 
@@ -183,6 +183,8 @@ func initPackageFlags() {
 	reporter.SimpleConsoleOutput = simpleConsole
 	reporter.Verbose = verbose
 	reporter.MachineReadable = machineReadable
+	reporter.ReportFormat = reportFormat
+	reporter.ReportOutput = reportOutput
 	execution.MachineReadable = machineReadable
 	execution.ExecuteTags = tags
 	execution.SetTableRows(rows)
@@ -194,6 +196,7 @@ func initPackageFlags() {
 	execution.Strategy = strategy
 	filter.ExecuteTags = tags
 	order.Sorted = sort
+	order.Order = executionOrder
 	filter.Distribute = group
 	filter.NumberOfExecutionStreams = streams
 	reporter.NumberOfExecutionStreams = streams
@@ -204,6 +207,16 @@ func initPackageFlags() {
 	filter.ScenariosName = scenarios
 	execution.MaxRetriesCount = maxRetriesCount
 	execution.RetryOnlyTags = retryOnlyTags
+	execution.ScenarioTimeout = scenarioTimeout
+	execution.Quarantine = quarantine
+	execution.Watch = watch
+	filter.Shard = shard
+	execution.ShardBy = shardBy
+	execution.StepRetries = stepRetries
+	execution.StepRetryBackoff = stepRetryBackoff
+	execution.ServePort = servePort
+	execution.Profile = profile
+	execution.DryRun = dryRun
 }
 
 var exit = func(err error, additionalText string) {
@@ -19,33 +19,46 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/getgauge/gauge/api"
 	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/docs"
 	"github.com/getgauge/gauge/plugin"
 	"github.com/spf13/cobra"
 )
 
-var docsCmd = &cobra.Command{
-	Use:     "docs [flags] <plugin> [args]",
-	Short:   "Generate documentation using specified plugin",
-	Long:    `Generate documentation using specified plugin.`,
-	Example: "  gauge docs spectacle specs/",
-	Run: func(cmd *cobra.Command, args []string) {
-		loadEnvAndReinitLogger(cmd)
-		if err := config.SetProjectRoot(args); err != nil {
-			exit(err, cmd.UsageString())
-		}
-		if len(args) < 1 {
-			exit(fmt.Errorf("Missing argument <plugin name>."), cmd.UsageString())
-		}
-		specDirs := getSpecsDir(args[1:])
-		gaugeConnectionHandler := api.Start(specDirs)
-		plugin.GenerateDoc(args[0], specDirs, gaugeConnectionHandler.ConnectionPortNumber())
-	},
-	DisableAutoGenTag: true,
-}
+var (
+	docsCmd = &cobra.Command{
+		Use:   "docs [flags] <plugin> [args]",
+		Short: "Generate documentation using specified plugin",
+		Long: `Generate documentation using specified plugin.
+With --html, static HTML documentation of the specs, scenarios, concepts and tags, with a tag index and search, is generated without a plugin.`,
+		Example: "  gauge docs spectacle specs/\n  gauge docs --html docs/ specs/",
+		Run: func(cmd *cobra.Command, args []string) {
+			loadEnvAndReinitLogger(cmd)
+			if err := config.SetProjectRoot(args); err != nil {
+				exit(err, cmd.UsageString())
+			}
+			if htmlDocsDir != "" {
+				if !docs.Generate(getSpecsDir(args), htmlDocsDir) {
+					os.Exit(1)
+				}
+				return
+			}
+			if len(args) < 1 {
+				exit(fmt.Errorf("Missing argument <plugin name>."), cmd.UsageString())
+			}
+			specDirs := getSpecsDir(args[1:])
+			gaugeConnectionHandler := api.Start(specDirs)
+			plugin.GenerateDoc(args[0], specDirs, gaugeConnectionHandler.ConnectionPortNumber())
+		},
+		DisableAutoGenTag: true,
+	}
+	htmlDocsDir string
+)
 
 func init() {
 	GaugeCmd.AddCommand(docsCmd)
+	docsCmd.Flags().StringVarP(&htmlDocsDir, "html", "", "", "Generate static HTML documentation to this directory, without a plugin")
 }
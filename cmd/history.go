@@ -0,0 +1,57 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution"
+	"github.com/spf13/cobra"
+)
+
+var (
+	historyCmd = &cobra.Command{
+		Use:   "history [flags]",
+		Short: "Shows the trends in the results of the last runs",
+		Long: `Shows the pass rate and duration of every specification and scenario over the last runs, the scenarios newly failing and newly fixed in the last run and the steps whose duration grew the most.
+The results of the runs are kept in .gauge/history if save_execution_history is set to true. The runs of the failed scenarios, of a single shard and of watch mode are not kept.`,
+		Example: "  gauge history\n  gauge history --runs 20 --top 5",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := config.SetProjectRoot([]string{}); err != nil {
+				exit(err, cmd.UsageString())
+			}
+			if historyRuns < 1 {
+				exit(fmt.Errorf("invalid input(%d) to --runs flag", historyRuns), cmd.UsageString())
+			}
+			if historyTop < 0 {
+				exit(fmt.Errorf("invalid input(%d) to --top flag", historyTop), cmd.UsageString())
+			}
+			os.Exit(execution.ShowHistory(historyRuns, historyTop))
+		},
+		DisableAutoGenTag: true,
+	}
+	historyRuns int
+	historyTop  int
+)
+
+func init() {
+	GaugeCmd.AddCommand(historyCmd)
+	historyCmd.Flags().IntVarP(&historyRuns, "runs", "", 10, "Number of last runs to show the trends for")
+	historyCmd.Flags().IntVarP(&historyTop, "top", "", 10, "Number of slowest growing steps to show")
+}
@@ -0,0 +1,66 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gherkin"
+	"github.com/spf13/cobra"
+)
+
+var (
+	importCmd = &cobra.Command{
+		Use:     "import [command]",
+		Short:   "Imports specifications written for other tools",
+		Long:    `Imports specifications written for other tools.`,
+		Example: "  gauge import gherkin features/",
+		Run: func(cmd *cobra.Command, args []string) {
+			exit(nil, cmd.UsageString())
+		},
+		DisableAutoGenTag: true,
+	}
+	importGherkinCmd = &cobra.Command{
+		Use:   "gherkin [flags] <dir>",
+		Short: "Converts Gherkin feature files to specs and concepts",
+		Long: `Converts the Gherkin feature files in the given directory to specs and concepts.
+Backgrounds become contexts, Examples of a Scenario Outline become data tables and the Background of a Rule becomes a concept. The constructs which could not be converted are reported.`,
+		Example: "  gauge import gherkin features/\n  gauge import gherkin --out specs/imported features/",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				exit(fmt.Errorf("the directory of the feature files is required"), cmd.UsageString())
+			}
+			loadEnvAndReinitLogger(cmd)
+			if err := config.SetProjectRoot([]string{}); err != nil {
+				exit(err, cmd.UsageString())
+			}
+			if !gherkin.Import(args[0], importOut) {
+				os.Exit(1)
+			}
+		},
+		DisableAutoGenTag: true,
+	}
+	importOut string
+)
+
+func init() {
+	GaugeCmd.AddCommand(importCmd)
+	importCmd.AddCommand(importGherkinCmd)
+	importGherkinCmd.Flags().StringVarP(&importOut, "out", "o", "specs", "Directory to write the specs and concepts to")
+}
@@ -18,48 +18,123 @@
 package cmd
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	supersort "sort"
+	"strconv"
+	"strings"
 
+	"github.com/getgauge/gauge/api"
 	"github.com/getgauge/gauge/filter"
 	"github.com/getgauge/gauge/gauge"
+	gm "github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/runner"
+	"github.com/getgauge/gauge/util"
+	"github.com/getgauge/gauge/validation"
 	"github.com/spf13/cobra"
 )
 
+const (
+	jsonListFormat = "json"
+	csvListFormat  = "csv"
+)
+
 var (
 	listCmd = &cobra.Command{
-		Use:     "list [flags] [args]",
-		Short:   "List specifications, scenarios or tags for a gauge project",
-		Long:    `List specifications, scenarios or tags for a gauge project`,
-		Example: `  gauge list --tags specs`,
+		Use:   "list [flags] [args]",
+		Short: "List specifications, scenarios, tags, steps or concepts for a gauge project",
+		Long:  `List specifications, scenarios, tags, steps or concepts for a gauge project`,
+		Example: `  gauge list --tags specs
+  gauge list --steps --unimplemented --format json specs
+  gauge list --tag-matrix --filter-tags "api & !slow" --format csv specs`,
 		Run: func(cmd *cobra.Command, args []string) {
-			specs, failed := parser.ParseSpecs(getSpecsDir(args), gauge.NewConceptDictionary(), gauge.NewBuildErrors())
+			if !specsFlag && !scenariosFlag && !tagsFlag && !stepsFlag && !conceptsFlag && !unimplementedFlag && !tagMatrixFlag {
+				exit(fmt.Errorf("Missing flag, nothing to list"), cmd.UsageString())
+			}
+			if listFormat != "" && listFormat != jsonListFormat && listFormat != csvListFormat {
+				exit(fmt.Errorf("Invalid format %s. Possible options are: json, csv", listFormat), cmd.UsageString())
+			}
+			if listFilterTags != "" {
+				if err := filter.ValidateTagExpression(listFilterTags); err != nil {
+					exit(err, "")
+				}
+			}
+			filter.ExecuteTags = listFilterTags
+			conceptDictionary := gauge.NewConceptDictionary()
+			if stepsFlag || conceptsFlag || unimplementedFlag {
+				dict, res, err := parser.ParseConcepts()
+				if err != nil {
+					exit(err, "")
+				}
+				if !res.Ok {
+					return
+				}
+				conceptDictionary = dict
+			}
+			specs, failed := parser.ParseSpecs(getSpecsDir(args), conceptDictionary, gauge.NewBuildErrors())
 			if failed {
 				return
 			}
+			var results []*listResult
 			if specsFlag {
-				logger.Info(true, "[Specifications]")
-				listSpecifications(specs, print)
+				listSpecifications(specs, func(res []string) {
+					results = append(results, singleColumnResult("Specifications", "specs", "specification", res))
+				})
 			}
 			if scenariosFlag {
-				logger.Info(true, "[Scenarios]")
-				listScenarios(specs, print)
+				listScenarios(specs, func(res []string) {
+					results = append(results, singleColumnResult("Scenarios", "scenarios", "scenario", res))
+				})
 			}
 			if tagsFlag {
-				logger.Info(true, "[Tags]")
-				listTags(specs, print)
+				listTags(specs, func(res []string) {
+					results = append(results, singleColumnResult("Tags", "tags", "tag", res))
+				})
 			}
-			if !specsFlag && !scenariosFlag && !tagsFlag {
-				exit(fmt.Errorf("Missing flag, nothing to list"), cmd.UsageString())
+			concepts := listedConcepts(specs, conceptDictionary, listFilterTags != "")
+			if stepsFlag {
+				results = append(results, stepsResult("Steps", "steps", stepUsages(specs, concepts)))
+			}
+			if conceptsFlag {
+				results = append(results, conceptsResult(conceptUsages(specs, concepts)))
+			}
+			if unimplementedFlag {
+				loadEnvAndReinitLogger(cmd)
+				usages, err := unimplementedStepUsages(specs, conceptDictionary)
+				if err != nil {
+					exit(err, "")
+				}
+				results = append(results, stepsResult("Unimplemented steps", "unimplementedSteps", usages))
+			}
+			if tagMatrixFlag {
+				results = append(results, tagMatrixResult(newTagMatrix(specs)))
+			}
+			if listFormat == "" {
+				printListResults(results)
+				return
+			}
+			if err := writeListResults(os.Stdout, listFormat, results); err != nil {
+				exit(err, "")
 			}
 		},
 		DisableAutoGenTag: true,
 	}
-	tagsFlag      bool
-	specsFlag     bool
-	scenariosFlag bool
+	tagsFlag          bool
+	specsFlag         bool
+	scenariosFlag     bool
+	stepsFlag         bool
+	conceptsFlag      bool
+	unimplementedFlag bool
+	tagMatrixFlag     bool
+	listFormat        string
+	listFilterTags    string
 )
 
 func init() {
@@ -67,16 +142,16 @@ func init() {
 	listCmd.Flags().BoolVarP(&tagsFlag, "tags", "", false, "List the tags in projects")
 	listCmd.Flags().BoolVarP(&specsFlag, "specs", "", false, "List the specifications in projects")
 	listCmd.Flags().BoolVarP(&scenariosFlag, "scenarios", "", false, "List the scenarios in projects")
+	listCmd.Flags().BoolVarP(&stepsFlag, "steps", "", false, "List the steps in projects with their usages")
+	listCmd.Flags().BoolVarP(&conceptsFlag, "concepts", "", false, "List the concepts in projects with their usages")
+	listCmd.Flags().BoolVarP(&unimplementedFlag, "unimplemented", "", false, "List the steps which are not implemented, by asking the language runner")
+	listCmd.Flags().BoolVarP(&tagMatrixFlag, "tag-matrix", "", false, "List the scenarios with the tags applicable to each of them")
+	listCmd.Flags().StringVarP(&listFormat, "format", "", "", "Print the listing in the given format. Possible options are: `json`, `csv`")
+	listCmd.Flags().StringVarP(&listFilterTags, "filter-tags", "t", "", "List only the specs and scenarios matching the given tag expression")
 }
 
 type handleResult func([]string)
 
-func print(res []string) {
-	for _, element := range res {
-		logger.Infof(true, element)
-	}
-}
-
 func listTags(s []*gauge.Specification, f handleResult) {
 	allTags := []string{}
 	for _, spec := range s {
@@ -127,3 +202,266 @@ func uniqueNonEmptyElementsOf(input []string) []string {
 	return us
 
 }
+
+// listResult is a listing which can be printed as text, JSON or CSV.
+type listResult struct {
+	title  string
+	key    string
+	value  interface{}
+	header []string
+	rows   [][]string
+}
+
+func singleColumnResult(title, key, column string, values []string) *listResult {
+	r := &listResult{title: title, key: key, value: values, header: []string{column}}
+	for _, v := range values {
+		r.rows = append(r.rows, []string{v})
+	}
+	return r
+}
+
+func printListResults(results []*listResult) {
+	for _, r := range results {
+		logger.Info(true, fmt.Sprintf("[%s]", r.title))
+		for _, row := range r.rows {
+			logger.Info(true, strings.Join(row, "\t"))
+		}
+	}
+}
+
+// writeListResults writes the listings as a JSON object keyed by the listing, or as CSV tables separated by an empty line.
+func writeListResults(w io.Writer, format string, results []*listResult) error {
+	if format == jsonListFormat {
+		out := make(map[string]interface{})
+		for _, r := range results {
+			out[r.key] = r.value
+		}
+		b, err := json.MarshalIndent(out, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(b))
+		return err
+	}
+	for i, r := range results {
+		if i > 0 {
+			if _, err := fmt.Fprintln(w); err != nil {
+				return err
+			}
+		}
+		cw := csv.NewWriter(w)
+		cw.Write(r.header)
+		cw.WriteAll(r.rows)
+		if err := cw.Error(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type stepUsage struct {
+	Step      string   `json:"step"`
+	Usages    int      `json:"usages"`
+	Locations []string `json:"locations"`
+}
+
+type conceptUsage struct {
+	Concept    string   `json:"concept"`
+	Definition string   `json:"definition"`
+	Usages     int      `json:"usages"`
+	Locations  []string `json:"locations"`
+}
+
+// usages holds the distinct locations at which every step is used, keyed by step value
+type usages map[string][]string
+
+func (u usages) add(value, location string) {
+	for _, l := range u[value] {
+		if l == location {
+			return
+		}
+	}
+	u[value] = append(u[value], location)
+}
+
+func location(file string, line int) string {
+	return fmt.Sprintf("%s:%d", filepath.ToSlash(util.RelPathToProjectRoot(file)), line)
+}
+
+func specSteps(spec *gauge.Specification) []*gauge.Step {
+	var steps []*gauge.Step
+	steps = append(steps, spec.Contexts...)
+	for _, scn := range spec.Scenarios {
+		steps = append(steps, scn.Steps...)
+	}
+	return append(steps, spec.TearDownSteps...)
+}
+
+// listedConcepts returns all concepts of the project, or only the ones used by the specs if they are filtered.
+func listedConcepts(specs []*gauge.Specification, dict *gauge.ConceptDictionary, filtered bool) []*gauge.Concept {
+	used := make(map[string]*gauge.Concept)
+	var addUsed func(steps []*gauge.Step)
+	addUsed = func(steps []*gauge.Step) {
+		for _, step := range steps {
+			if !step.IsConcept {
+				continue
+			}
+			c := dict.Search(step.Value)
+			if c == nil || used[step.Value] != nil {
+				continue
+			}
+			used[step.Value] = c
+			addUsed(c.ConceptStep.ConceptSteps)
+		}
+	}
+	for _, spec := range specs {
+		addUsed(specSteps(spec))
+	}
+	concepts := used
+	if !filtered {
+		concepts = dict.ConceptsMap
+	}
+	var listed []*gauge.Concept
+	for _, c := range concepts {
+		listed = append(listed, c)
+	}
+	supersort.Slice(listed, func(i, j int) bool { return listed[i].ConceptStep.Value < listed[j].ConceptStep.Value })
+	return listed
+}
+
+// collectUsages collects the usages of steps and concepts in the specs and in the definitions of the given concepts.
+func collectUsages(specs []*gauge.Specification, concepts []*gauge.Concept) (steps usages, conceptSteps usages) {
+	steps, conceptSteps = make(usages), make(usages)
+	add := func(step *gauge.Step, file string) {
+		if step.IsConcept {
+			conceptSteps.add(step.Value, location(file, step.LineNo))
+		} else {
+			steps.add(step.Value, location(file, step.LineNo))
+		}
+	}
+	for _, spec := range specs {
+		for _, step := range specSteps(spec) {
+			add(step, spec.FileName)
+		}
+	}
+	for _, c := range concepts {
+		for _, step := range c.ConceptStep.ConceptSteps {
+			add(step, c.FileName)
+		}
+	}
+	return steps, conceptSteps
+}
+
+func stepUsages(specs []*gauge.Specification, concepts []*gauge.Concept) []*stepUsage {
+	steps, _ := collectUsages(specs, concepts)
+	return sortedStepUsages(steps)
+}
+
+func sortedStepUsages(u usages) []*stepUsage {
+	res := []*stepUsage{}
+	for value, locations := range u {
+		res = append(res, &stepUsage{Step: value, Usages: len(locations), Locations: locations})
+	}
+	supersort.Slice(res, func(i, j int) bool { return res[i].Step < res[j].Step })
+	return res
+}
+
+func conceptUsages(specs []*gauge.Specification, concepts []*gauge.Concept) []*conceptUsage {
+	_, used := collectUsages(specs, concepts)
+	res := []*conceptUsage{}
+	for _, c := range concepts {
+		locations := used[c.ConceptStep.Value]
+		if locations == nil {
+			locations = []string{}
+		}
+		res = append(res, &conceptUsage{
+			Concept:    c.ConceptStep.LineText,
+			Definition: location(c.FileName, c.ConceptStep.LineNo),
+			Usages:     len(locations),
+			Locations:  locations,
+		})
+	}
+	return res
+}
+
+// unimplementedStepUsages validates the steps with the language runner and returns the steps without implementation.
+func unimplementedStepUsages(specs []*gauge.Specification, dict *gauge.ConceptDictionary) ([]*stepUsage, error) {
+	sc := api.StartAPI(false, ioutil.Discard)
+	var r runner.Runner
+	select {
+	case r = <-sc.RunnerChan:
+	case err := <-sc.ErrorChan:
+		return nil, fmt.Errorf("Failed to start runner. %s", err.Error())
+	}
+	defer r.Kill()
+	u := make(usages)
+	for _, errs := range validation.NewValidator(specs, r, dict).Validate() {
+		for _, err := range errs {
+			stepErr, ok := err.(validation.StepValidationError)
+			if ok && stepErr.ErrorType() == gm.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND {
+				u.add(stepErr.Step().Value, location(stepErr.FileName(), stepErr.Step().LineNo))
+			}
+		}
+	}
+	return sortedStepUsages(u), nil
+}
+
+func stepsResult(title, key string, steps []*stepUsage) *listResult {
+	r := &listResult{title: title, key: key, value: steps, header: []string{"step", "usages", "locations"}}
+	for _, s := range steps {
+		r.rows = append(r.rows, []string{s.Step, strconv.Itoa(s.Usages), strings.Join(s.Locations, " ")})
+	}
+	return r
+}
+
+func conceptsResult(concepts []*conceptUsage) *listResult {
+	r := &listResult{title: "Concepts", key: "concepts", value: concepts, header: []string{"concept", "definition", "usages", "locations"}}
+	for _, c := range concepts {
+		r.rows = append(r.rows, []string{c.Concept, c.Definition, strconv.Itoa(c.Usages), strings.Join(c.Locations, " ")})
+	}
+	return r
+}
+
+type scenarioTags struct {
+	Scenario string   `json:"scenario"`
+	Location string   `json:"location"`
+	Tags     []string `json:"tags"`
+}
+
+// tagMatrix holds the tags applicable to every scenario, including the tags of its spec.
+type tagMatrix struct {
+	Tags      []string        `json:"tags"`
+	Scenarios []*scenarioTags `json:"scenarios"`
+}
+
+func newTagMatrix(specs []*gauge.Specification) *tagMatrix {
+	m := &tagMatrix{Tags: []string{}, Scenarios: []*scenarioTags{}}
+	var allTags []string
+	for _, spec := range specs {
+		for _, scn := range spec.Scenarios {
+			tags := sortedDistinctElements(appendTags(appendTags(nil, spec.Tags), scn.Tags))
+			allTags = append(allTags, tags...)
+			m.Scenarios = append(m.Scenarios, &scenarioTags{Scenario: scn.Heading.Value, Location: location(spec.FileName, scn.Heading.LineNo), Tags: tags})
+		}
+	}
+	m.Tags = append(m.Tags, sortedDistinctElements(allTags)...)
+	return m
+}
+
+func tagMatrixResult(m *tagMatrix) *listResult {
+	r := &listResult{title: "Tag matrix", key: "tagMatrix", value: m, header: append([]string{"scenario", "location"}, m.Tags...)}
+	for _, scn := range m.Scenarios {
+		row := []string{scn.Scenario, scn.Location}
+		for _, tag := range m.Tags {
+			mark := ""
+			for _, t := range scn.Tags {
+				if t == tag {
+					mark = "x"
+				}
+			}
+			row = append(row, mark)
+		}
+		r.rows = append(r.rows, row)
+	}
+	return r
+}
@@ -18,6 +18,7 @@
 package cmd
 
 import (
+	"bytes"
 	"reflect"
 	"testing"
 
@@ -46,6 +47,88 @@ func TestOnlyUniqueSceanriosAreReturned(t *testing.T) {
 	})
 }
 
+func TestStepUsagesAreCountedAcrossSpecsAndConcepts(t *testing.T) {
+	spec := &gauge.Specification{
+		FileName: "example.spec",
+		Contexts: []*gauge.Step{{Value: "open app", LineNo: 2}},
+		Scenarios: []*gauge.Scenario{{
+			Heading: &gauge.Heading{Value: "scenario1", LineNo: 4},
+			Steps: []*gauge.Step{
+				{Value: "login as {}", LineNo: 5, IsConcept: true},
+				{Value: "open app", LineNo: 6},
+			},
+		}},
+	}
+	concept := &gauge.Concept{
+		FileName: "login.cpt",
+		ConceptStep: &gauge.Step{Value: "login as {}", LineText: "login as <user>", LineNo: 1, IsConcept: true,
+			ConceptSteps: []*gauge.Step{{Value: "open app", LineNo: 2}, {Value: "enter user {}", LineNo: 3}}},
+	}
+
+	steps := stepUsages([]*gauge.Specification{spec}, []*gauge.Concept{concept})
+
+	want := []*stepUsage{
+		{Step: "enter user {}", Usages: 1, Locations: []string{"login.cpt:3"}},
+		{Step: "open app", Usages: 3, Locations: []string{"example.spec:2", "example.spec:6", "login.cpt:2"}},
+	}
+	if !reflect.DeepEqual(steps, want) {
+		t.Errorf("wanted: %v, got: %v", want, steps)
+	}
+
+	concepts := conceptUsages([]*gauge.Specification{spec}, []*gauge.Concept{concept})
+
+	wantConcepts := []*conceptUsage{{Concept: "login as <user>", Definition: "login.cpt:1", Usages: 1, Locations: []string{"example.spec:5"}}}
+	if !reflect.DeepEqual(concepts, wantConcepts) {
+		t.Errorf("wanted: %v, got: %v", wantConcepts, concepts)
+	}
+}
+
+func TestTagMatrixIncludesSpecTags(t *testing.T) {
+	spec := buildTestSpecification()
+	spec.FileName = "example.spec"
+	spec.Tags = &gauge.Tags{RawValues: [][]string{{"smoke"}}}
+
+	m := newTagMatrix([]*gauge.Specification{spec})
+
+	if !reflect.DeepEqual(m.Tags, []string{"bar", "foo", "smoke"}) {
+		t.Errorf("wanted tags: [bar foo smoke], got: %v", m.Tags)
+	}
+	if !reflect.DeepEqual(m.Scenarios[1].Tags, []string{"foo", "smoke"}) {
+		t.Errorf("wanted scenario tags: [foo smoke], got: %v", m.Scenarios[1].Tags)
+	}
+}
+
+func TestWriteListResultsAsCSV(t *testing.T) {
+	m := newTagMatrix([]*gauge.Specification{buildTestSpecification()})
+	results := []*listResult{singleColumnResult("Specifications", "specs", "specification", []string{"Spec1"}), tagMatrixResult(m)}
+	b := &bytes.Buffer{}
+
+	err := writeListResults(b, csvListFormat, results)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err.Error())
+	}
+	want := "specification\nSpec1\n\nscenario,location,bar,foo\nscenario1,:0,x,x\nscenario1,:0,,x\n"
+	if b.String() != want {
+		t.Errorf("wanted:\n%s\ngot:\n%s", want, b.String())
+	}
+}
+
+func TestWriteListResultsAsJSON(t *testing.T) {
+	results := []*listResult{singleColumnResult("Tags", "tags", "tag", []string{"bar", "foo"}), stepsResult("Steps", "steps", []*stepUsage{})}
+	b := &bytes.Buffer{}
+
+	err := writeListResults(b, jsonListFormat, results)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err.Error())
+	}
+	want := "{\n  \"steps\": [],\n  \"tags\": [\n    \"bar\",\n    \"foo\"\n  ]\n}\n"
+	if b.String() != want {
+		t.Errorf("wanted:\n%s\ngot:\n%s", want, b.String())
+	}
+}
+
 func buildTestSpecification() *gauge.Specification {
 	return &gauge.Specification{
 		Heading: &gauge.Heading{
@@ -0,0 +1,61 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution"
+	"github.com/getgauge/gauge/reporter"
+	"github.com/spf13/cobra"
+)
+
+var (
+	mergeResultsCmd = &cobra.Command{
+		Use:   "merge-results [flags] <result files>",
+		Short: "Merges the execution results of shards",
+		Long: `Merges the execution results saved by runs with --shard into one result.
+The merged result is saved as the last run result of the project, the failed scenarios are saved for gauge run --failed and the reporting plugins of the project are notified.`,
+		Example: "  gauge merge-results shard1/last_run_result shard2/last_run_result\n  gauge merge-results --format junit --output reports/junit.xml shard*/last_run_result",
+		Run: func(cmd *cobra.Command, args []string) {
+			loadEnvAndReinitLogger(cmd)
+			if len(args) < 1 {
+				exit(fmt.Errorf("Result files to merge are not specified."), cmd.UsageString())
+			}
+			if err := config.SetProjectRoot([]string{}); err != nil {
+				exit(err, cmd.UsageString())
+			}
+			if mergeFormat != "" && !reporter.IsSupportedReportFormat(mergeFormat) {
+				exit(fmt.Errorf("invalid input(%s) to --format flag", mergeFormat), cmd.UsageString())
+			}
+			reporter.ReportFormat = mergeFormat
+			reporter.ReportOutput = mergeOutput
+			os.Exit(execution.MergeResults(args))
+		},
+		DisableAutoGenTag: true,
+	}
+	mergeFormat string
+	mergeOutput string
+)
+
+func init() {
+	GaugeCmd.AddCommand(mergeResultsCmd)
+	mergeResultsCmd.Flags().StringVarP(&mergeFormat, formatName, "", formatDefault, "Write the merged result in the given format without a reporting plugin. Possible options are: `junit`, `tap`")
+	mergeResultsCmd.Flags().StringVarP(&mergeOutput, outputName, "", outputDefault, "File to write the merged result in --format to. Defaults to a file in the reports directory")
+}
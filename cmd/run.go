@@ -23,12 +23,14 @@ import (
 	"strconv"
 
 	"strings"
+	"time"
 
 	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/execution"
 	"github.com/getgauge/gauge/execution/rerun"
 	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/order"
 	"github.com/getgauge/gauge/plugin/install"
 	"github.com/getgauge/gauge/util"
 	"github.com/spf13/cobra"
@@ -40,6 +42,7 @@ const (
 	simpleConsoleDefault   = false
 	failedDefault          = false
 	repeatDefault          = false
+	resumeDefault          = false
 	parallelDefault        = false
 	sortDefault            = false
 	installPluginsDefault  = true
@@ -53,11 +56,25 @@ const (
 	retryOnlyTagsDefault   = ""
 	failSafeDefault        = false
 	skipCommandSaveDefault = false
+	scenarioTimeoutDefault = 0
+	formatDefault          = ""
+	outputDefault          = ""
+	quarantineDefault      = false
+	watchDefault           = false
+	shardDefault           = ""
+	shardByDefault         = "hash"
+	stepRetriesDefault     = 0
+	stepBackoffDefault     = time.Second
+	serveDefault           = 0
+	profileDefault         = false
+	dryRunDefault          = false
+	orderDefault           = ""
 
 	verboseName         = "verbose"
 	simpleConsoleName   = "simple-console"
 	failedName          = "failed"
 	repeatName          = "repeat"
+	resumeName          = "resume"
 	parallelName        = "parallel"
 	sortName            = "sort"
 	installPluginsName  = "install-plugins"
@@ -73,6 +90,19 @@ const (
 	failSafeName        = "fail-safe"
 	skipCommandSaveName = "skip-save"
 	scenarioName        = "scenario"
+	scenarioTimeoutName = "scenario-timeout"
+	formatName          = "format"
+	outputName          = "output"
+	quarantineName      = "quarantine"
+	watchName           = "watch"
+	shardName           = "shard"
+	shardByName         = "shard-by"
+	stepRetriesName     = "step-retries"
+	stepBackoffName     = "step-retry-backoff"
+	serveName           = "serve"
+	profileName         = "profile"
+	dryRunName          = "dry-run"
+	orderName           = "order"
 )
 
 var overrideRerunFlags = []string{verboseName, simpleConsoleName, machineReadableName, dirName, logLevelName}
@@ -94,6 +124,8 @@ var (
 			}
 			if repeat {
 				repeatLastExecution(cmd)
+			} else if resume {
+				resumeLastExecution(cmd)
 			} else if failed {
 				executeFailed(cmd)
 			} else {
@@ -106,6 +138,7 @@ var (
 	simpleConsole              bool
 	failed                     bool
 	repeat                     bool
+	resume                     bool
 	parallel                   bool
 	sort                       bool
 	installPlugins             bool
@@ -122,6 +155,19 @@ var (
 	skipCommandSave            bool
 	scenarios                  []string
 	scenarioNameDefault        []string
+	scenarioTimeout            time.Duration
+	reportFormat               string
+	reportOutput               string
+	quarantine                 bool
+	watch                      bool
+	shard                      string
+	shardBy                    string
+	stepRetries                int
+	stepRetryBackoff           time.Duration
+	servePort                  int
+	profile                    bool
+	dryRun                     bool
+	executionOrder             string
 )
 
 func init() {
@@ -131,7 +177,7 @@ func init() {
 	f.BoolVarP(&simpleConsole, simpleConsoleName, "", simpleConsoleDefault, "Removes colouring and simplifies the console output")
 	f.StringVarP(&environment, environmentName, "e", environmentDefault, "Specifies the environment to use")
 	f.StringVarP(&tags, tagsName, "t", tagsDefault, "Executes the specs and scenarios tagged with given tags")
-	f.StringVarP(&rows, rowsName, "r", rowsDefault, "Executes the specs and scenarios only for the selected rows. It can be specified by range as 2-4 or as list 2,4")
+	f.StringVarP(&rows, rowsName, "r", rowsDefault, "Executes the specs and scenarios only for the selected rows of the spec data table, or of the scenario data tables for the scenarios not driven by it. It can be specified by range as 2-4 or as list 2,4")
 	f.BoolVarP(&parallel, parallelName, "p", parallelDefault, "Execute specs in parallel")
 	f.IntVarP(&streams, streamsName, "n", streamsDefault, "Specify number of parallel execution streams")
 	f.IntVarP(&maxRetriesCount, maxRetriesCountName, "c", maxRetriesCountDefault, "Max count of iterations for failed scenario")
@@ -139,16 +185,30 @@ func init() {
 	f.StringVarP(&tagsToFilterForParallelRun, onlyName, "o", onlyDefault, "Specify number of parallel execution streams")
 	f.MarkHidden(onlyName)
 	f.IntVarP(&group, groupName, "g", groupDefault, "Specify which group of specification to execute based on -n flag")
-	f.StringVarP(&strategy, strategyName, "", strategyDefault, "Set the parallelization strategy for execution. Possible options are: `eager`, `lazy`")
+	f.StringVarP(&strategy, strategyName, "", strategyDefault, "Set the parallelization strategy for execution. Possible options are: `eager`, `lazy`, `timed`")
 	f.BoolVarP(&sort, sortName, "s", sortDefault, "Run specs in Alphabetical Order")
 	f.BoolVarP(&installPlugins, installPluginsName, "i", installPluginsDefault, "Install All Missing Plugins")
 	f.BoolVarP(&failed, failedName, "f", failedDefault, "Run only the scenarios failed in previous run. This cannot be used in conjunction with any other argument")
 	f.BoolVarP(&repeat, repeatName, "", repeatDefault, "Repeat last run. This cannot be used in conjunction with any other argument")
+	f.BoolVarP(&resume, resumeName, "", resumeDefault, "Resume last run after a crash or interruption, skipping the specs and scenarios it completed. This cannot be used in conjunction with any other argument")
 	f.BoolVarP(&hideSuggestion, hideSuggestionName, "", hideSuggestionDefault, "Prints a step implementation stub for every unimplemented step")
 	f.BoolVarP(&failSafe, failSafeName, "", failSafeDefault, "Force return 0 exit code, even in case of failures.")
 	f.BoolVarP(&skipCommandSave, skipCommandSaveName, "", skipCommandSaveDefault, "Skip saving last command in lastRunCmd.json")
 	f.MarkHidden(skipCommandSaveName)
 	f.StringArrayVar(&scenarios, scenarioName, scenarioNameDefault, "Set scenarios for running specs with scenario name")
+	f.DurationVarP(&scenarioTimeout, scenarioTimeoutName, "", scenarioTimeoutDefault, "Fail a scenario which takes longer than the given duration, e.g. 30s. Its teardown steps and after scenario hook get the same duration of their own. Overridden by timeout:<duration> tags on specs or scenarios")
+	f.StringVarP(&reportFormat, formatName, "", formatDefault, "Write the execution result in the given format without a reporting plugin. Possible options are: `junit`, `tap`")
+	f.StringVarP(&reportOutput, outputName, "", outputDefault, "File to write the execution result in --format to. Defaults to a file in the reports directory")
+	f.BoolVarP(&quarantine, quarantineName, "", quarantineDefault, "Run known flaky scenarios and scenarios tagged with quarantine without failing the execution because of them. A scenario is no longer known flaky once it has the same outcome in 3 runs in a row")
+	f.BoolVarP(&watch, watchName, "", watchDefault, "Keep the runner alive and re-execute the specs affected by changes to spec, concept or implementation files")
+	f.StringVarP(&shard, shardName, "", shardDefault, "Execute only the i-th of N shards of the scenarios, given as i/N. Used to split execution across machines, the results can be combined with gauge merge-results")
+	f.IntVarP(&stepRetries, stepRetriesName, "", stepRetriesDefault, "Max count of retries for a failing step, without rerunning the earlier steps of its scenario. Overridden by step-retries:<n> tags on specs or scenarios")
+	f.DurationVarP(&stepRetryBackoff, stepBackoffName, "", stepBackoffDefault, "Wait before the first retry of a failing step, doubled for every further retry")
+	f.IntVarP(&servePort, serveName, "", serveDefault, "Serve the live execution events at /events, the execution info at /info and the suite result so far at /result on the given local port")
+	f.BoolVarP(&profile, profileName, "", profileDefault, "Report the total, mean and 95th percentile time of every step implementation, the time taken by the hooks of every spec and the idle time of the runners, on console and in profile.json in the reports directory")
+	f.BoolVarP(&dryRun, dryRunName, "", dryRunDefault, "Print the scenarios and resolved steps which would be executed on every stream, without starting the runner. The plan is also written to execution-plan.json in the reports directory")
+	f.StringVarP(&executionOrder, orderName, "", orderDefault, "Execute specs in random order, given as random[:seed], or specs and the scenarios within them, given as random-scenarios[:seed]. The seed is printed and saved, so that --repeat executes in the same order")
+	f.StringVarP(&shardBy, shardByName, "", shardByDefault, "Set the partitioning of scenarios for --shard. Possible options are: `hash`, `time`. The time option uses the execution times in the last run result, which should be the same on every machine")
 }
 
 func executeFailed(cmd *cobra.Command) {
@@ -161,6 +221,7 @@ func executeFailed(cmd *cobra.Command) {
 	}
 	handleFlags(cmd, append([]string{"gauge"}, lastState...))
 	cmd.Flags().Set(skipCommandSaveName, "true")
+	execution.RerunFailed = true
 	logger.Debugf(true, "Executing => %s\n", strings.Join(os.Args, " "))
 	cmd.Execute()
 }
@@ -213,12 +274,22 @@ func execute(cmd *cobra.Command, args []string) {
 		logger.Fatal(true, "Filtered parallel execution is a experimental feature. It can be enabled via allow_filtered_parallel_execution property.")
 	}
 	specs := getSpecsDir(args)
+	if executionOrder != "" {
+		seedExecutionOrder(cmd)
+	}
+	if dryRun {
+		os.Exit(execution.ExecuteSpecs(specs))
+	}
 	rerun.SaveState(os.Args[1:], specs)
 
 	if !skipCommandSave {
 		rerun.WritePrevArgs(os.Args)
 	}
 	installMissingPlugins(installPlugins)
+	if watch {
+		execution.WatchSpecs(specs)
+		return
+	}
 	exitCode := execution.ExecuteSpecs(specs)
 	notifyTelemetryIfNeeded(cmd, args)
 	if failSafe && exitCode != execution.ParseFailed {
@@ -227,6 +298,29 @@ func execute(cmd *cobra.Command, args []string) {
 	os.Exit(exitCode)
 }
 
+// seedExecutionOrder generates a seed for --order if none is given, and puts it into the
+// command arguments so that the saved command repeats the same order.
+func seedExecutionOrder(cmd *cobra.Command) {
+	o, seed, err := order.WithSeed(executionOrder)
+	if err != nil {
+		exit(err, cmd.UsageString())
+	}
+	for i := 1; i < len(os.Args); i++ {
+		f := lookupFlagFromArgs(cmd, os.Args[i])
+		if f == nil || f.Name != orderName {
+			continue
+		}
+		if strings.Contains(os.Args[i], "=") {
+			os.Args[i] = strings.SplitAfter(os.Args[i], "=")[0] + o
+		} else if i+1 < len(os.Args) {
+			os.Args[i+1] = o
+		}
+		break
+	}
+	executionOrder, order.Order = o, o
+	logger.Infof(true, "Executing in %s order with seed %d. Use --order %s to repeat it.", strings.Split(o, ":")[0], seed, o)
+}
+
 var repeatLastExecution = func(cmd *cobra.Command) {
 	lastState := rerun.ReadPrevArgs()
 	handleFlags(cmd, lastState)
@@ -234,6 +328,11 @@ var repeatLastExecution = func(cmd *cobra.Command) {
 	cmd.Execute()
 }
 
+var resumeLastExecution = func(cmd *cobra.Command) {
+	execution.Resume = true
+	repeatLastExecution(cmd)
+}
+
 func handleConflictingParams(setFlags *pflag.FlagSet, args []string) error {
 	flagDiffCount := 0
 	setFlags.Visit(func(flag *pflag.Flag) {
@@ -244,12 +343,30 @@ func handleConflictingParams(setFlags *pflag.FlagSet, args []string) error {
 	if repeat && len(args)+flagDiffCount > 1 {
 		return fmt.Errorf("Invalid Command. Usage: gauge run --repeat")
 	}
+	if resume && len(args)+flagDiffCount > 1 {
+		return fmt.Errorf("Invalid Command. Usage: gauge run --resume")
+	}
 	if failed && len(args)+flagDiffCount > 1 {
 		return fmt.Errorf("Invalid Command. Usage: gauge run --failed")
 	}
 	if !parallel && tagsToFilterForParallelRun != "" {
 		return fmt.Errorf("Invalid Command. flag --only can be used only with --parallel")
 	}
+	if watch && (parallel || failed || repeat || resume) {
+		return fmt.Errorf("Invalid Command. flag --watch cannot be used with --parallel, --failed, --repeat or --resume")
+	}
+	if shard != "" && (group != -1 || watch) {
+		return fmt.Errorf("Invalid Command. flag --shard cannot be used with --group or --watch")
+	}
+	if executionOrder != "" && sort {
+		return fmt.Errorf("Invalid Command. flag --order cannot be used with --sort")
+	}
+	if dryRun && watch {
+		return fmt.Errorf("Invalid Command. flag --dry-run cannot be used with --watch")
+	}
+	if reportFormat == "" && reportOutput != "" {
+		return fmt.Errorf("Invalid Command. flag --output can be used only with --format")
+	}
 	if maxRetriesCount == 1 && retryOnlyTags != "" {
 		return fmt.Errorf("Invalid Command. flag --retry-only can be used only with --max-retry-count")
 	}
@@ -184,6 +184,23 @@ func TestHandleConflictingParamsWithOtherFlags(t *testing.T) {
 	}
 }
 
+func TestHandleConflictingParamsWithOtherFlagsAndResumeFlag(t *testing.T) {
+	args := []string{"specs"}
+
+	var flags = pflag.FlagSet{}
+	flags.BoolP(resumeName, "", false, "")
+	flags.Set(resumeName, "true")
+
+	repeat, resume = false, true
+	defer func() { resume = false }()
+	expectedErrorMessage := "Invalid Command. Usage: gauge run --resume"
+	err := handleConflictingParams(&flags, args)
+
+	if err == nil || err.Error() != expectedErrorMessage {
+		t.Errorf("Expected %v  Got %v", expectedErrorMessage, err)
+	}
+}
+
 func TestHandleConflictingParamsWithJustRepeatFlag(t *testing.T) {
 	args := []string{}
 
@@ -211,7 +228,7 @@ func TestHandleRerunFlagsWithVerbose(t *testing.T) {
 		cmd.Flags().BoolP(parallelName, "p", parallelDefault, "Execute specs in parallel")
 		cmd.Flags().IntP(streamsName, "n", streamsDefault, "Specify number of parallel execution streams")
 		cmd.Flags().IntP(groupName, "g", groupDefault, "Specify which group of specification to execute based on -n flag")
-		cmd.Flags().StringP(strategyName, "", strategyDefault, "Set the parallelization strategy for execution. Possible options are: `eager`, `lazy`")
+		cmd.Flags().StringP(strategyName, "", strategyDefault, "Set the parallelization strategy for execution. Possible options are: `eager`, `lazy`, `timed`")
 		cmd.Flags().BoolP(sortName, "s", sortDefault, "Run specs in Alphabetical Order")
 		cmd.Flags().BoolP(installPluginsName, "i", installPluginsDefault, "Install All Missing Plugins")
 		cmd.Flags().BoolP(failedName, "f", failedDefault, "Run only the scenarios failed in previous run. This is an exclusive flag, it cannot be used in conjunction with any other argument")
@@ -484,3 +501,55 @@ func TestCorrectFlagsAreSetForFailed(t *testing.T) {
 func subEnv() []string {
 	return append(os.Environ(), []string{"TEST_EXITS=1", "GAUGE_PLUGIN_INSTALL=false"}...)
 }
+
+func TestHandleConflictingParamsWithWatchAndParallelFlags(t *testing.T) {
+	var flags = pflag.FlagSet{}
+	repeat, failed, parallel, watch = false, false, true, true
+	defer func() { parallel, watch = false, false }()
+
+	err := handleConflictingParams(&flags, []string{})
+
+	expectedErrorMessage := "Invalid Command. flag --watch cannot be used with --parallel, --failed, --repeat or --resume"
+	if err == nil || err.Error() != expectedErrorMessage {
+		t.Errorf("Expected %v  Got %v", expectedErrorMessage, err)
+	}
+}
+
+func TestHandleConflictingParamsWithShardAndGroupFlags(t *testing.T) {
+	var flags = pflag.FlagSet{}
+	repeat, failed, shard, group = false, false, "1/2", 1
+	defer func() { shard, group = "", -1 }()
+
+	err := handleConflictingParams(&flags, []string{})
+
+	expectedErrorMessage := "Invalid Command. flag --shard cannot be used with --group or --watch"
+	if err == nil || err.Error() != expectedErrorMessage {
+		t.Errorf("Expected %v  Got %v", expectedErrorMessage, err)
+	}
+}
+
+func TestHandleConflictingParamsWithDryRunAndWatchFlags(t *testing.T) {
+	var flags = pflag.FlagSet{}
+	repeat, failed, dryRun, watch = false, false, true, true
+	defer func() { dryRun, watch = false, false }()
+
+	err := handleConflictingParams(&flags, []string{})
+
+	expectedErrorMessage := "Invalid Command. flag --dry-run cannot be used with --watch"
+	if err == nil || err.Error() != expectedErrorMessage {
+		t.Errorf("Expected %v  Got %v", expectedErrorMessage, err)
+	}
+}
+
+func TestHandleConflictingParamsWithOrderAndSortFlags(t *testing.T) {
+	var flags = pflag.FlagSet{}
+	repeat, failed, executionOrder, sort = false, false, "random", true
+	defer func() { executionOrder, sort = "", false }()
+
+	err := handleConflictingParams(&flags, []string{})
+
+	expectedErrorMessage := "Invalid Command. flag --order cannot be used with --sort"
+	if err == nil || err.Error() != expectedErrorMessage {
+		t.Errorf("Expected %v  Got %v", expectedErrorMessage, err)
+	}
+}
@@ -33,10 +33,11 @@ var (
 		Use:     "validate [flags] [args]",
 		Short:   "Check for validation and parse errors",
 		Long:    `Check for validation and parse errors.`,
-		Example: "  gauge validate specs/",
+		Example: "  gauge validate specs/\n  gauge validate --unused specs/",
 		Run: func(cmd *cobra.Command, args []string) {
 			loadEnvAndReinitLogger(cmd)
 			validation.HideSuggestion = hideSuggestion
+			validation.ReportUnused = unused
 			if err := config.SetProjectRoot(args); err != nil {
 				exit(err, cmd.UsageString())
 			}
@@ -45,10 +46,12 @@ var (
 		DisableAutoGenTag: true,
 	}
 	hideSuggestion bool
+	unused         bool
 )
 
 func init() {
 	GaugeCmd.AddCommand(validateCmd)
 	validateCmd.Flags().BoolVarP(&hideSuggestion, "hide-suggestion", "", false, "Prints a step implementation stub for every unimplemented step")
+	validateCmd.Flags().BoolVarP(&unused, "unused", "", false, "Reports the step implementations and concepts which are not used by any spec")
 
 }
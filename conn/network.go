@@ -122,27 +122,38 @@ func WriteGaugeMessage(message *gauge_messages.Message, conn net.Conn) error {
 func getResponseForGaugeMessage(message *gauge_messages.Message, conn net.Conn, res response, timeout time.Duration) {
 	message.MessageId = common.GetUniqueID()
 	res.addTimer(timeout, message)
-	handle := func(err error) {
+	// the request ends on the first error, e.g. when the connection is closed as the runner is killed
+	handle := func(err error) bool {
 		if err != nil {
 			res.stopTimer()
+			m.delete(message.GetMessageId())
 			res.err <- err
+			return true
 		}
+		return false
 	}
 
 	data, err := proto.Marshal(message)
-
-	handle(err)
+	if handle(err) {
+		return
+	}
 	m.put(message.GetMessageId(), res)
 
 	responseBytes, err := writeDataAndGetResponse(conn, data)
-	handle(err)
+	if handle(err) {
+		return
+	}
 
 	responseMessage := &gauge_messages.Message{}
 	err = proto.Unmarshal(responseBytes, responseMessage)
-	handle(err)
+	if handle(err) {
+		return
+	}
 
 	err = checkUnsupportedResponseMessage(responseMessage)
-	handle(err)
+	if handle(err) {
+		return
+	}
 
 	responseRes := m.get(responseMessage.GetMessageId())
 	responseRes.stopTimer()
@@ -160,7 +171,8 @@ func checkUnsupportedResponseMessage(message *gauge_messages.Message) error {
 // Sends request to plugin for a message. If response is not received for the given message within the configured timeout, an error is thrown
 // To wait indefinitely for the response from the plugin, set timeout value as 0.
 func GetResponseForMessageWithTimeout(message *gauge_messages.Message, conn net.Conn, timeout time.Duration) (*gauge_messages.Message, error) {
-	res := response{result: make(chan *gauge_messages.Message), err: make(chan error)}
+	// buffered, so that the request does not block once the caller stopped waiting, i.e. after a timeout
+	res := response{result: make(chan *gauge_messages.Message, 1), err: make(chan error, 2)}
 	go getResponseForGaugeMessage(message, conn, res, timeout)
 	select {
 	case err := <-res.err:
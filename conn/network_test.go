@@ -241,3 +241,34 @@ func TestGetResponseForGaugeMessageShoudNotErrorIfNoTimeoutIsSpecified(t *testin
 		t.Errorf("expected : %v\ngot : %v", responseMessage, res)
 	}
 }
+
+type closedConn struct {
+	mockConn
+}
+
+func (c closedConn) Read(b []byte) (n int, err error) {
+	return 0, errors.New("use of closed network connection")
+}
+
+func TestGetResponseForGaugeMessageEndsWhenConnectionIsClosed(t *testing.T) {
+	message := &gauge_messages.Message{MessageType: gauge_messages.Message_ExecuteStep}
+	res := response{result: make(chan *gauge_messages.Message, 1), err: make(chan error, 2)}
+	done := make(chan bool)
+
+	go func() {
+		getResponseForGaugeMessage(message, closedConn{}, res, 0)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("expected the request to end when the connection is closed")
+	}
+	if err := <-res.err; err == nil {
+		t.Errorf("expected an error for the closed connection")
+	}
+	if len(res.err) != 0 {
+		t.Errorf("expected only one error, got %d more", len(res.err))
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package docs
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+)
+
+// Generate writes the documentation of the specs in specDirs and of the concepts of the project to outDir. It returns
+// false if the specs or concepts could not be parsed or the documentation could not be written.
+func Generate(specDirs []string, outDir string) bool {
+	dictionary, res, err := parser.ParseConcepts()
+	if err != nil {
+		logger.Errorf(true, "Unable to parse concepts: %s", err.Error())
+		return false
+	}
+	specs, failed := parser.ParseSpecs(specDirs, dictionary, gauge.NewBuildErrors())
+	if !res.Ok || failed {
+		return false
+	}
+	if err := write(newSite(specs, dictionary, config.ProjectRoot), outDir); err != nil {
+		logger.Errorf(true, "Failed to generate documentation. %s", err.Error())
+		return false
+	}
+	logger.Infof(true, "Generated documentation of %d specifications in %s", len(specs), outDir)
+	return true
+}
+
+func write(s *site, outDir string) error {
+	if err := writeFile(filepath.Join(outDir, "style.css"), []byte(style)); err != nil {
+		return err
+	}
+	if err := render(filepath.Join(outDir, "index.html"), "index", s.index); err != nil {
+		return err
+	}
+	if err := render(filepath.Join(outDir, "concepts.html"), "concepts", s.concepts); err != nil {
+		return err
+	}
+	for _, p := range s.specs {
+		if err := render(filepath.Join(outDir, filepath.FromSlash(p.URL)), "spec", p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func render(file, name string, data interface{}) error {
+	var b bytes.Buffer
+	if err := templates.ExecuteTemplate(&b, name, data); err != nil {
+		return err
+	}
+	return writeFile(file, b.Bytes())
+}
+
+func writeFile(file string, contents []byte) error {
+	if err := os.MkdirAll(filepath.Dir(file), common.NewDirectoryPermissions); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, contents, common.NewFilePermissions)
+}
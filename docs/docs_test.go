@@ -0,0 +1,152 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package docs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type MySuite struct{}
+
+var _ = Suite(&MySuite{})
+
+const projectRoot = "/project"
+
+func parseSite(c *C, conceptText string, specTexts ...string) *site {
+	dictionary := gauge.NewConceptDictionary()
+	if conceptText != "" {
+		steps, res := new(parser.ConceptParser).Parse(conceptText, filepath.Join(projectRoot, "specs", "concepts.cpt"))
+		c.Assert(res.ParseErrors, HasLen, 0)
+		errs, err := parser.AddConcept(steps, filepath.Join(projectRoot, "specs", "concepts.cpt"), dictionary)
+		c.Assert(err, IsNil)
+		c.Assert(errs, HasLen, 0)
+	}
+	var specs []*gauge.Specification
+	for i, text := range specTexts {
+		spec, res, err := new(parser.SpecParser).Parse(text, dictionary, filepath.Join(projectRoot, "specs", string(rune('a'+i))+".spec"))
+		c.Assert(err, IsNil)
+		c.Assert(res.Ok, Equals, true)
+		specs = append(specs, spec)
+	}
+	return newSite(specs, dictionary, projectRoot)
+}
+
+func (s *MySuite) TestSiteLinksAndExpandsConcepts(c *C) {
+	site := parseSite(c, "# Login as <name>\n* Open \"login\" page\n* Type <name>\n", `# Cart
+
+## Add
+
+* Login as "bob"
+* Pay
+`)
+
+	c.Assert(site.concepts.Concepts, HasLen, 1)
+	concept := site.concepts.Concepts[0]
+	c.Assert(concept.ID, Equals, "concept-login-as")
+	c.Assert(concept.File, Equals, "specs/concepts.cpt")
+	c.Assert(concept.UsedBy, DeepEquals, []link{{Text: "Cart / Add", URL: "specs/a.html#scenario-add"}})
+
+	p := site.specs[0]
+	c.Assert(p.URL, Equals, "specs/a.html")
+	c.Assert(p.Root, Equals, "../")
+	login := p.Scenarios[0].Items[0].Step
+	c.Assert(login.ConceptURL, Equals, "../concepts.html#concept-login-as")
+	c.Assert(login.Fragments, DeepEquals, []fragment{{Text: "Login as "}, {Text: `"bob"`, Kind: "static"}})
+	c.Assert(login.Steps, HasLen, 2)
+	c.Assert(stepText(login.Steps[1]), Equals, "Type <name>")
+}
+
+func (s *MySuite) TestSiteKeepsTheOrderOfTheSpec(c *C) {
+	site := parseSite(c, "", `# Cart
+
+A comment
+
+   |user |
+   |-----|
+   |alice|
+
+* Login as <user>
+
+## Add
+
+* Add "shoes" with
+   |name|qty|
+   |----|---|
+   |a   |1  |
+
+___
+* Logout
+`)
+
+	items := site.specs[0].Items
+	c.Assert(items, HasLen, 6)
+	c.Assert(items[0].Comment, Equals, "A comment")
+	c.Assert(items[1].Table, DeepEquals, &table{Headers: []string{"user"}, Rows: [][]string{{"alice"}}})
+	c.Assert(stepText(items[2].Step), Equals, "Login as <user>")
+	c.Assert(items[3].Scenario.Heading, Equals, "Add")
+	c.Assert(items[3].Scenario.Items[0].Step.Table, DeepEquals, &table{Headers: []string{"name", "qty"}, Rows: [][]string{{"a", "1"}}})
+	c.Assert(items[4].Teardown, Equals, true)
+	c.Assert(stepText(items[5].Step), Equals, "Logout")
+}
+
+func (s *MySuite) TestSiteIndexesTags(c *C) {
+	site := parseSite(c, "", "# Cart\n\ntags: smoke\n\n## Add\n\ntags: fast, smoke\n\n* Add\n", "# Search\n\n## Find\n\n* Find\n")
+
+	c.Assert(site.index.Tags, HasLen, 2)
+	c.Assert(site.index.Tags[0].Name, Equals, "fast")
+	c.Assert(site.index.Tags[0].Entries, DeepEquals, []link{{Text: "Cart / Add", URL: "specs/a.html#scenario-add"}})
+	c.Assert(site.index.Tags[1].Name, Equals, "smoke")
+	c.Assert(site.index.Tags[1].Entries, HasLen, 2)
+	c.Assert(site.index.Search, HasLen, 4)
+	c.Assert(site.index.Search[0], DeepEquals, searchEntry{Title: "Add", Kind: "scenario", URL: "specs/a.html#scenario-add", Text: "cart fast smoke add"})
+	c.Assert(site.index.Search[1], DeepEquals, searchEntry{Title: "Cart", Kind: "spec", URL: "specs/a.html", Text: "specs/a.spec smoke"})
+}
+
+func (s *MySuite) TestWriteRendersThePages(c *C) {
+	site := parseSite(c, "# Checkout\n* Pay\n", "# Cart <&>\n\n## Add\n\n* Checkout\n")
+	dir, err := ioutil.TempDir("", "docs")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+
+	c.Assert(write(site, dir), IsNil)
+
+	for _, f := range []string{"index.html", "concepts.html", "style.css", "specs/a.html"} {
+		_, err := os.Stat(filepath.Join(dir, f))
+		c.Assert(err, IsNil)
+	}
+	page, err := ioutil.ReadFile(filepath.Join(dir, "specs", "a.html"))
+	c.Assert(err, IsNil)
+	c.Assert(strings.Contains(string(page), "<h1>Cart &lt;&amp;&gt;</h1>"), Equals, true)
+	c.Assert(strings.Contains(string(page), `<a href="../concepts.html#concept-checkout">concept</a>`), Equals, true)
+}
+
+func (s *MySuite) TestSlug(c *C) {
+	c.Assert(slug("Login as <name>!"), Equals, "login-as-name")
+	ids := make(map[string]int)
+	c.Assert(uniqueID(ids, "a"), Equals, "a")
+	c.Assert(uniqueID(ids, "a"), Equals, "a-2")
+}
@@ -0,0 +1,373 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package docs
+
+import (
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+type link struct {
+	Text string
+	URL  string
+}
+
+type fragment struct {
+	Text string
+	// Kind is static, dynamic or special for a parameter, empty for the text of the step.
+	Kind string
+}
+
+type table struct {
+	Headers []string
+	Rows    [][]string
+	// Source is the file of an external data table.
+	Source string
+}
+
+type step struct {
+	Fragments  []fragment
+	Table      *table
+	ConceptURL string
+	Steps      []*step
+}
+
+// item is a comment, data table, step, scenario or the teardown heading, in the order of the spec file.
+type item struct {
+	Comment  string
+	Table    *table
+	Step     *step
+	Scenario *scenario
+	Teardown bool
+}
+
+type scenario struct {
+	ID      string
+	Heading string
+	Tags    []string
+	Items   []*item
+}
+
+type specPage struct {
+	Root      string
+	Title     string
+	File      string
+	URL       string
+	Tags      []string
+	Items     []*item
+	Scenarios []*scenario
+}
+
+type concept struct {
+	ID     string
+	File   string
+	Step   *step
+	Steps  []*step
+	UsedBy []link
+	value  *gauge.Step
+}
+
+type conceptsPage struct {
+	Root     string
+	Title    string
+	Concepts []*concept
+}
+
+type tag struct {
+	Name    string
+	ID      string
+	Entries []link
+}
+
+// searchEntry is a spec, scenario or concept, with the text it is found by.
+type searchEntry struct {
+	Title string `json:"title"`
+	Kind  string `json:"kind"`
+	URL   string `json:"url"`
+	Text  string `json:"text"`
+}
+
+type indexPage struct {
+	Root   string
+	Title  string
+	Specs  []*specPage
+	Tags   []*tag
+	Search []searchEntry
+}
+
+// site is the view of the specs and concepts of a project, which is rendered to the pages of the documentation.
+type site struct {
+	index    *indexPage
+	specs    []*specPage
+	concepts *conceptsPage
+	// conceptsByValue are the concepts by the value of their step.
+	conceptsByValue map[string]*concept
+	tags            map[string]*tag
+}
+
+func newSite(specs []*gauge.Specification, dictionary *gauge.ConceptDictionary, projectRoot string) *site {
+	s := &site{
+		index:           &indexPage{Title: "Specifications"},
+		concepts:        &conceptsPage{Title: "Concepts"},
+		conceptsByValue: make(map[string]*concept),
+		tags:            make(map[string]*tag),
+	}
+	ids := make(map[string]int)
+	for _, c := range dictionary.ConceptsMap {
+		s.concepts.Concepts = append(s.concepts.Concepts, &concept{File: relPath(projectRoot, c.FileName), value: c.ConceptStep})
+	}
+	sort.Slice(s.concepts.Concepts, func(i, j int) bool {
+		return s.concepts.Concepts[i].value.LineText < s.concepts.Concepts[j].value.LineText
+	})
+	for _, c := range s.concepts.Concepts {
+		c.ID = uniqueID(ids, "concept-"+slug(c.value.Value))
+		s.conceptsByValue[c.value.Value] = c
+	}
+	sort.Slice(specs, func(i, j int) bool { return specs[i].FileName < specs[j].FileName })
+	for _, spec := range specs {
+		s.addSpec(spec, projectRoot)
+	}
+	for _, c := range s.concepts.Concepts {
+		c.Step = s.newStep(c.value, "", nil)
+		for _, cs := range c.value.ConceptSteps {
+			c.Steps = append(c.Steps, s.newStep(cs, "", nil))
+		}
+		s.index.Search = append(s.index.Search, searchEntry{Title: stepText(c.Step), Kind: "concept", URL: "concepts.html#" + c.ID, Text: searchText(c.File, stepsText(c.Steps))})
+	}
+	var names []string
+	for name := range s.tags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		s.index.Tags = append(s.index.Tags, s.tags[name])
+	}
+	return s
+}
+
+func (s *site) addSpec(spec *gauge.Specification, projectRoot string) {
+	file := relPath(projectRoot, spec.FileName)
+	url := strings.TrimSuffix(file, filepath.Ext(file)) + ".html"
+	if url == "index.html" || url == "concepts.html" {
+		url = "spec-" + url
+	}
+	p := &specPage{
+		Root:  strings.Repeat("../", strings.Count(url, "/")),
+		Title: spec.Heading.Value,
+		File:  file,
+		URL:   url,
+	}
+	if spec.Tags != nil {
+		p.Tags = spec.Tags.Values()
+	}
+	s.addTagged(p.Tags, link{Text: p.Title, URL: url})
+	var text []string
+	ids := make(map[string]int)
+	for _, i := range spec.Items {
+		switch v := i.(type) {
+		case *gauge.Comment:
+			p.Items = appendComment(p.Items, v.Value)
+		case *gauge.DataTable:
+			p.Items = append(p.Items, &item{Table: newDataTable(v)})
+		case *gauge.Step:
+			st := s.newStep(v, p.Root, &link{Text: p.Title, URL: url})
+			text = append(text, stepText(st))
+			p.Items = append(p.Items, &item{Step: st})
+		case *gauge.TearDown:
+			p.Items = append(p.Items, &item{Teardown: true})
+		case *gauge.Scenario:
+			sc := s.newScenario(v, p, uniqueID(ids, "scenario-"+slug(v.Heading.Value)))
+			p.Scenarios = append(p.Scenarios, sc)
+			p.Items = append(p.Items, &item{Scenario: sc})
+		}
+	}
+	s.specs = append(s.specs, p)
+	s.index.Specs = append(s.index.Specs, p)
+	s.index.Search = append(s.index.Search, searchEntry{Title: p.Title, Kind: "spec", URL: url, Text: searchText(file, strings.Join(p.Tags, " "), strings.Join(text, " "))})
+}
+
+func (s *site) newScenario(scn *gauge.Scenario, p *specPage, id string) *scenario {
+	sc := &scenario{ID: id, Heading: scn.Heading.Value}
+	if scn.Tags != nil {
+		sc.Tags = scn.Tags.Values()
+	}
+	url := p.URL + "#" + id
+	s.addTagged(sc.Tags, link{Text: p.Title + " / " + sc.Heading, URL: url})
+	var text []string
+	for _, i := range scn.Items {
+		switch v := i.(type) {
+		case *gauge.Comment:
+			sc.Items = appendComment(sc.Items, v.Value)
+		case *gauge.DataTable:
+			sc.Items = append(sc.Items, &item{Table: newTable(&v.Table)})
+		case *gauge.Step:
+			st := s.newStep(v, p.Root, &link{Text: p.Title + " / " + sc.Heading, URL: url})
+			text = append(text, stepsText([]*step{st}))
+			sc.Items = append(sc.Items, &item{Step: st})
+		}
+	}
+	s.index.Search = append(s.index.Search, searchEntry{Title: sc.Heading, Kind: "scenario", URL: url, Text: searchText(p.Title, strings.Join(sc.Tags, " "), strings.Join(text, " "))})
+	return sc
+}
+
+// addTagged adds the link to the index of each of the tags.
+func (s *site) addTagged(tags []string, l link) {
+	seen := make(map[string]bool)
+	for _, name := range tags {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		t, ok := s.tags[name]
+		if !ok {
+			t = &tag{Name: name, ID: "tag-" + slug(name)}
+			s.tags[name] = t
+		}
+		t.Entries = append(t.Entries, l)
+	}
+}
+
+// newStep converts the step, a concept is linked to the concepts page and its steps are expanded. usedBy is recorded
+// as a usage of the concept.
+func (s *site) newStep(st *gauge.Step, root string, usedBy *link) *step {
+	v := &step{}
+	text := st.Value
+	for _, arg := range st.Args {
+		i := strings.Index(text, gauge.ParameterPlaceholder)
+		if i < 0 {
+			break
+		}
+		if text[:i] != "" {
+			v.Fragments = append(v.Fragments, fragment{Text: text[:i]})
+		}
+		text = text[i+len(gauge.ParameterPlaceholder):]
+		switch arg.ArgType {
+		case gauge.TableArg:
+			v.Table = newTable(&arg.Table)
+		case gauge.Dynamic, gauge.SpecialString, gauge.SpecialTable:
+			v.Fragments = append(v.Fragments, fragment{Text: "<" + arg.Name + ">", Kind: "dynamic"})
+		default:
+			v.Fragments = append(v.Fragments, fragment{Text: `"` + arg.Value + `"`, Kind: "static"})
+		}
+	}
+	if text != "" {
+		v.Fragments = append(v.Fragments, fragment{Text: text})
+	}
+	if !st.IsConcept {
+		return v
+	}
+	if c, ok := s.conceptsByValue[st.Value]; ok {
+		v.ConceptURL = root + "concepts.html#" + c.ID
+		if usedBy != nil && (len(c.UsedBy) == 0 || c.UsedBy[len(c.UsedBy)-1] != *usedBy) {
+			c.UsedBy = append(c.UsedBy, *usedBy)
+		}
+	}
+	for _, cs := range st.ConceptSteps {
+		v.Steps = append(v.Steps, s.newStep(cs, root, usedBy))
+	}
+	return v
+}
+
+func newTable(t *gauge.Table) *table {
+	return &table{Headers: t.Headers, Rows: t.Rows()}
+}
+
+func newDataTable(t *gauge.DataTable) *table {
+	v := newTable(&t.Table)
+	if t.IsExternal {
+		v.Source = strings.TrimSpace(strings.TrimPrefix(t.Value, "table:"))
+	}
+	return v
+}
+
+// appendComment joins consecutive comment lines into one paragraph.
+func appendComment(items []*item, comment string) []*item {
+	comment = strings.TrimSpace(comment)
+	if comment == "" {
+		return items
+	}
+	if len(items) > 0 && items[len(items)-1].Comment != "" {
+		items[len(items)-1].Comment += "\n" + comment
+		return items
+	}
+	return append(items, &item{Comment: comment})
+}
+
+func stepText(st *step) string {
+	var b strings.Builder
+	for _, f := range st.Fragments {
+		b.WriteString(f.Text)
+	}
+	return b.String()
+}
+
+// stepsText is the text of the steps, including the steps of concepts.
+func stepsText(steps []*step) string {
+	var text []string
+	for _, st := range steps {
+		text = append(text, stepText(st))
+		if len(st.Steps) > 0 {
+			text = append(text, stepsText(st.Steps))
+		}
+	}
+	return strings.Join(text, " ")
+}
+
+func searchText(text ...string) string {
+	var words []string
+	for _, t := range text {
+		if t != "" {
+			words = append(words, t)
+		}
+	}
+	return strings.ToLower(strings.Join(words, " "))
+}
+
+func relPath(root, file string) string {
+	if rel, err := filepath.Rel(root, file); err == nil && !strings.HasPrefix(rel, "..") {
+		return filepath.ToSlash(rel)
+	}
+	return filepath.Base(file)
+}
+
+// slug is the text in lower case with every run of other characters than letters and digits replaced by a hyphen.
+func slug(text string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(text) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r > 127 {
+			b.WriteRune(r)
+			hyphen = false
+		} else if !hyphen && b.Len() > 0 {
+			b.WriteRune('-')
+			hyphen = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
+
+func uniqueID(ids map[string]int, id string) string {
+	ids[id]++
+	if n := ids[id]; n > 1 {
+		return id + "-" + strconv.Itoa(n)
+	}
+	return id
+}
@@ -0,0 +1,164 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package docs
+
+import "html/template"
+
+var templates = template.Must(template.New("docs").Parse(`
+{{define "header"}}<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<link rel="stylesheet" href="{{.Root}}style.css">
+</head>
+<body>
+<nav>
+<a href="{{.Root}}index.html">Specifications</a>
+<a href="{{.Root}}index.html#tags">Tags</a>
+<a href="{{.Root}}concepts.html">Concepts</a>
+<form action="{{.Root}}index.html"><input type="search" name="q" placeholder="Search"></form>
+</nav>
+<main>
+{{end}}
+
+{{define "footer"}}</main>
+</body>
+</html>
+{{end}}
+
+{{define "tags"}}{{if .}}<ul class="tags">{{range .}}<li>{{.}}</li>{{end}}</ul>{{end}}{{end}}
+
+{{define "table"}}{{if .Source}}<p class="source">table: {{.Source}}</p>{{end}}
+<table>
+<thead><tr>{{range .Headers}}<th>{{.}}</th>{{end}}</tr></thead>
+<tbody>{{range .Rows}}<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>{{end}}</tbody>
+</table>
+{{end}}
+
+{{define "stepText"}}{{range .Fragments}}{{if .Kind}}<span class="{{.Kind}}">{{.Text}}</span>{{else}}{{.Text}}{{end}}{{end}}{{end}}
+
+{{define "step"}}{{if .ConceptURL}}<li class="concept"><details><summary>{{template "stepText" .}} <a href="{{.ConceptURL}}">concept</a></summary>
+<ul class="steps">{{range .Steps}}{{template "step" .}}{{end}}</ul>
+</details>{{if .Table}}{{template "table" .Table}}{{end}}</li>
+{{else}}<li>{{template "stepText" .}}{{if .Table}}{{template "table" .Table}}{{end}}</li>
+{{end}}{{end}}
+
+{{define "items"}}{{range .}}{{if .Comment}}<p class="comment">{{.Comment}}</p>
+{{else if .Table}}{{template "table" .Table}}
+{{else if .Step}}<ul class="steps">{{template "step" .Step}}</ul>
+{{else if .Teardown}}<h3>Teardown</h3>
+{{else if .Scenario}}<section id="{{.Scenario.ID}}">
+<h2><a href="#{{.Scenario.ID}}">{{.Scenario.Heading}}</a></h2>
+{{template "tags" .Scenario.Tags}}
+{{template "items" .Scenario.Items}}</section>
+{{end}}{{end}}{{end}}
+
+{{define "spec"}}{{template "header" .}}
+<h1>{{.Title}}</h1>
+<p class="source">{{.File}}</p>
+{{template "tags" .Tags}}
+{{if .Scenarios}}<ol class="toc">{{range .Scenarios}}<li><a href="#{{.ID}}">{{.Heading}}</a></li>{{end}}</ol>{{end}}
+{{template "items" .Items}}
+{{template "footer" .}}{{end}}
+
+{{define "concepts"}}{{template "header" .}}
+<h1>Concepts</h1>
+{{range .Concepts}}<section id="{{.ID}}">
+<h2><a href="#{{.ID}}">{{template "stepText" .Step}}</a></h2>
+<p class="source">{{.File}}</p>
+<ul class="steps">{{range .Steps}}{{template "step" .}}{{end}}</ul>
+{{if .UsedBy}}<h3>Used in</h3>
+<ul>{{range .UsedBy}}<li><a href="{{.URL}}">{{.Text}}</a></li>{{end}}</ul>{{end}}
+</section>
+{{else}}<p>There are no concepts.</p>
+{{end}}
+{{template "footer" .}}{{end}}
+
+{{define "index"}}{{template "header" .}}
+<section id="results" hidden>
+<h1>Search results</h1>
+<ul id="matches"></ul>
+</section>
+<section id="specs">
+<h1>Specifications</h1>
+<ul>{{range .Specs}}<li><a href="{{.URL}}">{{.Title}}</a> <span class="source">{{.File}}</span>{{template "tags" .Tags}}</li>
+{{end}}</ul>
+</section>
+<section id="tags">
+<h1>Tags</h1>
+{{range .Tags}}<h2 id="{{.ID}}">{{.Name}}</h2>
+<ul>{{range .Entries}}<li><a href="{{.URL}}">{{.Text}}</a></li>{{end}}</ul>
+{{else}}<p>There are no tags.</p>
+{{end}}
+</section>
+<script>
+var entries = {{.Search}};
+var input = document.querySelector("input[name=q]");
+function search(q) {
+  var words = q.toLowerCase().split(/\s+/).filter(function (w) { return w; });
+  var matches = document.getElementById("matches");
+  matches.innerHTML = "";
+  document.getElementById("results").hidden = words.length === 0;
+  document.getElementById("specs").hidden = words.length > 0;
+  document.getElementById("tags").hidden = words.length > 0;
+  entries.filter(function (e) {
+    var text = e.title.toLowerCase() + " " + e.text;
+    return words.every(function (w) { return text.indexOf(w) >= 0; });
+  }).forEach(function (e) {
+    var li = document.createElement("li");
+    var a = document.createElement("a");
+    a.href = e.url;
+    a.textContent = e.title;
+    var kind = document.createElement("span");
+    kind.className = "source";
+    kind.textContent = " " + e.kind;
+    li.appendChild(a);
+    li.appendChild(kind);
+    matches.appendChild(li);
+  });
+}
+input.value = new URLSearchParams(window.location.search).get("q") || "";
+input.addEventListener("input", function () { search(input.value); });
+search(input.value);
+</script>
+{{template "footer" .}}{{end}}
+`))
+
+const style = `body { margin: 0; font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; color: #24292e; line-height: 1.5; }
+nav { display: flex; align-items: center; gap: 1.5em; padding: 0.8em 2em; background: #24292e; }
+nav a { color: #fff; text-decoration: none; }
+nav form { margin-left: auto; }
+nav input { padding: 0.3em 0.6em; border-radius: 3px; border: none; }
+main { max-width: 60em; margin: 0 auto; padding: 1em 2em 3em; }
+h2 a { color: inherit; text-decoration: none; }
+section { margin-top: 2em; }
+.source { color: #6a737d; font-size: 0.85em; }
+.comment { white-space: pre-line; }
+.tags { display: inline; padding: 0; }
+.tags li { display: inline-block; margin: 0 0.3em 0.3em 0; padding: 0 0.6em; border-radius: 1em; background: #e1ecf4; font-size: 0.85em; }
+.steps { padding-left: 1.2em; list-style: square; }
+.steps .steps { border-left: 2px solid #e1e4e8; list-style: circle; }
+.static { color: #22863a; }
+.dynamic { color: #6f42c1; }
+.concept summary { cursor: pointer; }
+.concept summary a { font-size: 0.8em; }
+table { border-collapse: collapse; margin: 0.5em 0; }
+th, td { border: 1px solid #dfe2e5; padding: 0.2em 0.8em; text-align: left; }
+th { background: #f6f8fa; }
+`
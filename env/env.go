@@ -25,6 +25,7 @@ import (
 
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/dmotylev/goproperties"
 	"github.com/getgauge/common"
@@ -52,6 +53,11 @@ const (
 	allowMultilineStep             = "allow_multiline_step"
 	allowScenarioDatatable         = "allow_scenario_datatable"
 	allowFilteredParallelExecution = "allow_filtered_parallel_execution"
+	allowScenarioParallelism       = "allow_scenario_parallelism"
+	scenarioTimeout                = "scenario_timeout"
+	replayHooksOnRestart           = "replay_hooks_on_restart"
+	saveExecutionHistory           = "save_execution_history"
+	suiteSpec                      = "suite_spec"
 	enableMultithreading           = "enable_multithreading"
 	useTestGA                      = "use_test_ga"
 	telemetryInterval              = "gauge_telemetry_interval"
@@ -117,10 +123,14 @@ func loadDefaultEnvVars() {
 	addEnvVar(OverwriteReports, "true")
 	addEnvVar(ScreenshotOnFailure, "true")
 	addEnvVar(saveExecutionResult, "false")
+	addEnvVar(saveExecutionHistory, "false")
 	addEnvVar(CsvDelimiter, ",")
 	addEnvVar(allowMultilineStep, "false")
-	addEnvVar(allowScenarioDatatable, "false")
+	addEnvVar(allowScenarioDatatable, "true")
 	addEnvVar(allowFilteredParallelExecution, "false")
+	addEnvVar(allowScenarioParallelism, "false")
+	addEnvVar(replayHooksOnRestart, "false")
+	addEnvVar(suiteSpec, filepath.Join("specs", "_suite.spec"))
 	addEnvVar(useTestGA, "false")
 }
 
@@ -239,9 +249,43 @@ var AllowFilteredParallelExecution = func() bool {
 	return convertToBool(allowFilteredParallelExecution, false)
 }
 
-// AllowScenarioDatatable -feature toggle for datatables in scenario
+// AllowScenarioParallelism - feature toggle for distributing scenarios instead of specs in parallel execution.
+// Every scenario is executed as a spec of its own, the before and after spec hooks still run once for the spec, on the
+// streams executing its first and last scenarios.
+var AllowScenarioParallelism = func() bool {
+	return convertToBool(allowScenarioParallelism, false)
+}
+
+// ScenarioTimeout gives the maximum duration a scenario is allowed to run, 0 meaning no timeout. Its teardown steps and
+// after scenario hook get the same duration of their own, so that they clean up even after the scenario timed out.
+var ScenarioTimeout = func() time.Duration {
+	v := strings.TrimSpace(os.Getenv(scenarioTimeout))
+	if v == "" {
+		return 0
+	}
+	t, err := time.ParseDuration(v)
+	if err != nil {
+		logger.Warningf(true, "Incorrect value for %s in property file. Cannot convert %s to duration.", scenarioTimeout, v)
+		return 0
+	}
+	return t
+}
+
+// ReplayHooksOnRestart tells if the before suite, spec and scenario hooks are run again on a runner restarted after a
+// timeout, so that the rest of the spec can be executed on it. Otherwise the rest of the spec is skipped.
+var ReplayHooksOnRestart = func() bool {
+	return convertToBool(replayHooksOnRestart, false)
+}
+
+// SuiteSpec gives the path of the spec whose contexts run before the suite and whose teardown steps run after it
+var SuiteSpec = func() string {
+	return strings.TrimSpace(os.Getenv(suiteSpec))
+}
+
+// AllowScenarioDatatable tells if a table under a scenario heading is the data table of the scenario, the scenario is
+// executed for each of its rows. It can be turned off to treat such tables as comments.
 var AllowScenarioDatatable = func() bool {
-	return convertToBool(allowScenarioDatatable, false)
+	return convertToBool(allowScenarioDatatable, true)
 }
 
 // AllowMultiLineStep - feature toggle for newline in step text
@@ -254,6 +298,11 @@ var SaveExecutionResult = func() bool {
 	return convertToBool(saveExecutionResult, false)
 }
 
+// SaveExecutionHistory determines if the result of every run should be added to the result history
+var SaveExecutionHistory = func() bool {
+	return convertToBool(saveExecutionHistory, false)
+}
+
 // EnableMultiThreadedExecution determines if threads should be used instead of process
 // for each parallel stream
 var EnableMultiThreadedExecution = func() bool {
@@ -0,0 +1,86 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package execution
+
+import (
+	"errors"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+)
+
+var cancelled int32
+
+var errExecutionCancelled = errors.New(result.ExecutionCancelled)
+
+func isCancelled() bool {
+	return atomic.LoadInt32(&cancelled) == 1
+}
+
+func cancel() {
+	atomic.StoreInt32(&cancelled, 1)
+}
+
+// cancelOnInterrupt cancels the execution on the first interrupt or termination signal. Scenarios being executed stop
+// after the current step, their teardowns and hooks are run and the remaining specs and scenarios are skipped, so that
+// the after suite hook, the plugins and the saved results see a complete, partial run. The runners and the plugins started
+// meanwhile run in process groups of their own, so an interrupt from the terminal reaches only gauge. A second signal kills
+// them and exits immediately. The returned function stops listening to the signals and kills the runners and plugins
+// which are still running.
+func cancelOnInterrupt() func() {
+	atomic.StoreInt32(&cancelled, 0)
+	killProcessGroups := util.EnableProcessGroups()
+	c := make(chan os.Signal, 2)
+	done := make(chan bool)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		select {
+		case <-c:
+			cancel()
+			logger.Infof(true, "\nCancelling execution, the running scenarios are completing their teardowns and hooks. Interrupt again to exit immediately.")
+		case <-done:
+			return
+		}
+		select {
+		case <-c:
+			util.KillProcessGroups()
+			os.Exit(Cancelled)
+		case <-done:
+		}
+	}()
+	return func() {
+		signal.Stop(c)
+		close(done)
+		killProcessGroups()
+	}
+}
+
+// setCancelledInResult marks the scenario skipped because of cancellation, unless it has already failed.
+func setCancelledInResult(res *result.ScenarioResult) {
+	if res.GetFailed() {
+		return
+	}
+	res.ProtoScenario.ExecutionStatus = gauge_messages.ExecutionStatus_SKIPPED
+	res.ProtoScenario.Skipped = true
+	res.ProtoScenario.SkipErrors = append(res.ProtoScenario.SkipErrors, result.ExecutionCancelled)
+}
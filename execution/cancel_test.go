@@ -0,0 +1,111 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package execution
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+)
+
+func newStep(text string) *gauge.Step {
+	return &gauge.Step{
+		Value:     text,
+		LineText:  text,
+		Fragments: []*gauge_messages.Fragment{{FragmentType: gauge_messages.Fragment_Text, Text: text}},
+	}
+}
+
+func protoItemsOf(steps []*gauge.Step) []*gauge_messages.ProtoItem {
+	var items []*gauge_messages.ProtoItem
+	for _, s := range steps {
+		item := gauge.ConvertToProtoItem(s)
+		item.GetStep().StepExecutionResult = &gauge_messages.ProtoStepExecutionResult{}
+		items = append(items, item)
+	}
+	return items
+}
+
+func TestScenarioIsSkippedWhenExecutionIsCancelled(t *testing.T) {
+	cancel()
+	defer atomic.StoreInt32(&cancelled, 0)
+	event.InitRegistry()
+	executed := false
+	r := &mockRunner{ExecuteAndGetStatusFunc: func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		executed = true
+		return &gauge_messages.ProtoExecutionResult{}
+	}}
+	h := &mockPluginHandler{NotifyPluginsfunc: func(m *gauge_messages.Message) {}, GracefullyKillPluginsfunc: func() {}}
+	errMap := gauge.NewBuildErrors()
+	sce := newScenarioExecutor(r, h, &gauge_messages.ExecutionInfo{}, errMap, nil, nil, 0)
+	scenario := &gauge.Scenario{Heading: &gauge.Heading{Value: "A scenario"}, Span: &gauge.Span{Start: 2, End: 10}}
+	scenarioResult := result.NewScenarioResult(gauge.NewProtoScenario(scenario))
+
+	sce.execute(scenario, scenarioResult)
+
+	if executed {
+		t.Error("Expected scenario not to be executed after cancellation")
+	}
+	if !scenarioResult.Cancelled() {
+		t.Errorf("Expected scenario to be skipped as cancelled, got %v %v", scenarioResult.ProtoScenario.ExecutionStatus, scenarioResult.ProtoScenario.SkipErrors)
+	}
+	if len(errMap.ScenarioErrs) != 0 {
+		t.Errorf("Expected the error map shared by the streams to be unchanged, got %v", errMap.ScenarioErrs)
+	}
+}
+
+func TestTeardownsAreExecutedWhenExecutionIsCancelledDuringScenario(t *testing.T) {
+	defer atomic.StoreInt32(&cancelled, 0)
+	event.InitRegistry()
+	var executedSteps []string
+	r := &mockRunner{ExecuteAndGetStatusFunc: func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		if m.MessageType == gauge_messages.Message_ExecuteStep {
+			executedSteps = append(executedSteps, m.ExecuteStepRequest.ParsedStepText)
+			cancel()
+		}
+		return &gauge_messages.ProtoExecutionResult{}
+	}}
+	h := &mockPluginHandler{NotifyPluginsfunc: func(m *gauge_messages.Message) {}, GracefullyKillPluginsfunc: func() {}}
+	steps := []*gauge.Step{newStep("step 1"), newStep("step 2")}
+	teardowns := []*gauge.Step{newStep("teardown")}
+	sce := newScenarioExecutor(r, h, &gauge_messages.ExecutionInfo{}, gauge.NewBuildErrors(), nil, teardowns, 0)
+	scenarioResult := result.NewScenarioResult(&gauge_messages.ProtoScenario{})
+
+	sce.executeSteps(steps, protoItemsOf(steps), scenarioResult, true)
+	sce.executeSteps(teardowns, protoItemsOf(teardowns), scenarioResult, false)
+
+	if len(executedSteps) != 2 || executedSteps[0] != "step 1" || executedSteps[1] != "teardown" {
+		t.Errorf("Expected only step 1 and the teardown to be executed, got %v", executedSteps)
+	}
+	if !scenarioResult.Cancelled() {
+		t.Errorf("Expected scenario to be skipped as cancelled, got %v", scenarioResult.ProtoScenario.ExecutionStatus)
+	}
+}
+
+func TestFailedScenarioIsNotMarkedCancelled(t *testing.T) {
+	scenarioResult := result.NewScenarioResult(&gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_FAILED})
+
+	setCancelledInResult(scenarioResult)
+
+	if !scenarioResult.GetFailed() || scenarioResult.Cancelled() {
+		t.Errorf("Expected failed scenario to remain failed, got %v", scenarioResult.ProtoScenario.ExecutionStatus)
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package execution
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/filter"
+	"github.com/getgauge/gauge/gauge"
+	m "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/validation"
+)
+
+const checkpointFile = "checkpoint.json"
+
+// Resume if true skips the specs and scenarios completed by the previous run, as recorded in its checkpoint,
+// and merges their results with the results of the run.
+var Resume bool
+
+// checkpoint holds the results of the specs and scenarios completed so far. It is saved in .gauge folder during
+// the execution and removed once the suite ends, so that an interrupted run can be resumed.
+// Specs with a data table are recorded only once all their rows are executed.
+type checkpoint struct {
+	// Scenarios holds the keys of completed scenarios, see filter.ScenarioKey
+	Scenarios []string             `json:"scenarios"`
+	Results   []*m.ProtoSpecResult `json:"results"`
+	completed map[string]bool
+	running   map[string]*m.ProtoSpec
+	tables    map[string]*tableRows
+}
+
+// tableRows holds the executed rows of a spec with a data table, every row is executed as a spec of its own.
+type tableRows struct {
+	count   int
+	specs   []*gauge.Specification
+	results []*result.SpecResult
+}
+
+func newCheckpoint() *checkpoint {
+	return &checkpoint{completed: make(map[string]bool), running: make(map[string]*m.ProtoSpec), tables: make(map[string]*tableRows)}
+}
+
+// trackTableRows counts the rows of the specs with a data table, among the specs to be executed.
+func (cp *checkpoint) trackTableRows(specs []*gauge.Specification) {
+	for _, spec := range specs {
+		if !spec.DataTable.IsInitialized() {
+			continue
+		}
+		if _, ok := cp.tables[spec.FileName]; !ok {
+			cp.tables[spec.FileName] = &tableRows{}
+		}
+		cp.tables[spec.FileName].count++
+	}
+}
+
+func (cp *checkpoint) complete(key string) {
+	if !cp.completed[key] {
+		cp.completed[key] = true
+		cp.Scenarios = append(cp.Scenarios, key)
+	}
+}
+
+// addScenario records the result of a scenario of a spec still being executed by the stream.
+// The result of a retried scenario replaces the result of its previous attempt.
+func (cp *checkpoint) addScenario(info m.ExecutionInfo, stream int, res *result.ScenarioResult) {
+	spec := info.GetCurrentSpec()
+	id := runningSpecID(spec.GetFileName(), stream)
+	protoSpec, ok := cp.running[id]
+	if !ok {
+		protoSpec = &m.ProtoSpec{FileName: spec.GetFileName(), SpecHeading: spec.GetName(), Tags: spec.GetTags()}
+		cp.running[id] = protoSpec
+	}
+	item := &m.ProtoItem{ItemType: m.ProtoItem_Scenario, Scenario: res.ProtoScenario}
+	for i, existing := range protoSpec.Items {
+		if existing.GetScenario().GetScenarioHeading() == res.ProtoScenario.GetScenarioHeading() {
+			protoSpec.Items[i] = item
+			return
+		}
+	}
+	protoSpec.Items = append(protoSpec.Items, item)
+	cp.complete(filter.ScenarioKey(spec.GetFileName(), res.ProtoScenario.GetScenarioHeading()))
+}
+
+// addSpec records the result of a spec, which replaces the scenarios of it recorded while it was executed by the stream.
+func (cp *checkpoint) addSpec(spec *gauge.Specification, stream int, res *result.SpecResult) {
+	delete(cp.running, runningSpecID(spec.FileName, stream))
+	for _, s := range spec.Scenarios {
+		cp.complete(filter.ScenarioKey(spec.FileName, s.Heading.Value))
+	}
+	cp.Results = append(cp.Results, toProtoSpecResult(res))
+}
+
+// addTableRow records the result of a data table row of a spec. The spec is recorded once all its rows are executed,
+// it returns true if so.
+func (cp *checkpoint) addTableRow(spec *gauge.Specification, res *result.SpecResult) bool {
+	rows := cp.tables[spec.FileName]
+	rows.specs = append(rows.specs, spec)
+	rows.results = append(rows.results, res)
+	if len(rows.results) < rows.count {
+		return false
+	}
+	for _, s := range rows.specs {
+		for _, scn := range s.Scenarios {
+			cp.complete(filter.ScenarioKey(spec.FileName, scn.Heading.Value))
+		}
+	}
+	cp.Results = append(cp.Results, toProtoSpecResult(mergeSpecResults(rows.results, InParallel)))
+	delete(cp.tables, spec.FileName)
+	return true
+}
+
+// results gives the results of completed specs and of completed scenarios of specs still being executed.
+func (cp *checkpoint) results() []*m.ProtoSpecResult {
+	results := append([]*m.ProtoSpecResult{}, cp.Results...)
+	for _, spec := range cp.running {
+		res := &m.ProtoSpecResult{ProtoSpec: spec}
+		for _, item := range spec.Items {
+			res.ScenarioCount++
+			res.ExecutionTime += item.GetScenario().GetExecutionTime()
+			switch item.GetScenario().GetExecutionStatus() {
+			case m.ExecutionStatus_FAILED:
+				res.ScenarioFailedCount++
+				res.Failed = true
+			case m.ExecutionStatus_SKIPPED:
+				res.ScenarioSkippedCount++
+			}
+		}
+		res.Skipped = res.ScenarioSkippedCount == res.ScenarioCount
+		results = append(results, res)
+	}
+	return results
+}
+
+// pending removes the completed scenarios from the specs, specs whose scenarios are all completed are removed.
+func (cp *checkpoint) pending(specs []*gauge.Specification) []*gauge.Specification {
+	var pendingSpecs []*gauge.Specification
+	for _, spec := range specs {
+		if len(spec.Scenarios) == 0 {
+			pendingSpecs = append(pendingSpecs, spec)
+			continue
+		}
+		s, _ := spec.Filter(&scenarioFilterBasedOnCheckpoint{spec.FileName, cp.completed})
+		if len(s.Scenarios) != 0 {
+			pendingSpecs = append(pendingSpecs, s)
+		}
+	}
+	return pendingSpecs
+}
+
+// resumedResults gives the recorded results, which are merged with the results of the resumed run.
+func (cp *checkpoint) resumedResults() []*result.SpecResult {
+	var results []*result.SpecResult
+	for _, res := range cp.results() {
+		results = append(results, convertFromProtoSpecResult(res))
+	}
+	return results
+}
+
+type scenarioFilterBasedOnCheckpoint struct {
+	specFile  string
+	completed map[string]bool
+}
+
+func (f *scenarioFilterBasedOnCheckpoint) Filter(item gauge.Item) bool {
+	if item.Kind() != gauge.ScenarioKind {
+		return false
+	}
+	return f.completed[filter.ScenarioKey(f.specFile, item.(*gauge.Scenario).Heading.Value)]
+}
+
+func runningSpecID(specFile string, stream int) string {
+	return fmt.Sprintf("%s#%d", specFile, stream)
+}
+
+func toProtoSpecResult(res *result.SpecResult) *m.ProtoSpecResult {
+	return &m.ProtoSpecResult{
+		ProtoSpec:            res.ProtoSpec,
+		ScenarioCount:        int32(res.ScenarioCount),
+		ScenarioFailedCount:  int32(res.ScenarioFailedCount),
+		Failed:               res.IsFailed,
+		FailedDataTableRows:  res.FailedDataTableRows,
+		ExecutionTime:        res.ExecutionTime,
+		Skipped:              res.Skipped,
+		ScenarioSkippedCount: int32(res.ScenarioSkippedCount),
+		Errors:               res.Errors,
+	}
+}
+
+// ListenAndSaveCheckpoint listens to execution events and saves the results of completed specs and scenarios
+// to the checkpoint in .gauge folder, starting with the results in cp. The checkpoint is removed once the suite ends,
+// unless the execution was cancelled.
+func ListenAndSaveCheckpoint(wg *sync.WaitGroup, cp *checkpoint, specs []*gauge.Specification) {
+	cp.trackTableRows(specs)
+	ch := make(chan event.ExecutionEvent, 0)
+	event.Register(ch, event.ScenarioEnd, event.SpecEnd, event.SuiteEnd)
+	wg.Add(1)
+
+	go func() {
+		for {
+			e := <-ch
+			switch e.Topic {
+			case event.ScenarioEnd:
+				res := e.Result.(*result.ScenarioResult)
+				if res.Cancelled() || cp.tables[e.ExecutionInfo.GetCurrentSpec().GetFileName()] != nil || e.Item.(*gauge.Scenario).ScenarioDataTableRow.IsInitialized() {
+					continue
+				}
+				cp.addScenario(e.ExecutionInfo, e.Stream, res)
+				writeCheckpoint(cp)
+			case event.SpecEnd:
+				spec := e.Item.(*gauge.Specification)
+				// scenarios of a spec cancelled midway are recorded individually, a data table row cancelled midway is not recorded
+				if isCancelled() {
+					continue
+				}
+				if cp.tables[spec.FileName] != nil {
+					if cp.addTableRow(spec, e.Result.(*result.SpecResult)) {
+						writeCheckpoint(cp)
+					}
+					continue
+				}
+				cp.addSpec(spec, e.Stream, e.Result.(*result.SpecResult))
+				writeCheckpoint(cp)
+			case event.SuiteEnd:
+				if !isCancelled() {
+					removeCheckpoint()
+				}
+				wg.Done()
+				return
+			}
+		}
+	}()
+}
+
+func checkpointPath() string {
+	return filepath.Join(config.ProjectRoot, dotGauge, checkpointFile)
+}
+
+func readCheckpoint() (*checkpoint, error) {
+	contents, err := ioutil.ReadFile(checkpointPath())
+	if err != nil {
+		return nil, err
+	}
+	cp := newCheckpoint()
+	if err = json.Unmarshal(contents, cp); err != nil {
+		return nil, err
+	}
+	for _, key := range cp.Scenarios {
+		cp.completed[key] = true
+	}
+	return cp, nil
+}
+
+func writeCheckpoint(cp *checkpoint) {
+	dotGaugeDir := filepath.Join(config.ProjectRoot, dotGauge)
+	if err := os.MkdirAll(dotGaugeDir, common.NewDirectoryPermissions); err != nil {
+		logger.Errorf(true, "Failed to create directory in %s. Reason: %s", dotGaugeDir, err.Error())
+		return
+	}
+	saved := &checkpoint{Scenarios: cp.Scenarios, Results: cp.results()}
+	contents, err := json.Marshal(saved)
+	if err != nil {
+		logger.Errorf(true, "Unable to marshal checkpoint, skipping save. %s", err.Error())
+		return
+	}
+	if err = ioutil.WriteFile(checkpointPath(), contents, common.NewFilePermissions); err != nil {
+		logger.Errorf(true, "Failed to write to %s. Reason: %s", checkpointPath(), err.Error())
+	}
+}
+
+func removeCheckpoint() {
+	if err := os.Remove(checkpointPath()); err != nil && !os.IsNotExist(err) {
+		logger.Warningf(true, "Failed to remove %s. Reason: %s", checkpointPath(), err.Error())
+	}
+}
+
+// loadCheckpoint reads the checkpoint of the previous run to resume it, execution starts afresh if there is none.
+func loadCheckpoint() *checkpoint {
+	cp, err := readCheckpoint()
+	if err != nil {
+		if !os.IsNotExist(err) {
+			logger.Warningf(true, "Ignoring invalid checkpoint, executing all specs. Reason: %s", err.Error())
+		} else {
+			logger.Infof(true, "No interrupted run found to resume, executing all specs.")
+		}
+		return newCheckpoint()
+	}
+	logger.Infof(true, "Resuming previous run, skipping %d completed scenario(s).", len(cp.Scenarios))
+	return cp
+}
+
+// finishResumedRun ends the suite with the results in the checkpoint when the previous run was interrupted after completing all scenarios.
+func finishResumedRun(res *validation.ValidationResult, cp *checkpoint) int {
+	res.Runner.Kill()
+	removeCheckpoint()
+	logger.Infof(true, "All scenarios were completed by the previous run.")
+	suiteRes := result.NewSuiteResult(ExecuteTags, time.Now())
+	suiteRes.AddSpecResults(cp.resumedResults())
+	return finishMergedRun(mergeDataTableSpecResults(suiteRes, InParallel), res.ParseOk)
+}
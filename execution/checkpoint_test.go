@@ -0,0 +1,161 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package execution
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	gm "github.com/getgauge/gauge/gauge_messages"
+)
+
+func specWithScenarios(file string, headings ...string) *gauge.Specification {
+	spec := &gauge.Specification{FileName: file, Heading: &gauge.Heading{Value: "spec"}}
+	for i, h := range headings {
+		s := &gauge.Scenario{Heading: &gauge.Heading{Value: h, LineNo: i + 2}, Span: &gauge.Span{Start: i + 2, End: i + 2}}
+		spec.Scenarios = append(spec.Scenarios, s)
+		spec.Items = append(spec.Items, s)
+	}
+	return spec
+}
+
+func executionInfoOf(file string) gm.ExecutionInfo {
+	return gm.ExecutionInfo{CurrentSpec: &gm.SpecInfo{FileName: file, Name: "spec"}}
+}
+
+func TestCheckpointSkipsCompletedScenarios(t *testing.T) {
+	cp := newCheckpoint()
+	cp.addScenario(executionInfoOf("spec1"), 0, scenarioResultOf(scenarioOf("scenario 1", gm.ExecutionStatus_FAILED, 5), 0))
+	cp.addSpec(specWithScenarios("spec2", "scenario 1"), 0, &result.SpecResult{ProtoSpec: &gm.ProtoSpec{FileName: "spec2"}, ScenarioCount: 1})
+
+	pending := cp.pending([]*gauge.Specification{specWithScenarios("spec1", "scenario 1", "scenario 2"), specWithScenarios("spec2", "scenario 1")})
+
+	if len(pending) != 1 || pending[0].FileName != "spec1" {
+		t.Fatalf("Expected only spec1 to be pending, got %v", pending)
+	}
+	if len(pending[0].Scenarios) != 1 || pending[0].Scenarios[0].Heading.Value != "scenario 2" {
+		t.Errorf("Expected only scenario 2 of spec1 to be pending, got %v", pending[0].Scenarios)
+	}
+}
+
+func TestCheckpointResultsOfRunningSpec(t *testing.T) {
+	cp := newCheckpoint()
+	cp.addScenario(executionInfoOf("spec1"), 1, scenarioResultOf(scenarioOf("scenario 1", gm.ExecutionStatus_FAILED, 5), 0))
+	cp.addScenario(executionInfoOf("spec1"), 1, scenarioResultOf(scenarioOf("scenario 1", gm.ExecutionStatus_PASSED, 5), 0))
+	cp.addScenario(executionInfoOf("spec1"), 1, scenarioResultOf(scenarioOf("scenario 2", gm.ExecutionStatus_SKIPPED, 5), 0))
+
+	results := cp.results()
+
+	if len(results) != 1 {
+		t.Fatalf("Expected results of one spec, got %d", len(results))
+	}
+	got := results[0]
+	if got.ScenarioCount != 2 || got.ScenarioFailedCount != 0 || got.ScenarioSkippedCount != 1 || got.Failed || got.ExecutionTime != 10 {
+		t.Errorf("Unexpected result of running spec %v", got)
+	}
+	if !reflect.DeepEqual(cp.Scenarios, []string{"spec1:scenario 1", "spec1:scenario 2"}) {
+		t.Errorf("Expected completed scenarios to be recorded once, got %v", cp.Scenarios)
+	}
+}
+
+func TestCheckpointSpecResultReplacesResultsOfItsScenarios(t *testing.T) {
+	cp := newCheckpoint()
+	cp.addScenario(executionInfoOf("spec1"), 1, scenarioResultOf(scenarioOf("scenario 1", gm.ExecutionStatus_PASSED, 5), 0))
+	cp.addScenario(executionInfoOf("spec1"), 2, scenarioResultOf(scenarioOf("scenario 2", gm.ExecutionStatus_PASSED, 5), 0))
+	specRes := &result.SpecResult{ProtoSpec: &gm.ProtoSpec{FileName: "spec1"}, ScenarioCount: 1}
+
+	cp.addSpec(specWithScenarios("spec1", "scenario 1"), 1, specRes)
+
+	results := cp.results()
+	if len(results) != 2 {
+		t.Fatalf("Expected result of the spec and of the scenario executed by other stream, got %d", len(results))
+	}
+	if results[0].ProtoSpec != specRes.ProtoSpec || results[1].ScenarioCount != 1 {
+		t.Errorf("Unexpected results %v", results)
+	}
+}
+
+func TestCheckpointIsSavedAndRead(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checkpoint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	old := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = old }()
+	cp := newCheckpoint()
+	cp.addScenario(executionInfoOf("spec1"), 0, scenarioResultOf(scenarioOf("scenario 1", gm.ExecutionStatus_FAILED, 5), 0))
+
+	writeCheckpoint(cp)
+	got, err := readCheckpoint()
+
+	if err != nil {
+		t.Fatalf("Expected checkpoint to be read, got %s", err.Error())
+	}
+	if !got.completed["spec1:scenario 1"] || len(got.Results) != 1 || got.Results[0].ScenarioFailedCount != 1 {
+		t.Errorf("Unexpected checkpoint %+v", got)
+	}
+	removeCheckpoint()
+	if _, err := readCheckpoint(); !os.IsNotExist(err) {
+		t.Errorf("Expected checkpoint to be removed, got %v", err)
+	}
+}
+
+func dataTableRowSpec(file string, row int) *gauge.Specification {
+	spec := specWithScenarios(file, "scenario")
+	spec.DataTable.Table.AddHeaders([]string{"id"})
+	spec.DataTable.Table.AddRowValues(spec.DataTable.Table.CreateTableCells([]string{fmt.Sprint(row)}))
+	return spec
+}
+
+func dataTableRowResult(file string, row int, status gm.ExecutionStatus) *result.SpecResult {
+	return &result.SpecResult{ProtoSpec: &gm.ProtoSpec{FileName: file, IsTableDriven: true, Items: []*gm.ProtoItem{
+		{ItemType: gm.ProtoItem_Table, Table: &gm.ProtoTable{Headers: &gm.ProtoTableRow{Cells: []string{"id"}}, Rows: []*gm.ProtoTableRow{{Cells: []string{fmt.Sprint(row)}}}}},
+		{ItemType: gm.ProtoItem_TableDrivenScenario, TableDrivenScenario: &gm.ProtoTableDrivenScenario{Scenario: &gm.ProtoScenario{ScenarioHeading: "scenario", ExecutionStatus: status}}},
+	}}, ScenarioCount: 1, IsFailed: status == gm.ExecutionStatus_FAILED}
+}
+
+func TestCheckpointRecordsDataTableSpecOnceAllRowsAreExecuted(t *testing.T) {
+	cp := newCheckpoint()
+	specs := []*gauge.Specification{dataTableRowSpec("spec1", 1), dataTableRowSpec("spec1", 2)}
+	cp.trackTableRows(specs)
+
+	if cp.addTableRow(specs[0], dataTableRowResult("spec1", 1, gm.ExecutionStatus_PASSED)) {
+		t.Fatalf("Expected the spec not to be recorded before all rows are executed")
+	}
+	if len(cp.results()) != 0 || len(cp.pending(specs)) != 2 {
+		t.Fatalf("Expected no results and all rows pending, got %v", cp.results())
+	}
+	if !cp.addTableRow(specs[1], dataTableRowResult("spec1", 2, gm.ExecutionStatus_FAILED)) {
+		t.Fatalf("Expected the spec to be recorded once its last row is executed")
+	}
+
+	results := cp.results()
+	if len(results) != 1 || results[0].ScenarioCount != 2 || results[0].ScenarioFailedCount != 1 || !results[0].Failed {
+		t.Errorf("Expected merged result of all rows of the spec, got %v", results)
+	}
+	if pending := cp.pending(specs); len(pending) != 0 {
+		t.Errorf("Expected no rows pending, got %v", pending)
+	}
+}
@@ -0,0 +1,343 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package execution
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+)
+
+const dependsTagPrefix = "depends:"
+
+// dependenciesFromTags gives the names in the `depends:<name>` tags. A spec depends on specs by their heading or
+// file name, a scenario depends on scenarios of its spec by their heading.
+func dependenciesFromTags(tags *gauge.Tags) []string {
+	var names []string
+	for _, tag := range getTagValue(tags) {
+		tag = strings.TrimSpace(tag)
+		if !strings.HasPrefix(strings.ToLower(tag), dependsTagPrefix) {
+			continue
+		}
+		if name := strings.TrimSpace(tag[len(dependsTagPrefix):]); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func isSpecNamed(spec *gauge.Specification, name string) bool {
+	return (spec.Heading != nil && spec.Heading.Value == name) || filepath.Base(spec.FileName) == name
+}
+
+func isScenarioNamed(scn *gauge.Scenario, name string) bool {
+	return scn.Heading != nil && scn.Heading.Value == name
+}
+
+// specDependencyGraph gives the indexes of the specs every spec depends on, either through a dependency of the spec
+// or through a dependency of one of its scenarios on a scenario which is in another spec of the same file, as is the
+// case for data table rows and scenario parallelism.
+func specDependencyGraph(specs []*gauge.Specification) [][]int {
+	graph := make([][]int, len(specs))
+	for i, spec := range specs {
+		for _, name := range dependenciesFromTags(spec.Tags) {
+			for j, other := range specs {
+				if other.FileName != spec.FileName && isSpecNamed(other, name) {
+					graph[i] = append(graph[i], j)
+				}
+			}
+		}
+		for _, scn := range spec.Scenarios {
+			for _, name := range dependenciesFromTags(scn.Tags) {
+				for j, other := range specs {
+					if j == i || other.FileName != spec.FileName {
+						continue
+					}
+					for _, o := range other.Scenarios {
+						if isScenarioNamed(o, name) {
+							graph[i] = append(graph[i], j)
+							break
+						}
+					}
+				}
+			}
+		}
+	}
+	return graph
+}
+
+// warnUnknownDependencies reports the dependencies on specs or scenarios which are not part of the execution,
+// they are ignored.
+func warnUnknownDependencies(specs []*gauge.Specification) {
+	for _, spec := range specs {
+		for _, name := range dependenciesFromTags(spec.Tags) {
+			found := false
+			for _, other := range specs {
+				found = found || (other.FileName != spec.FileName && isSpecNamed(other, name))
+			}
+			if !found {
+				logger.Warningf(true, "Ignoring dependency of %s on spec '%s', it is not part of the execution.", spec.FileName, name)
+			}
+		}
+		for _, scn := range spec.Scenarios {
+			for _, name := range dependenciesFromTags(scn.Tags) {
+				found := false
+				for _, other := range specs {
+					for _, o := range other.Scenarios {
+						found = found || (other.FileName == spec.FileName && o != scn && isScenarioNamed(o, name))
+					}
+				}
+				if !found {
+					logger.Warningf(true, "Ignoring dependency of scenario '%s' on scenario '%s', it is not part of the execution.", scn.Heading.Value, name)
+				}
+			}
+		}
+	}
+}
+
+// dependencyOrder gives the order of the nodes of the graph in which every node comes right after the nodes it
+// depends on, keeping the given order otherwise. Dependencies in a cycle are reported with describe and ignored.
+func dependencyOrder(graph [][]int, describe func(i int) string) []int {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make([]int, len(graph))
+	var order []int
+	var visit func(i int)
+	visit = func(i int) {
+		state[i] = visiting
+		for _, j := range graph[i] {
+			switch state[j] {
+			case visiting:
+				logger.Warningf(true, "Ignoring the dependency of %s on %s, they depend on each other.", describe(i), describe(j))
+			case 0:
+				visit(j)
+			}
+		}
+		state[i] = visited
+		order = append(order, i)
+	}
+	for i := range graph {
+		if state[i] == 0 {
+			visit(i)
+		}
+	}
+	return order
+}
+
+// orderByDependencies orders the specs such that every spec comes after the specs it depends on, and the scenarios
+// of every spec such that a scenario comes after the scenarios it depends on.
+func orderByDependencies(specs []*gauge.Specification) []*gauge.Specification {
+	warnUnknownDependencies(specs)
+	for _, spec := range specs {
+		orderScenariosByDependencies(spec)
+	}
+	ordered := make([]*gauge.Specification, 0, len(specs))
+	for _, i := range dependencyOrder(specDependencyGraph(specs), func(i int) string { return specs[i].FileName }) {
+		ordered = append(ordered, specs[i])
+	}
+	return ordered
+}
+
+func orderScenariosByDependencies(spec *gauge.Specification) {
+	scns := spec.Scenarios
+	graph := make([][]int, len(scns))
+	for i, scn := range scns {
+		for _, name := range dependenciesFromTags(scn.Tags) {
+			for j, other := range scns {
+				if j != i && isScenarioNamed(other, name) {
+					graph[i] = append(graph[i], j)
+				}
+			}
+		}
+	}
+	ordered := make([]*gauge.Scenario, 0, len(scns))
+	for _, i := range dependencyOrder(graph, func(i int) string { return fmt.Sprintf("'%s'", scns[i].Heading.Value) }) {
+		ordered = append(ordered, scns[i])
+	}
+	spec.Scenarios = ordered
+}
+
+// dependencyChains groups the ordered specs which depend on each other, directly or through other specs, keeping
+// their order. Every chain has to be executed on the same stream.
+func dependencyChains(specs []*gauge.Specification) [][]*gauge.Specification {
+	graph := specDependencyGraph(specs)
+	chain := make([]int, len(specs))
+	for i := range chain {
+		chain[i] = i
+	}
+	var root func(i int) int
+	root = func(i int) int {
+		if chain[i] != i {
+			chain[i] = root(chain[i])
+		}
+		return chain[i]
+	}
+	for i, deps := range graph {
+		for _, j := range deps {
+			ri, rj := root(i), root(j)
+			if ri < rj {
+				chain[rj] = ri
+			} else {
+				chain[ri] = rj
+			}
+		}
+	}
+	index := make(map[int]int)
+	var chains [][]*gauge.Specification
+	for i, spec := range specs {
+		r := root(i)
+		if _, ok := index[r]; !ok {
+			index[r] = len(chains)
+			chains = append(chains, nil)
+		}
+		chains[index[r]] = append(chains[index[r]], spec)
+	}
+	return chains
+}
+
+// specsByFileName splits a group of the spec collection, which is a dependency chain in parallel execution, into
+// the consecutive specs created from the same file.
+func specsByFileName(specs []*gauge.Specification) [][]*gauge.Specification {
+	var groups [][]*gauge.Specification
+	for i, spec := range specs {
+		if i == 0 || specs[i-1].FileName != spec.FileName {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], spec)
+	}
+	return groups
+}
+
+// distributeChains distributes the specs to streams such that every dependency chain is on one stream. The first spec
+// of every chain is distributed with distribute, the rest of the chain follows it on its stream.
+func distributeChains(specs []*gauge.Specification, distribute func([]*gauge.Specification) []*gauge.SpecCollection) []*gauge.SpecCollection {
+	chains := dependencyChains(specs)
+	heads := make([]*gauge.Specification, len(chains))
+	rest := make(map[*gauge.Specification][]*gauge.Specification)
+	for i, c := range chains {
+		heads[i] = c[0]
+		rest[c[0]] = c[1:]
+	}
+	var streams []*gauge.SpecCollection
+	for _, s := range distribute(heads) {
+		if s == nil {
+			continue
+		}
+		var streamSpecs []*gauge.Specification
+		for _, head := range s.Specs() {
+			streamSpecs = append(append(streamSpecs, head), rest[head]...)
+		}
+		streams = append(streams, gauge.NewSpecCollection(streamSpecs, false))
+	}
+	return streams
+}
+
+// dependencyResults keeps the outcome of the executed specs and scenarios, so that the ones depending on them can
+// be skipped when they did not pass.
+type dependencyResults struct {
+	mutex     sync.Mutex
+	specs     map[string]*specOutcome
+	scenarios map[string]string
+}
+
+type specOutcome struct {
+	spec   *gauge.Specification
+	status string
+}
+
+const (
+	dependencyFailed  = "failed"
+	dependencySkipped = "was skipped"
+)
+
+var dependencies = newDependencyResults()
+
+func newDependencyResults() *dependencyResults {
+	return &dependencyResults{specs: make(map[string]*specOutcome), scenarios: make(map[string]string)}
+}
+
+func scenarioDependencyKey(fileName, heading string) string {
+	return fileName + ":" + heading
+}
+
+func outcome(failed, skipped bool) string {
+	if failed {
+		return dependencyFailed
+	}
+	if skipped {
+		return dependencySkipped
+	}
+	return ""
+}
+
+// recordSpec records the result of a spec. A spec with data table rows did not pass if any of its rows did not.
+func (d *dependencyResults) recordSpec(spec *gauge.Specification, res *result.SpecResult) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	status := outcome(res.GetFailed(), res.Skipped)
+	if o, ok := d.specs[spec.FileName]; ok && o.status != "" {
+		return
+	}
+	d.specs[spec.FileName] = &specOutcome{spec: spec, status: status}
+}
+
+func (d *dependencyResults) recordScenario(fileName string, scn *gauge.Scenario, res *result.ScenarioResult) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	key := scenarioDependencyKey(fileName, scn.Heading.Value)
+	if d.scenarios[key] != "" {
+		return
+	}
+	d.scenarios[key] = outcome(res.GetFailed(), res.ProtoScenario.GetSkipped())
+}
+
+// unmetSpecDependency gives the reason to skip a spec because a spec it depends on did not pass.
+func (d *dependencyResults) unmetSpecDependency(spec *gauge.Specification) error {
+	names := dependenciesFromTags(spec.Tags)
+	if len(names) == 0 {
+		return nil
+	}
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	for _, name := range names {
+		for fileName, o := range d.specs {
+			if fileName != spec.FileName && o.status != "" && isSpecNamed(o.spec, name) {
+				return fmt.Errorf("skipped Reason: depends on spec '%s', which %s", name, o.status)
+			}
+		}
+	}
+	return nil
+}
+
+// unmetScenarioDependency gives the reason to skip a scenario because a scenario it depends on did not pass.
+func (d *dependencyResults) unmetScenarioDependency(fileName string, scn *gauge.Scenario) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	for _, name := range dependenciesFromTags(scn.Tags) {
+		if status := d.scenarios[scenarioDependencyKey(fileName, name)]; status != "" {
+			return fmt.Errorf("skipped Reason: depends on scenario '%s', which %s", name, status)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package execution
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/filter"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+)
+
+func specWithDependencies(fileName, heading string, depends ...string) *gauge.Specification {
+	spec := &gauge.Specification{FileName: fileName, Heading: &gauge.Heading{Value: heading}, Tags: &gauge.Tags{}}
+	for _, d := range depends {
+		spec.Tags.Add([]string{"depends:" + d})
+	}
+	return spec
+}
+
+func scenarioWithDependencies(heading string, depends ...string) *gauge.Scenario {
+	scn := &gauge.Scenario{Heading: &gauge.Heading{Value: heading}, Tags: &gauge.Tags{}}
+	for _, d := range depends {
+		scn.Tags.Add([]string{"depends:" + d})
+	}
+	return scn
+}
+
+func fileNames(specs []*gauge.Specification) string {
+	var names []string
+	for _, s := range specs {
+		names = append(names, s.FileName)
+	}
+	return strings.Join(names, ",")
+}
+
+func TestOrderByDependenciesPutsDependenciesFirst(t *testing.T) {
+	specs := []*gauge.Specification{
+		specWithDependencies("checkout.spec", "Checkout", "Login"),
+		specWithDependencies("search.spec", "Search"),
+		specWithDependencies("login.spec", "Login", "signup.spec"),
+		specWithDependencies("signup.spec", "Signup"),
+	}
+
+	got := fileNames(orderByDependencies(specs))
+
+	want := "signup.spec,login.spec,checkout.spec,search.spec"
+	if got != want {
+		t.Errorf("Expected order %s, got %s", want, got)
+	}
+}
+
+func TestOrderByDependenciesIgnoresCycles(t *testing.T) {
+	specs := []*gauge.Specification{
+		specWithDependencies("a.spec", "A", "B"),
+		specWithDependencies("b.spec", "B", "A"),
+	}
+
+	got := fileNames(orderByDependencies(specs))
+
+	if got != "b.spec,a.spec" {
+		t.Errorf("Expected order b.spec,a.spec, got %s", got)
+	}
+}
+
+func TestOrderByDependenciesOrdersScenariosOfSpec(t *testing.T) {
+	spec := specWithDependencies("cart.spec", "Cart")
+	spec.Scenarios = []*gauge.Scenario{
+		scenarioWithDependencies("Remove item", "Add item"),
+		scenarioWithDependencies("Add item"),
+		scenarioWithDependencies("Empty cart"),
+	}
+
+	orderByDependencies([]*gauge.Specification{spec})
+
+	var got []string
+	for _, scn := range spec.Scenarios {
+		got = append(got, scn.Heading.Value)
+	}
+	if want := "Add item,Remove item,Empty cart"; strings.Join(got, ",") != want {
+		t.Errorf("Expected order %s, got %s", want, strings.Join(got, ","))
+	}
+}
+
+func TestDependencyChainsGroupsSpecsOfScenarioDependencies(t *testing.T) {
+	add := specWithDependencies("cart.spec", "Cart")
+	add.Scenarios = []*gauge.Scenario{scenarioWithDependencies("Add item")}
+	remove := specWithDependencies("cart.spec", "Cart")
+	remove.Scenarios = []*gauge.Scenario{scenarioWithDependencies("Remove item", "Add item")}
+	search := specWithDependencies("search.spec", "Search")
+
+	chains := dependencyChains([]*gauge.Specification{add, search, remove})
+
+	if len(chains) != 2 || len(chains[0]) != 2 || chains[0][0] != add || chains[0][1] != remove || chains[1][0] != search {
+		t.Errorf("Expected chains [cart.spec cart.spec] [search.spec], got %v", chains)
+	}
+}
+
+func TestDistributeChainsKeepsChainOnOneStream(t *testing.T) {
+	login := specWithDependencies("login.spec", "Login")
+	search := specWithDependencies("search.spec", "Search")
+	checkout := specWithDependencies("checkout.spec", "Checkout", "Login")
+	profile := specWithDependencies("profile.spec", "Profile")
+
+	got := distributeChains([]*gauge.Specification{login, checkout, search, profile}, func(s []*gauge.Specification) []*gauge.SpecCollection {
+		return filter.DistributeSpecs(s, 2)
+	})
+
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 streams, got %d", len(got))
+	}
+	if s := fileNames(got[0].Specs()); s != "login.spec,checkout.spec,profile.spec" {
+		t.Errorf("Expected login.spec,checkout.spec,profile.spec on first stream, got %s", s)
+	}
+	if s := fileNames(got[1].Specs()); s != "search.spec" {
+		t.Errorf("Expected search.spec on second stream, got %s", s)
+	}
+}
+
+func TestSpecsByFileName(t *testing.T) {
+	a1, a2, b := &gauge.Specification{FileName: "a.spec"}, &gauge.Specification{FileName: "a.spec"}, &gauge.Specification{FileName: "b.spec"}
+
+	got := specsByFileName([]*gauge.Specification{a1, a2, b})
+
+	if len(got) != 2 || len(got[0]) != 2 || got[1][0] != b {
+		t.Errorf("Expected specs grouped as [a.spec a.spec] [b.spec], got %v", got)
+	}
+}
+
+func TestUnmetSpecDependencyForFailedSpec(t *testing.T) {
+	d := newDependencyResults()
+	login := specWithDependencies("login.spec", "Login")
+	checkout := specWithDependencies("checkout.spec", "Checkout", "Login")
+	res := &result.SpecResult{ProtoSpec: &gauge_messages.ProtoSpec{}}
+	res.SetFailure()
+
+	if err := d.unmetSpecDependency(checkout); err != nil {
+		t.Errorf("Expected no unmet dependency before login.spec is executed, got %s", err.Error())
+	}
+	d.recordSpec(login, res)
+	d.recordSpec(login, &result.SpecResult{ProtoSpec: &gauge_messages.ProtoSpec{}})
+
+	err := d.unmetSpecDependency(checkout)
+
+	want := "skipped Reason: depends on spec 'Login', which failed"
+	if err == nil || err.Error() != want {
+		t.Errorf("Expected error %s, got %v", want, err)
+	}
+}
+
+func TestUnmetScenarioDependencyForSkippedScenario(t *testing.T) {
+	d := newDependencyResults()
+	add := scenarioWithDependencies("Add item")
+	remove := scenarioWithDependencies("Remove item", "Add item")
+	res := &result.ScenarioResult{ProtoScenario: &gauge_messages.ProtoScenario{Skipped: true}}
+
+	d.recordScenario("cart.spec", add, res)
+
+	if err := d.unmetScenarioDependency("other.spec", remove); err != nil {
+		t.Errorf("Expected no unmet dependency for a scenario of another spec, got %s", err.Error())
+	}
+	err := d.unmetScenarioDependency("cart.spec", remove)
+	want := "skipped Reason: depends on scenario 'Add item', which was skipped"
+	if err == nil || err.Error() != want {
+		t.Errorf("Expected error %s, got %v", want, err)
+	}
+}
+
+func TestSpecWithUnmetDependencyIsSkippedWithoutChangingTheErrorMap(t *testing.T) {
+	old, oldRetries := dependencies, MaxRetriesCount
+	defer func() { dependencies, MaxRetriesCount = old, oldRetries }()
+	dependencies, MaxRetriesCount = newDependencyResults(), 1
+	event.InitRegistry()
+	login := specWithDependencies("login.spec", "Login")
+	checkout := specWithDependencies("checkout.spec", "Checkout", "Login")
+	scenario := scenarioWithDependencies("Pay")
+	scenario.Span = &gauge.Span{Start: 2, End: 2}
+	checkout.Scenarios = []*gauge.Scenario{scenario}
+	checkout.Items = []gauge.Item{scenario}
+	failed := &result.SpecResult{ProtoSpec: &gauge_messages.ProtoSpec{}}
+	failed.SetFailure()
+	dependencies.recordSpec(login, failed)
+	executed := false
+	r := &mockRunner{ExecuteAndGetStatusFunc: func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		executed = executed || m.MessageType == gauge_messages.Message_ScenarioExecutionStarting
+		return &gauge_messages.ProtoExecutionResult{}
+	}}
+	h := &mockPluginHandler{NotifyPluginsfunc: func(m *gauge_messages.Message) {}, GracefullyKillPluginsfunc: func() {}}
+	errMap := gauge.NewBuildErrors()
+
+	res := newSpecExecutor(checkout, r, h, errMap, 0).execute(true, true, true)
+
+	if executed || !res.Skipped || res.ScenarioSkippedCount != 1 {
+		t.Errorf("Expected the spec and its scenario to be skipped, got %+v", res)
+	}
+	want := "skipped Reason: depends on spec 'Login', which failed"
+	if skipErrs := res.ProtoSpec.Items[0].GetScenario().GetSkipErrors(); len(skipErrs) != 1 || !strings.Contains(skipErrs[0], want) {
+		t.Errorf("Expected the scenario to be skipped for the unmet dependency, got %v", skipErrs)
+	}
+	if len(errMap.SpecErrs) != 0 || len(errMap.ScenarioErrs) != 0 {
+		t.Errorf("Expected the error map shared by the streams to be unchanged, got %v %v", errMap.SpecErrs, errMap.ScenarioErrs)
+	}
+}
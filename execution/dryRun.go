@@ -0,0 +1,322 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package execution
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/filter"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/reporter"
+	"github.com/getgauge/gauge/util"
+)
+
+const executionPlanFile = "execution-plan.json"
+
+// DryRun if true prints the scenarios and resolved steps which would be executed on every stream, without starting the runner.
+var DryRun bool
+
+// executionPlan holds the scenarios in the order in which they would be executed on every stream.
+type executionPlan struct {
+	Strategy      string           `json:"strategy,omitempty"`
+	SuiteSetup    []*plannedStep   `json:"suiteSetup,omitempty"`
+	Streams       []*plannedStream `json:"streams"`
+	SuiteTeardown []*plannedStep   `json:"suiteTeardown,omitempty"`
+}
+
+// plannedStream is a stream of the execution plan. Stream 0 executes the specs in serial. With the lazy strategy, all
+// the parallel streams take the next spec in the order of one shared stream.
+type plannedStream struct {
+	Stream    int                `json:"stream"`
+	Scenarios []*plannedScenario `json:"scenarios"`
+}
+
+type plannedScenario struct {
+	Spec             string         `json:"spec"`
+	Heading          string         `json:"heading"`
+	SpecTableRow     int            `json:"specTableRow,omitempty"`
+	ScenarioTableRow int            `json:"scenarioTableRow,omitempty"`
+	Retries          int            `json:"retries,omitempty"`
+	StepRetries      int            `json:"stepRetries,omitempty"`
+	Timeout          string         `json:"timeout,omitempty"`
+	DependsOn        []string       `json:"dependsOn,omitempty"`
+	Steps            []*plannedStep `json:"steps"`
+}
+
+// plannedStep is a step with its parameters resolved, the steps of a concept are nested in it.
+type plannedStep struct {
+	Text  string         `json:"text"`
+	Steps []*plannedStep `json:"steps,omitempty"`
+}
+
+// dryRun parses and filters the specs as for an execution and prints the execution plan. The plan is also written
+// as JSON to the reports directory.
+func dryRun(specDirs []string) int {
+	conceptDict, res, err := parser.ParseConcepts()
+	if err != nil {
+		logger.Errorf(true, "Unable to parse concepts: %s", err.Error())
+		return ParseFailed
+	}
+	errMap := gauge.NewBuildErrors()
+	specs, failed := parser.ParseSpecs(specDirs, conceptDict, errMap)
+	suiteSpec, suiteSpecFailed := parser.ParseSuiteSpec(conceptDict)
+	if !res.Ok || failed || suiteSpecFailed {
+		return ParseFailed
+	}
+	specs = orderByDependencies(parser.GetSpecsForDataTableRows(specs, errMap))
+	parseRetryOnlyTags(specs)
+	p := newExecutionPlan(specs, errMap)
+	if suiteSpec != nil {
+		p.SuiteSetup = suiteSteps(suiteSpec, suiteSpec.Contexts, errMap)
+		p.SuiteTeardown = suiteSteps(suiteSpec, suiteSpec.TearDownSteps, errMap)
+	}
+	printExecutionPlan(p)
+	writeExecutionPlan(p, filepath.Join(reporter.ReportsDir(), executionPlanFile))
+	return Success
+}
+
+// newExecutionPlan assigns the specs to streams as the simple or parallel execution would.
+func newExecutionPlan(specs []*gauge.Specification, errMap *gauge.BuildErrors) *executionPlan {
+	p := &executionPlan{}
+	if !InParallel {
+		p.addStream(0, gauge.NewSpecCollection(specs, true).Specs(), errMap)
+		return p
+	}
+	if env.AllowFilteredParallelExecution() && TagsToFilterForParallelRun != "" {
+		var serial []*gauge.Specification
+		specs, serial = filter.FilterSpecForParallelRun(specs, TagsToFilterForParallelRun)
+		if len(serial) > 0 {
+			p.addStream(0, gauge.NewSpecCollection(serial, true).Specs(), errMap)
+		}
+	}
+	if env.AllowScenarioParallelism() {
+		specs = parser.GetSpecsForScenarios(specs, errMap)
+	}
+	if len(specs) == 0 {
+		return p
+	}
+	p.Strategy = Strategy
+	streams := NumberOfExecutionStreams
+	if chains := len(dependencyChains(specs)); streams > chains {
+		streams = chains
+	}
+	switch {
+	case isLazy():
+		p.addStream(1, specs, errMap)
+	case isTimed():
+		execTimes := specExecutionTimesFromLastRun()
+		for i, s := range distributeChains(specs, func(s []*gauge.Specification) []*gauge.SpecCollection {
+			return filter.DistributeSpecsByExecutionTime(s, streams, execTimes)
+		}) {
+			p.addStream(i+1, s.Specs(), errMap)
+		}
+	default:
+		for i, s := range distributeChains(specs, func(s []*gauge.Specification) []*gauge.SpecCollection {
+			return filter.DistributeSpecs(s, streams)
+		}) {
+			p.addStream(i+1, s.Specs(), errMap)
+		}
+	}
+	return p
+}
+
+// suiteSteps resolves the contexts or teardown steps of the suite spec.
+func suiteSteps(spec *gauge.Specification, steps []*gauge.Step, errMap *gauge.BuildErrors) []*plannedStep {
+	items, err := (&specExecutor{specification: spec, errMap: errMap}).getItemsForScenarioExecution(steps)
+	if err != nil {
+		logger.Errorf(true, "Failed to resolve steps of %s. %s", spec.FileName, err.Error())
+		return nil
+	}
+	return plannedSteps(items)
+}
+
+func (p *executionPlan) addStream(stream int, specs []*gauge.Specification, errMap *gauge.BuildErrors) {
+	s := &plannedStream{Stream: stream, Scenarios: make([]*plannedScenario, 0)}
+	for _, spec := range specs {
+		for _, scn := range scenariosInExecutionOrder(spec) {
+			if _, ok := errMap.ScenarioErrs[scn]; ok || excludedByTableRows(scn) {
+				continue
+			}
+			s.Scenarios = append(s.Scenarios, newPlannedScenario(spec, scn, errMap))
+		}
+	}
+	p.Streams = append(p.Streams, s)
+}
+
+// scenariosInExecutionOrder gives the scenarios of the spec in the order in which the spec executor runs them, the
+// scenarios driven by a data table come last.
+func scenariosInExecutionOrder(spec *gauge.Specification) []*gauge.Scenario {
+	others, tableDriven := parser.FilterTableRelatedScenarios(spec.Scenarios, func(s *gauge.Scenario) bool {
+		if spec.DataTable.Table.GetRowCount() == 0 {
+			return s.ScenarioDataTableRow.IsInitialized()
+		}
+		return s.SpecDataTableRow.IsInitialized()
+	})
+	return append(others, tableDriven...)
+}
+
+func newPlannedScenario(spec *gauge.Specification, scn *gauge.Scenario, errMap *gauge.BuildErrors) *plannedScenario {
+	specTags, scnTags := getTagValue(spec.Tags), getTagValue(scn.Tags)
+	ps := &plannedScenario{
+		Spec:        filepath.ToSlash(util.RelPathToProjectRoot(spec.FileName)),
+		Heading:     scn.Heading.Value,
+		StepRetries: stepRetries(specTags, scnTags),
+		DependsOn:   append(dependenciesFromTags(spec.Tags), dependenciesFromTags(scn.Tags)...),
+		Steps:       make([]*plannedStep, 0),
+	}
+	if scn.SpecDataTableRow.IsInitialized() {
+		ps.SpecTableRow = scn.SpecDataTableRowIndex + 1
+	}
+	if scn.ScenarioDataTableRow.IsInitialized() {
+		ps.ScenarioTableRow = scn.ScenarioDataTableRowIndex + 1
+	}
+	if shouldRetryScenario(spec, scn) {
+		ps.Retries = MaxRetriesCount - 1
+	}
+	if t := scenarioTimeout(specTags, scnTags); t > 0 {
+		ps.Timeout = t.String()
+	}
+	res := &result.ScenarioResult{ProtoScenario: gauge.NewProtoScenario(scn)}
+	if err := (&specExecutor{specification: spec, errMap: errMap}).addAllItemsForScenarioExecution(scn, res); err != nil {
+		logger.Errorf(true, "Failed to resolve steps of scenario '%s' in %s. %s", scn.Heading.Value, spec.FileName, err.Error())
+		return ps
+	}
+	for _, items := range [][]*gauge_messages.ProtoItem{res.ProtoScenario.GetContexts(), res.ProtoScenario.GetScenarioItems(), res.ProtoScenario.GetTearDownSteps()} {
+		ps.Steps = append(ps.Steps, plannedSteps(items)...)
+	}
+	return ps
+}
+
+func plannedSteps(items []*gauge_messages.ProtoItem) []*plannedStep {
+	var steps []*plannedStep
+	for _, item := range items {
+		switch item.GetItemType() {
+		case gauge_messages.ProtoItem_Step:
+			steps = append(steps, &plannedStep{Text: resolvedStepText(item.GetStep())})
+		case gauge_messages.ProtoItem_Concept:
+			steps = append(steps, &plannedStep{Text: resolvedStepText(item.GetConcept().GetConceptStep()), Steps: plannedSteps(item.GetConcept().GetSteps())})
+		}
+	}
+	return steps
+}
+
+func resolvedStepText(step *gauge_messages.ProtoStep) string {
+	var text bytes.Buffer
+	for _, f := range step.GetFragments() {
+		if f.GetFragmentType() == gauge_messages.Fragment_Text {
+			text.WriteString(f.GetText())
+			continue
+		}
+		switch f.GetParameter().GetParameterType() {
+		case gauge_messages.Parameter_Table, gauge_messages.Parameter_Special_Table:
+			text.WriteString("<table>")
+		case gauge_messages.Parameter_Special_String:
+			fmt.Fprintf(&text, "<%s>", f.GetParameter().GetName())
+		default:
+			fmt.Fprintf(&text, "%q", f.GetParameter().GetValue())
+		}
+	}
+	return text.String()
+}
+
+func printExecutionPlan(p *executionPlan) {
+	if len(p.SuiteSetup) > 0 {
+		logger.Infof(true, suiteSetupHeading)
+		printPlannedSteps(p.SuiteSetup, "  ")
+	}
+	for _, s := range p.Streams {
+		switch {
+		case s.Stream == 0:
+			logger.Infof(true, "Serial")
+		case p.Strategy == Lazy:
+			logger.Infof(true, "Parallel streams, each taking the next spec or chain of dependent specs in this order")
+		default:
+			logger.Infof(true, "Stream %d", s.Stream)
+		}
+		for _, scn := range s.Scenarios {
+			logger.Infof(true, "  %s", scn.title())
+			printPlannedSteps(scn.Steps, "    ")
+		}
+	}
+	if len(p.SuiteTeardown) > 0 {
+		logger.Infof(true, suiteTeardownHeading)
+		printPlannedSteps(p.SuiteTeardown, "  ")
+	}
+}
+
+func (s *plannedScenario) title() string {
+	title := fmt.Sprintf("%s: %s", s.Spec, s.Heading)
+	if s.SpecTableRow > 0 {
+		title += fmt.Sprintf(" [row %d]", s.SpecTableRow)
+	}
+	if s.ScenarioTableRow > 0 {
+		title += fmt.Sprintf(" [scenario row %d]", s.ScenarioTableRow)
+	}
+	var rules []string
+	if s.Retries > 0 {
+		rules = append(rules, fmt.Sprintf("retries: %d", s.Retries))
+	}
+	if s.StepRetries > 0 {
+		rules = append(rules, fmt.Sprintf("step retries: %d", s.StepRetries))
+	}
+	if s.Timeout != "" {
+		rules = append(rules, fmt.Sprintf("timeout: %s", s.Timeout))
+	}
+	if len(s.DependsOn) > 0 {
+		rules = append(rules, fmt.Sprintf("depends on: %s", strings.Join(s.DependsOn, ", ")))
+	}
+	if len(rules) > 0 {
+		title += fmt.Sprintf(" (%s)", strings.Join(rules, ", "))
+	}
+	return title
+}
+
+func printPlannedSteps(steps []*plannedStep, indent string) {
+	for _, step := range steps {
+		logger.Infof(true, "%s* %s", indent, step.Text)
+		printPlannedSteps(step.Steps, indent+"  ")
+	}
+}
+
+func writeExecutionPlan(p *executionPlan, file string) {
+	if err := os.MkdirAll(filepath.Dir(file), common.NewDirectoryPermissions); err != nil {
+		logger.Errorf(true, "Failed to create directory in %s. Reason: %s", filepath.Dir(file), err.Error())
+		return
+	}
+	contents, err := json.MarshalIndent(p, "", "\t")
+	if err != nil {
+		logger.Errorf(true, "Unable to marshal execution plan, skipping save. %s", err.Error())
+		return
+	}
+	if err = ioutil.WriteFile(file, contents, common.NewFilePermissions); err != nil {
+		logger.Errorf(true, "Failed to write to %s. Reason: %s", file, err.Error())
+		return
+	}
+	logger.Infof(true, "Execution plan written to %s", file)
+}
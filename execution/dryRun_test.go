@@ -0,0 +1,104 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package execution
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+)
+
+const dryRunSpec = `# Spec
+
+|id|
+|--|
+|1 |
+|2 |
+
+## Scenario one
+tags: timeout:30s
+
+* step with <id>
+
+## Scenario two
+
+* create user "456" "foo" and "9900"
+`
+
+func dryRunSpecs(t *testing.T) ([]*gauge.Specification, *gauge.BuildErrors) {
+	dict := gauge.NewConceptDictionary()
+	path, _ := filepath.Abs(filepath.Join("testdata", "concept.cpt"))
+	parser.AddConcepts([]string{path}, dict)
+	spec, res, err := new(parser.SpecParser).Parse(dryRunSpec, dict, filepath.Join(config.ProjectRoot, "specs", "a.spec"))
+	if err != nil || !res.Ok {
+		t.Fatalf("Failed to parse spec. %v %v", err, res.ParseErrors)
+	}
+	errMap := gauge.NewBuildErrors()
+	return parser.GetSpecsForDataTableRows([]*gauge.Specification{spec}, errMap), errMap
+}
+
+func TestExecutionPlanInSerial(t *testing.T) {
+	old := config.ProjectRoot
+	config.ProjectRoot, MaxRetriesCount = "project", 1
+	defer func() { config.ProjectRoot = old }()
+	specs, errMap := dryRunSpecs(t)
+
+	p := newExecutionPlan(specs, errMap)
+
+	if len(p.Streams) != 1 || p.Streams[0].Stream != 0 {
+		t.Fatalf("Expected one serial stream, got %+v", p.Streams)
+	}
+	var titles []string
+	for _, scn := range p.Streams[0].Scenarios {
+		titles = append(titles, scn.title())
+	}
+	want := []string{"specs/a.spec: Scenario two", "specs/a.spec: Scenario one [row 1] (timeout: 30s)", "specs/a.spec: Scenario one [row 2] (timeout: 30s)"}
+	if !reflect.DeepEqual(titles, want) {
+		t.Errorf("Expected scenarios %v, got %v", want, titles)
+	}
+	if got := p.Streams[0].Scenarios[2].Steps[0].Text; got != `step with "2"` {
+		t.Errorf("Expected step resolved with the row of the data table, got %s", got)
+	}
+	concept := p.Streams[0].Scenarios[0].Steps[0]
+	if concept.Text != `create user "456" "foo" and "9900"` || len(concept.Steps) != 2 || concept.Steps[0].Steps[1].Text != `add name "foo"` {
+		t.Errorf("Expected concept with resolved nested steps, got %+v", concept)
+	}
+}
+
+func TestExecutionPlanInParallel(t *testing.T) {
+	old, oldStrategy, oldStreams := config.ProjectRoot, Strategy, NumberOfExecutionStreams
+	config.ProjectRoot = "project"
+	InParallel, Strategy, NumberOfExecutionStreams, MaxRetriesCount = true, Eager, 4, 3
+	defer func() {
+		config.ProjectRoot = old
+		InParallel, Strategy, NumberOfExecutionStreams, MaxRetriesCount = false, oldStrategy, oldStreams, 1
+	}()
+	specs, errMap := dryRunSpecs(t)
+
+	p := newExecutionPlan(specs, errMap)
+
+	if len(p.Streams) != 2 || len(p.Streams[0].Scenarios) != 2 || len(p.Streams[1].Scenarios) != 1 {
+		t.Fatalf("Expected specs of the 2 rows on 2 streams, got %+v", p.Streams)
+	}
+	if got := p.Streams[1].Scenarios[0]; p.Streams[1].Stream != 2 || got.SpecTableRow != 2 || got.Retries != 2 {
+		t.Errorf("Unexpected scenario %+v on stream 2", got)
+	}
+}
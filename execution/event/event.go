@@ -58,8 +58,32 @@ const (
 	ScenarioEnd
 	SpecEnd
 	SuiteEnd
+	// SuiteStepsStart and SuiteStepsEnd are raised around the contexts and teardown steps of the suite spec, which
+	// are reported like a scenario.
+	SuiteStepsStart
+	SuiteStepsEnd
 )
 
+var topicNames = map[Topic]string{
+	SuiteStart:      "SuiteStart",
+	SpecStart:       "SpecStart",
+	ScenarioStart:   "ScenarioStart",
+	ConceptStart:    "ConceptStart",
+	StepStart:       "StepStart",
+	StepEnd:         "StepEnd",
+	ConceptEnd:      "ConceptEnd",
+	ScenarioEnd:     "ScenarioEnd",
+	SpecEnd:         "SpecEnd",
+	SuiteEnd:        "SuiteEnd",
+	SuiteStepsStart: "SuiteStepsStart",
+	SuiteStepsEnd:   "SuiteStepsEnd",
+}
+
+// String gives the name of the topic, e.g. ScenarioEnd
+func (t Topic) String() string {
+	return topicNames[t]
+}
+
 var subscriberRegistry map[Topic][]chan ExecutionEvent
 
 // InitRegistry is used for console reporting, execution API and rerun of specs
@@ -78,6 +102,8 @@ func InitRegistry() {
 
 // Register registers the given channel to the given list of topics. Any updates for the given topics
 // will be sent on this channel
+// Listeners stop listening once they handle SuiteEnd, the last event of an execution, so that they do not
+// pile up over the executions of gauge run --watch.
 func Register(ch chan ExecutionEvent, topics ...Topic) {
 	for _, t := range topics {
 		subscriberRegistry[t] = append(subscriberRegistry[t], ch)
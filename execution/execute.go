@@ -29,6 +29,7 @@
    Strategy
     	- Lazy : Lazy is a parallelization strategy for execution. In this case tests assignment will be dynamic during execution, i.e. assign the next spec in line to the stream that has completed it’s previous execution and is waiting for more work.
     	- Eager : Eager is a parallelization strategy for execution. In this case tests are distributed before execution, thus making them an equal number based distribution.
+    	- Timed : Timed is a parallelization strategy for execution. In this case tests are distributed before execution, based on the execution times recorded in the previous run.
 */
 package execution
 
@@ -53,10 +54,10 @@ import (
 
 	"github.com/getgauge/common"
 	"github.com/getgauge/gauge/config"
-	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/execution/event"
 	"github.com/getgauge/gauge/execution/rerun"
 	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/filter"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/manifest"
@@ -76,6 +77,9 @@ var MaxRetriesCount int
 // Tags to filter specs/scenarios to retry
 var RetryOnlyTags string
 
+// retryOnly is RetryOnlyTags parsed for the specs to execute, nil to retry all scenarios
+var retryOnly *filter.TagExpression
+
 // NumberOfExecutionStreams shows the number of execution streams, in parallel execution.
 var NumberOfExecutionStreams int
 
@@ -90,6 +94,9 @@ var Verbose bool
 // MachineReadable indicates that the output is in json format
 var MachineReadable bool
 
+// RerunFailed if true indicates that only the scenarios failed in the previous run are executed
+var RerunFailed bool
+
 type suiteExecutor interface {
 	run() *result.SuiteResult
 }
@@ -108,6 +115,10 @@ type executionInfo struct {
 	numberOfStreams int
 	tagsToFilter    string
 	stream          int
+	keepRunnerAlive bool
+	resumed         []*result.SpecResult
+	suiteSpec       *gauge.Specification
+	specHooks       *specHooks
 }
 
 func newExecutionInfo(s *gauge.SpecCollection, r runner.Runner, ph plugin.Handler, e *gauge.BuildErrors, p bool, stream int) *executionInfo {
@@ -135,13 +146,40 @@ var ExecuteSpecs = func(specDirs []string) int {
 	if err != nil {
 		logger.Fatalf(true, err.Error())
 	}
+	if filter.Shard != "" && ShardBy == ShardByTime {
+		filter.ShardExecutionTimes = scenarioExecutionTimesFromLastRun()
+	}
+	if DryRun {
+		return dryRun(specDirs)
+	}
 	if config.CheckUpdates() {
 		i := &install.UpdateFacade{}
 		i.BufferUpdateDetails()
 		defer i.PrintUpdateBuffer()
 	}
 	skel.SetupPlugins(MachineReadable)
+	stopCancelling := cancelOnInterrupt()
+	defer stopCancelling()
 	res := validation.ValidateSpecs(specDirs, false)
+	cp := newCheckpoint()
+	if Resume && len(res.Errs) == 0 {
+		cp = loadCheckpoint()
+		res.SpecCollection = gauge.NewSpecCollection(cp.pending(res.SpecCollection.Specs()), false)
+		if res.SpecCollection.Size() < 1 && len(cp.Scenarios) > 0 {
+			return finishResumedRun(res, cp)
+		}
+	}
+	exitCode := executeValidatedSpecs(res, specDirs, false, cp)
+	if isCancelled() {
+		logger.Infof(true, "Execution was cancelled.")
+		return Cancelled
+	}
+	return exitCode
+}
+
+// executeValidatedSpecs executes the specs of the validation result. The runner is killed at the end,
+// unless keepRunnerAlive is true. The results in the checkpoint are merged with the results of the execution.
+func executeValidatedSpecs(res *validation.ValidationResult, specDirs []string, keepRunnerAlive bool, cp *checkpoint) int {
 	if len(res.Errs) > 0 {
 		if res.ParseOk {
 			return ParseFailed
@@ -150,26 +188,64 @@ var ExecuteSpecs = func(specDirs []string) int {
 	}
 	if res.SpecCollection.Size() < 1 {
 		logger.Infof(true, "No specifications found in %s.", strings.Join(specDirs, ", "))
-		res.Runner.Kill()
+		if !keepRunnerAlive {
+			res.Runner.Kill()
+		}
 		if res.ParseOk {
 			return Success
 		}
 		return ExecutionFailed
 	}
+	res.SpecCollection = gauge.NewSpecCollection(orderByDependencies(res.SpecCollection.Specs()), false)
+	parseRetryOnlyTags(res.SpecCollection.Specs())
+	dependencies = newDependencyResults()
 	event.InitRegistry()
 	wg := &sync.WaitGroup{}
 	reporter.ListenExecutionEvents(wg)
 	rerun.ListenFailedScenarios(wg, specDirs)
-	if env.SaveExecutionResult() {
+	if Quarantine {
+		loadKnownFlakyScenarios()
+	}
+	ListenScenarioEndAndSaveFlakyHistory(wg)
+	ListenAndSaveCheckpoint(wg, cp, res.SpecCollection.Specs())
+	if shouldSaveResult() || filter.Shard != "" {
 		ListenSuiteEndAndSaveResult(wg)
 	}
+	if shouldSaveHistory() {
+		ListenSuiteEndAndSaveHistory(wg)
+	}
+	if reporter.ReportFormat != "" {
+		reporter.ListenSuiteEndAndWriteReport(wg)
+	}
+	if ServePort > 0 {
+		ListenExecutionEventsAndServe(wg)
+	}
+	if Profile {
+		ListenSuiteEndAndWriteProfile(wg)
+	}
 	defer wg.Wait()
 	ei := newExecutionInfo(res.SpecCollection, res.Runner, nil, res.ErrMap, InParallel, 0)
+	ei.keepRunnerAlive = keepRunnerAlive
+	ei.resumed = cp.resumedResults()
+	ei.suiteSpec = res.SuiteSpec
 
 	e := newExecution(ei)
 	return printExecutionResult(e.run(), res.ParseOk)
 }
 
+// parseRetryOnlyTags parses the --retry-only tag expression once for the scenarios of all specs.
+func parseRetryOnlyTags(specs []*gauge.Specification) {
+	retryOnly = nil
+	if RetryOnlyTags == "" {
+		return
+	}
+	exp, err := filter.ParseTagExpression(RetryOnlyTags, specs)
+	if err != nil {
+		logger.Fatalf(true, "invalid input(%s) to --retry-only flag. %s", RetryOnlyTags, err.Error())
+	}
+	retryOnly = exp
+}
+
 func newExecution(executionInfo *executionInfo) suiteExecutor {
 	if executionInfo.inParallel {
 		return newParallelExecution(executionInfo)
@@ -276,22 +352,42 @@ func printExecutionResult(suiteResult *result.SuiteResult, isParsingOk bool) int
 	logger.Infof(true, "\nTotal time taken: %s", time.Millisecond*time.Duration(suiteResult.ExecutionTime))
 	writeExecutionResult(s)
 
-	if !isParsingOk {
-		return ParseFailed
-	}
-	if suiteResult.IsFailed {
-		return ExecutionFailed
-	}
-	return Success
+	return exitCode(suiteResult, isParsingOk)
 }
 
 func validateFlags() error {
 	if MaxRetriesCount < 1 {
 		return fmt.Errorf("invalid input(%s) to --max-retries-count flag", strconv.Itoa(MaxRetriesCount))
 	}
+	if reporter.ReportFormat != "" && !reporter.IsSupportedReportFormat(reporter.ReportFormat) {
+		return fmt.Errorf("invalid input(%s) to --format flag", reporter.ReportFormat)
+	}
+	if RetryOnlyTags != "" {
+		if err := filter.ValidateTagExpression(RetryOnlyTags); err != nil {
+			return fmt.Errorf("invalid input(%s) to --retry-only flag. %s", RetryOnlyTags, err.Error())
+		}
+	}
+	if ScenarioTimeout < 0 {
+		return fmt.Errorf("invalid input(%s) to --scenario-timeout flag", ScenarioTimeout)
+	}
+	if StepRetries < 0 {
+		return fmt.Errorf("invalid input(%s) to --step-retries flag", strconv.Itoa(StepRetries))
+	}
+	if StepRetryBackoff < 0 {
+		return fmt.Errorf("invalid input(%s) to --step-retry-backoff flag", StepRetryBackoff)
+	}
+	if ServePort < 0 || ServePort > 65535 {
+		return fmt.Errorf("invalid input(%s) to --serve flag", strconv.Itoa(ServePort))
+	}
+	if err := validateShard(); err != nil {
+		return err
+	}
 	if !InParallel {
 		return nil
 	}
+	if Watch {
+		return fmt.Errorf("--watch cannot be used with --parallel")
+	}
 	if NumberOfExecutionStreams < 1 {
 		return fmt.Errorf("invalid input(%s) to --n flag", strconv.Itoa(NumberOfExecutionStreams))
 	}
@@ -75,6 +75,14 @@ func (s *MySuite) TestValidateFlagsWithStartegyLazy(c *C) {
 	c.Assert(err, Equals, nil)
 }
 
+func (s *MySuite) TestValidateFlagsWithStartegyTimed(c *C) {
+	InParallel = true
+	Strategy = "timed"
+	NumberOfExecutionStreams = 1
+	err := validateFlags()
+	c.Assert(err, Equals, nil)
+}
+
 func (s *MySuite) TestValidateFlagsWithInvalidStrategy(c *C) {
 	InParallel = true
 	Strategy = "sdf"
@@ -83,6 +91,14 @@ func (s *MySuite) TestValidateFlagsWithInvalidStrategy(c *C) {
 	c.Assert(err.Error(), Equals, "invalid input(sdf) to --strategy flag")
 }
 
+func (s *MySuite) TestValidateFlagsWithInvalidRetryOnlyTags(c *C) {
+	InParallel = false
+	RetryOnlyTags = "flaky &"
+	defer func() { RetryOnlyTags = "" }()
+	err := validateFlags()
+	c.Assert(err, ErrorMatches, "(?s)invalid input\\(flaky &\\) to --retry-only flag. Invalid tag expression: expected a tag or '\\(' but found end of expression at column 8.*")
+}
+
 func (s *MySuite) TestValidateFlagsWithInvalidStream(c *C) {
 	InParallel = true
 	NumberOfExecutionStreams = -1
@@ -1,5 +1,10 @@
 package execution
 
+import (
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/logger"
+)
+
 const (
 	// Success indicated successful operation
 	Success = 0
@@ -9,4 +14,22 @@ const (
 	ParseFailed = 2
 	// ValidationFailed indicates one or more validation errors
 	ValidationFailed = 3
+	// Cancelled indicates the execution was cancelled by an interrupt or termination signal
+	Cancelled = 130
 )
+
+// exitCode gives the exit code for the result of an execution. Failures of quarantined scenarios do not fail
+// the execution in quarantine mode, as long as these are the only failures.
+func exitCode(res *result.SuiteResult, isParsingOk bool) int {
+	if !isParsingOk {
+		return ParseFailed
+	}
+	if !res.IsFailed {
+		return Success
+	}
+	if n, ok := quarantinedFailures(res); Quarantine && ok {
+		logger.Infof(true, "Ignoring failures of %d quarantined scenario(s).", n)
+		return Success
+	}
+	return ExecutionFailed
+}
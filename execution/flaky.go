@@ -0,0 +1,253 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+)
+
+const (
+	flakyHistoryFile = "flaky_history.json"
+	// maxFlakyHistory is the number of runs remembered for every scenario
+	maxFlakyHistory = 10
+	// stableRuns is the number of consecutive runs with the same outcome after which a flaky scenario is no longer
+	// quarantined, i.e. once it is fixed or consistently fails
+	stableRuns    = 3
+	quarantineTag = "quarantine"
+)
+
+// Outcomes of a scenario recorded in the flaky history, in increasing order of precedence
+const (
+	passedOutcome = "passed"
+	failedOutcome = "failed"
+	flakyOutcome  = "flaky"
+)
+
+// Quarantine if true does not fail the execution for failures of known flaky scenarios
+// and of scenarios tagged with quarantine.
+var Quarantine bool
+
+var knownFlakyScenarios = make(map[string]bool)
+
+// flakyHistory holds the outcomes of the last runs of every scenario, latest last.
+type flakyHistory struct {
+	Scenarios map[string][]string `json:"scenarios"`
+}
+
+func newFlakyHistory() *flakyHistory {
+	return &flakyHistory{Scenarios: make(map[string][]string)}
+}
+
+func (h *flakyHistory) add(outcomes map[string]string) {
+	for key, outcome := range outcomes {
+		runs := append(h.Scenarios[key], outcome)
+		if len(runs) > maxFlakyHistory {
+			runs = runs[len(runs)-maxFlakyHistory:]
+		}
+		h.Scenarios[key] = runs
+	}
+}
+
+// flakyScenarios gives the scenarios which were flaky in one of their last runs and have not been stable since.
+func (h *flakyHistory) flakyScenarios() map[string]bool {
+	flaky := make(map[string]bool)
+	for key, runs := range h.Scenarios {
+		for i := len(runs) - 1; i >= 0; i-- {
+			if runs[i] != flakyOutcome {
+				continue
+			}
+			if !isStable(runs[i+1:]) {
+				flaky[key] = true
+			}
+			break
+		}
+	}
+	return flaky
+}
+
+// isStable tells if the last runs of a scenario had the same outcome stableRuns times in a row.
+func isStable(runs []string) bool {
+	if len(runs) < stableRuns {
+		return false
+	}
+	last := runs[len(runs)-stableRuns:]
+	for _, outcome := range last {
+		if outcome != last[0] {
+			return false
+		}
+	}
+	return true
+}
+
+func flakyKey(specFile, scenarioHeading string) string {
+	return fmt.Sprintf("%s:%s", filepath.ToSlash(util.RelPathToProjectRoot(specFile)), scenarioHeading)
+}
+
+func outcomePrecedence(outcome string) int {
+	switch outcome {
+	case flakyOutcome:
+		return 2
+	case failedOutcome:
+		return 1
+	}
+	return 0
+}
+
+func scenarioOutcome(res *result.ScenarioResult) string {
+	if res.Flaky() {
+		return flakyOutcome
+	}
+	if res.GetFailed() {
+		return failedOutcome
+	}
+	return passedOutcome
+}
+
+// recordOutcome keeps the outcome of the run for the scenario. Retries and data table rows of the same scenario
+// are recorded as one outcome, the one with the highest precedence.
+func recordOutcome(outcomes map[string]string, key string, res *result.ScenarioResult) {
+	if res.ProtoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_SKIPPED {
+		return
+	}
+	outcome := scenarioOutcome(res)
+	if o, ok := outcomes[key]; !ok || outcomePrecedence(outcome) > outcomePrecedence(o) {
+		outcomes[key] = outcome
+	}
+}
+
+// ListenScenarioEndAndSaveFlakyHistory listens to execution events and appends the outcome of every executed scenario
+// to the flaky history in .gauge folder.
+func ListenScenarioEndAndSaveFlakyHistory(wg *sync.WaitGroup) {
+	ch := make(chan event.ExecutionEvent, 0)
+	event.Register(ch, event.ScenarioEnd, event.SuiteEnd)
+	wg.Add(1)
+
+	go func() {
+		outcomes := make(map[string]string)
+		for {
+			e := <-ch
+			switch e.Topic {
+			case event.ScenarioEnd:
+				key := flakyKey(e.ExecutionInfo.GetCurrentSpec().GetFileName(), e.Item.(*gauge.Scenario).Heading.Value)
+				recordOutcome(outcomes, key, e.Result.(*result.ScenarioResult))
+			case event.SuiteEnd:
+				h := readFlakyHistory()
+				h.add(outcomes)
+				writeFlakyHistory(h)
+				wg.Done()
+				return
+			}
+		}
+	}()
+}
+
+func loadKnownFlakyScenarios() {
+	knownFlakyScenarios = readFlakyHistory().flakyScenarios()
+}
+
+func readFlakyHistory() *flakyHistory {
+	h := newFlakyHistory()
+	contents, err := ioutil.ReadFile(filepath.Join(config.ProjectRoot, dotGauge, flakyHistoryFile))
+	if err != nil {
+		return h
+	}
+	if err = json.Unmarshal(contents, h); err != nil {
+		logger.Warningf(true, "Ignoring invalid flaky history. Reason: %s", err.Error())
+		return newFlakyHistory()
+	}
+	if h.Scenarios == nil {
+		h.Scenarios = make(map[string][]string)
+	}
+	return h
+}
+
+func writeFlakyHistory(h *flakyHistory) {
+	dotGaugeDir := filepath.Join(config.ProjectRoot, dotGauge)
+	historyFile := filepath.Join(dotGaugeDir, flakyHistoryFile)
+	if err := os.MkdirAll(dotGaugeDir, common.NewDirectoryPermissions); err != nil {
+		logger.Errorf(true, "Failed to create directory in %s. Reason: %s", dotGaugeDir, err.Error())
+		return
+	}
+	contents, err := json.MarshalIndent(h, "", "\t")
+	if err != nil {
+		logger.Errorf(true, "Unable to marshal flaky history, skipping save. %s", err.Error())
+		return
+	}
+	if err = ioutil.WriteFile(historyFile, contents, common.NewFilePermissions); err != nil {
+		logger.Errorf(true, "Failed to write to %s. Reason: %s", historyFile, err.Error())
+	}
+}
+
+func isQuarantined(specFile string, tags []string, scn *gauge_messages.ProtoScenario) bool {
+	for _, t := range append(tags, scn.GetTags()...) {
+		if strings.EqualFold(strings.TrimSpace(t), quarantineTag) {
+			return true
+		}
+	}
+	return knownFlakyScenarios[flakyKey(specFile, scn.GetScenarioHeading())]
+}
+
+// quarantinedFailures returns the number of failed scenarios which are quarantined and true if
+// these are the only failures in the suite.
+func quarantinedFailures(res *result.SuiteResult) (int, bool) {
+	if res.PreSuite != nil || res.PostSuite != nil || len(res.UnhandledErrors) > 0 {
+		return 0, false
+	}
+	if (res.SuiteSetup != nil && res.SuiteSetup.GetFailed()) || (res.SuiteTeardown != nil && res.SuiteTeardown.GetFailed()) {
+		return 0, false
+	}
+	count := 0
+	for _, specRes := range res.SpecResults {
+		if !specRes.GetFailed() {
+			continue
+		}
+		spec := specRes.ProtoSpec
+		if len(spec.GetPreHookFailures()) > 0 || len(spec.GetPostHookFailures()) > 0 || len(specRes.Errors) > 0 {
+			return 0, false
+		}
+		for _, item := range spec.GetItems() {
+			scn := item.GetScenario()
+			if item.GetItemType() == gauge_messages.ProtoItem_TableDrivenScenario {
+				scn = item.GetTableDrivenScenario().GetScenario()
+			}
+			if scn.GetExecutionStatus() != gauge_messages.ExecutionStatus_FAILED {
+				continue
+			}
+			if !isQuarantined(spec.GetFileName(), spec.GetTags(), scn) {
+				return 0, false
+			}
+			count++
+		}
+	}
+	return count, true
+}
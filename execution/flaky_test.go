@@ -0,0 +1,166 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge_messages"
+)
+
+func TestScenarioResultIsFlakyWhenPassedAfterRetry(t *testing.T) {
+	if !scenarioResultOf(scenarioOf("", gauge_messages.ExecutionStatus_PASSED, 0), 1).Flaky() {
+		t.Error("Expected scenario passed after a retry to be flaky")
+	}
+	if scenarioResultOf(scenarioOf("", gauge_messages.ExecutionStatus_PASSED, 0), 0).Flaky() {
+		t.Error("Expected scenario passed in the first attempt not to be flaky")
+	}
+	if scenarioResultOf(scenarioOf("", gauge_messages.ExecutionStatus_FAILED, 0), 2).Flaky() {
+		t.Error("Expected scenario failed after retries not to be flaky")
+	}
+}
+
+func TestRecordOutcomeKeepsOutcomeWithHighestPrecedence(t *testing.T) {
+	outcomes := make(map[string]string)
+
+	recordOutcome(outcomes, "a", scenarioResultOf(scenarioOf("", gauge_messages.ExecutionStatus_FAILED, 0), 0))
+	recordOutcome(outcomes, "a", scenarioResultOf(scenarioOf("", gauge_messages.ExecutionStatus_PASSED, 0), 1))
+	recordOutcome(outcomes, "b", scenarioResultOf(scenarioOf("", gauge_messages.ExecutionStatus_FAILED, 0), 0))
+	recordOutcome(outcomes, "b", scenarioResultOf(scenarioOf("", gauge_messages.ExecutionStatus_PASSED, 0), 0))
+	recordOutcome(outcomes, "c", scenarioResultOf(scenarioOf("", gauge_messages.ExecutionStatus_SKIPPED, 0), 0))
+
+	want := map[string]string{"a": flakyOutcome, "b": failedOutcome}
+	if !reflect.DeepEqual(outcomes, want) {
+		t.Errorf("Expected outcomes %v, got %v", want, outcomes)
+	}
+}
+
+func TestFlakyHistoryKeepsLastRuns(t *testing.T) {
+	h := newFlakyHistory()
+	h.add(map[string]string{"a": flakyOutcome, "b": passedOutcome})
+	for i := 0; i < maxFlakyHistory; i++ {
+		h.add(map[string]string{"a": passedOutcome})
+	}
+	h.add(map[string]string{"b": flakyOutcome})
+
+	if len(h.Scenarios["a"]) != maxFlakyHistory {
+		t.Errorf("Expected %d runs in history, got %d", maxFlakyHistory, len(h.Scenarios["a"]))
+	}
+	want := map[string]bool{"b": true}
+	if got := h.flakyScenarios(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected flaky scenarios %v, got %v", want, got)
+	}
+}
+
+func TestFlakyHistoryIsSavedInDotGauge(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flaky")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	old := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = old }()
+
+	h := newFlakyHistory()
+	h.add(map[string]string{flakyKey(filepath.Join(dir, "specs", "a.spec"), "Login"): flakyOutcome})
+	writeFlakyHistory(h)
+
+	got := readFlakyHistory()
+	want := map[string][]string{"specs/a.spec:Login": {flakyOutcome}}
+	if !reflect.DeepEqual(got.Scenarios, want) {
+		t.Errorf("Expected history %v, got %v", want, got.Scenarios)
+	}
+}
+
+func TestQuarantinedFailures(t *testing.T) {
+	knownFlakyScenarios = map[string]bool{flakyKey(filepath.Join(config.ProjectRoot, "specs", "a.spec"), "Known flaky"): true}
+	defer func() { knownFlakyScenarios = make(map[string]bool) }()
+
+	res := suiteResultOf(
+		scenarioOf("Known flaky", gauge_messages.ExecutionStatus_FAILED, 0),
+		&gauge_messages.ProtoScenario{ScenarioHeading: "Tagged", Tags: []string{"Quarantine"}, ExecutionStatus: gauge_messages.ExecutionStatus_FAILED},
+		scenarioOf("Passing", gauge_messages.ExecutionStatus_PASSED, 0),
+	)
+
+	n, ok := quarantinedFailures(res)
+
+	if !ok || n != 2 {
+		t.Errorf("Expected 2 quarantined failures only, got %d, %v", n, ok)
+	}
+}
+
+func TestQuarantinedFailuresWithOtherFailures(t *testing.T) {
+	res := suiteResultOf(scenarioOf("Broken", gauge_messages.ExecutionStatus_FAILED, 0))
+
+	if _, ok := quarantinedFailures(res); ok {
+		t.Error("Expected failure of a scenario which is not quarantined to fail the execution")
+	}
+
+	res = suiteResultOf()
+	res.PreSuite = &gauge_messages.ProtoHookFailure{ErrorMessage: "failed"}
+	if _, ok := quarantinedFailures(res); ok {
+		t.Error("Expected before suite hook failure to fail the execution")
+	}
+}
+
+func TestFlakyScenarioIsNoLongerQuarantinedOnceStable(t *testing.T) {
+	h := newFlakyHistory()
+	h.Scenarios = map[string][]string{
+		"fixed":    {flakyOutcome, passedOutcome, passedOutcome, passedOutcome},
+		"broken":   {flakyOutcome, failedOutcome, failedOutcome, failedOutcome},
+		"unstable": {flakyOutcome, passedOutcome, failedOutcome, passedOutcome},
+		"recent":   {passedOutcome, passedOutcome, passedOutcome, flakyOutcome, passedOutcome},
+	}
+
+	want := map[string]bool{"unstable": true, "recent": true}
+	if got := h.flakyScenarios(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected flaky scenarios %v, got %v", want, got)
+	}
+}
+
+func TestQuarantinedFailuresWithSuiteSetupFailure(t *testing.T) {
+	res := suiteResultOf(&gauge_messages.ProtoScenario{ScenarioHeading: "Tagged", Tags: []string{"quarantine"}, ExecutionStatus: gauge_messages.ExecutionStatus_FAILED})
+	res.SuiteSetup = &result.ScenarioResult{ProtoScenario: &gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_FAILED, Failed: true}}
+
+	if _, ok := quarantinedFailures(res); ok {
+		t.Error("Expected suite setup failure to fail the execution")
+	}
+}
+
+func TestExitCodeIgnoresQuarantinedFailuresInQuarantineMode(t *testing.T) {
+	defer func() { Quarantine = false }()
+	res := suiteResultOf(&gauge_messages.ProtoScenario{ScenarioHeading: "Tagged", Tags: []string{"quarantine"}, ExecutionStatus: gauge_messages.ExecutionStatus_FAILED})
+
+	if got := exitCode(res, true); got != ExecutionFailed {
+		t.Errorf("Expected exit code %d without quarantine mode, got %d", ExecutionFailed, got)
+	}
+	Quarantine = true
+	if got := exitCode(res, true); got != Success {
+		t.Errorf("Expected exit code %d in quarantine mode, got %d", Success, got)
+	}
+	if got := exitCode(res, false); got != ParseFailed {
+		t.Errorf("Expected exit code %d on parse failure, got %d", ParseFailed, got)
+	}
+}
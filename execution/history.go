@@ -0,0 +1,167 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package execution
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/filter"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	historyDir     = "history"
+	historyFileExt = ".pb.gz"
+	// historyFileLayout names the result files so that they sort in the order of the runs
+	historyFileLayout = "20060102T150405.000000000Z"
+	// maxHistory is the number of runs kept in the result history
+	maxHistory = 100
+)
+
+// shouldSaveHistory tells if the result of the run is added to the result history. The runs of the failed scenarios, of a
+// single shard and of watch mode execute a part of the specs, they are left out so that they do not distort the trends.
+func shouldSaveHistory() bool {
+	return env.SaveExecutionHistory() && !RerunFailed && filter.Shard == "" && !Watch
+}
+
+// ListenSuiteEndAndSaveHistory listens to execution events and adds the suite result to the result history in .gauge folder.
+func ListenSuiteEndAndSaveHistory(wg *sync.WaitGroup) {
+	ch := make(chan event.ExecutionEvent, 0)
+	event.Register(ch, event.SuiteEnd)
+	wg.Add(1)
+
+	go func() {
+		for {
+			e := <-ch
+			if e.Topic == event.SuiteEnd {
+				saveToHistory(gauge.ConvertToProtoSuiteResult(e.Result.(*result.SuiteResult)), time.Now())
+				wg.Done()
+				return
+			}
+		}
+	}()
+}
+
+func historyPath() string {
+	return filepath.Join(config.ProjectRoot, dotGauge, historyDir)
+}
+
+// saveToHistory writes the result as a new compressed file in the history and removes the oldest results beyond maxHistory.
+func saveToHistory(res *gauge_messages.ProtoSuiteResult, t time.Time) {
+	dir := historyPath()
+	if err := os.MkdirAll(dir, common.NewDirectoryPermissions); err != nil {
+		logger.Errorf(true, "Failed to create directory in %s. Reason: %s", dir, err.Error())
+		return
+	}
+	b, err := proto.Marshal(res)
+	if err != nil {
+		logger.Errorf(true, "Unable to marshal suite execution result, skipping save to history. %s", err.Error())
+		return
+	}
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err = w.Write(b); err == nil {
+		err = w.Close()
+	}
+	if err != nil {
+		logger.Errorf(true, "Unable to compress suite execution result, skipping save to history. %s", err.Error())
+		return
+	}
+	file := filepath.Join(dir, t.UTC().Format(historyFileLayout)+historyFileExt)
+	if err = ioutil.WriteFile(file, buf.Bytes(), common.NewFilePermissions); err != nil {
+		logger.Errorf(true, "Failed to write to %s. Reason: %s", file, err.Error())
+		return
+	}
+	files := historyFiles()
+	for i := 0; i < len(files)-maxHistory; i++ {
+		if err = os.Remove(files[i]); err != nil {
+			logger.Debugf(true, "Failed to remove %s from history. %s", files[i], err.Error())
+		}
+	}
+}
+
+// historyFiles gives the result files in the history, oldest first.
+func historyFiles() []string {
+	infos, err := ioutil.ReadDir(historyPath())
+	if err != nil {
+		return nil
+	}
+	var files []string
+	for _, info := range infos {
+		if !info.IsDir() && strings.HasSuffix(info.Name(), historyFileExt) {
+			files = append(files, filepath.Join(historyPath(), info.Name()))
+		}
+	}
+	sort.Strings(files)
+	return files
+}
+
+// readHistory gives the results of the last n runs, oldest first. Results which cannot be read are skipped.
+func readHistory(n int) []*gauge_messages.ProtoSuiteResult {
+	files := historyFiles()
+	if len(files) > n {
+		files = files[len(files)-n:]
+	}
+	var results []*gauge_messages.ProtoSuiteResult
+	for _, file := range files {
+		res, err := readHistoryFile(file)
+		if err != nil {
+			logger.Warningf(true, "Ignoring invalid result %s in history. Reason: %s", file, err.Error())
+			continue
+		}
+		results = append(results, res)
+	}
+	return results
+}
+
+func readHistoryFile(file string) (*gauge_messages.ProtoSuiteResult, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	res := &gauge_messages.ProtoSuiteResult{}
+	if err = proto.Unmarshal(b, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
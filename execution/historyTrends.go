@@ -0,0 +1,235 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package execution
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+)
+
+// runOutcome is the outcome of an item in a run. Items executed more than once in a run, like the rows of a table
+// driven scenario or a step used by many scenarios, fail if any execution failed and take the average duration.
+type runOutcome struct {
+	failed bool
+	total  int64
+	count  int64
+}
+
+func (o *runOutcome) duration() int64 {
+	return o.total / o.count
+}
+
+// itemHistory holds the outcomes of a spec, scenario or step in the runs of the history, oldest first.
+// Runs in which the item was not executed have no outcome.
+type itemHistory struct {
+	name     string
+	outcomes []*runOutcome
+	children map[string]*itemHistory
+}
+
+func newItemHistory(name string, runs int) *itemHistory {
+	return &itemHistory{name: name, outcomes: make([]*runOutcome, runs), children: make(map[string]*itemHistory)}
+}
+
+func (h *itemHistory) record(run int, failed bool, duration int64) {
+	o := h.outcomes[run]
+	if o == nil {
+		o = &runOutcome{}
+		h.outcomes[run] = o
+	}
+	o.failed = o.failed || failed
+	o.total += duration
+	o.count++
+}
+
+func (h *itemHistory) executed() []*runOutcome {
+	var executed []*runOutcome
+	for _, o := range h.outcomes {
+		if o != nil {
+			executed = append(executed, o)
+		}
+	}
+	return executed
+}
+
+func (h *itemHistory) passRate() float64 {
+	executed := h.executed()
+	passed := 0
+	for _, o := range executed {
+		if !o.failed {
+			passed++
+		}
+	}
+	return 100 * float64(passed) / float64(len(executed))
+}
+
+// durationGrowth gives the duration in the last run in which the item was executed, compared to the average of the earlier runs.
+func (h *itemHistory) durationGrowth() (earlier, last int64, ok bool) {
+	executed := h.executed()
+	if len(executed) < 2 {
+		return 0, 0, false
+	}
+	for _, o := range executed[:len(executed)-1] {
+		earlier += o.duration()
+	}
+	return earlier / int64(len(executed)-1), executed[len(executed)-1].duration(), true
+}
+
+// changedInLastRun tells if the item failed in the last run and passed in the run before, or the other way round if failed is false.
+func (h *itemHistory) changedInLastRun(failed bool) bool {
+	n := len(h.outcomes)
+	if n < 2 || h.outcomes[n-1] == nil || h.outcomes[n-2] == nil {
+		return false
+	}
+	return h.outcomes[n-1].failed == failed && h.outcomes[n-2].failed != failed
+}
+
+func (h *itemHistory) child(name string) *itemHistory {
+	c, ok := h.children[name]
+	if !ok {
+		c = newItemHistory(name, len(h.outcomes))
+		h.children[name] = c
+	}
+	return c
+}
+
+func (h *itemHistory) sortedChildren() []*itemHistory {
+	var children []*itemHistory
+	for _, c := range h.children {
+		children = append(children, c)
+	}
+	sort.Slice(children, func(i, j int) bool { return children[i].name < children[j].name })
+	return children
+}
+
+// historyTrends holds the history of the specs, with their scenarios as children, and of the steps over the given runs.
+type historyTrends struct {
+	runs  []*gauge_messages.ProtoSuiteResult
+	specs *itemHistory
+	steps *itemHistory
+}
+
+func newHistoryTrends(runs []*gauge_messages.ProtoSuiteResult) *historyTrends {
+	t := &historyTrends{runs: runs, specs: newItemHistory("", len(runs)), steps: newItemHistory("", len(runs))}
+	for run, res := range runs {
+		for _, specRes := range res.GetSpecResults() {
+			if specRes.GetSkipped() {
+				continue
+			}
+			spec := specRes.GetProtoSpec()
+			specHistory := t.specs.child(filepath.ToSlash(util.RelPathToProjectRoot(spec.GetFileName())))
+			specHistory.record(run, specRes.GetFailed(), specRes.GetExecutionTime())
+			for _, item := range spec.GetItems() {
+				scn := item.GetScenario()
+				if item.GetItemType() == gauge_messages.ProtoItem_TableDrivenScenario {
+					scn = item.GetTableDrivenScenario().GetScenario()
+				}
+				if scn == nil || scn.GetExecutionStatus() == gauge_messages.ExecutionStatus_SKIPPED {
+					continue
+				}
+				specHistory.child(scn.GetScenarioHeading()).record(run, scn.GetExecutionStatus() == gauge_messages.ExecutionStatus_FAILED, scn.GetExecutionTime())
+				forEachExecutedStep(scn, func(step *gauge_messages.ProtoStep, res *gauge_messages.ProtoExecutionResult) {
+					t.steps.child(step.GetParsedText()).record(run, res.GetFailed(), res.GetExecutionTime())
+				})
+			}
+		}
+	}
+	return t
+}
+
+// scenariosChangedInLastRun gives the scenarios, as spec file:heading, which failed in the last run and passed in the
+// run before, or the other way round if failed is false.
+func (t *historyTrends) scenariosChangedInLastRun(failed bool) []string {
+	var changed []string
+	for _, spec := range t.specs.sortedChildren() {
+		for _, scn := range spec.sortedChildren() {
+			if scn.changedInLastRun(failed) {
+				changed = append(changed, fmt.Sprintf("%s:%s", spec.name, scn.name))
+			}
+		}
+	}
+	return changed
+}
+
+// slowestGrowingSteps gives at most n steps whose duration in their last run grew the most over their earlier average.
+func (t *historyTrends) slowestGrowingSteps(n int) []*itemHistory {
+	var growing []*itemHistory
+	growth := make(map[*itemHistory]int64)
+	for _, step := range t.steps.sortedChildren() {
+		if earlier, last, ok := step.durationGrowth(); ok && last > earlier {
+			growing = append(growing, step)
+			growth[step] = last - earlier
+		}
+	}
+	sort.SliceStable(growing, func(i, j int) bool { return growth[growing[i]] > growth[growing[j]] })
+	if len(growing) > n {
+		growing = growing[:n]
+	}
+	return growing
+}
+
+// ShowHistory prints the trends over the last runs in the result history: the pass rate and duration of every spec and
+// scenario, the scenarios newly failing and newly fixed in the last run and at most top steps whose duration grew the most.
+func ShowHistory(runs, top int) int {
+	results := readHistory(runs)
+	if len(results) == 0 {
+		logger.Infof(true, "No execution results found in %s.", historyPath())
+		return Success
+	}
+	t := newHistoryTrends(results)
+	logger.Infof(true, "[Runs]")
+	for _, res := range t.runs {
+		logger.Infof(true, "%s\t%d specs\t%.1f%% passed\t%s", res.GetTimestamp(), len(res.GetSpecResults()), res.GetSuccessRate(), formatDuration(res.GetExecutionTime()))
+	}
+	logger.Infof(true, "[Specifications]")
+	for _, spec := range t.specs.sortedChildren() {
+		logger.Infof(true, "%s", historyLine(spec))
+		for _, scn := range spec.sortedChildren() {
+			logger.Infof(true, "\t%s", historyLine(scn))
+		}
+	}
+	logger.Infof(true, "[Newly failing scenarios]")
+	for _, scn := range t.scenariosChangedInLastRun(true) {
+		logger.Infof(true, "%s", scn)
+	}
+	logger.Infof(true, "[Newly fixed scenarios]")
+	for _, scn := range t.scenariosChangedInLastRun(false) {
+		logger.Infof(true, "%s", scn)
+	}
+	logger.Infof(true, "[Slowest growing steps]")
+	for _, step := range t.slowestGrowingSteps(top) {
+		earlier, last, _ := step.durationGrowth()
+		logger.Infof(true, "%s\t+%s\t%s -> %s", step.name, formatDuration(last-earlier), formatDuration(earlier), formatDuration(last))
+	}
+	return Success
+}
+
+func historyLine(h *itemHistory) string {
+	executed := h.executed()
+	return fmt.Sprintf("%s\t%.1f%% passed in %d run(s)\t%s -> %s", h.name, h.passRate(), len(executed),
+		formatDuration(executed[0].duration()), formatDuration(executed[len(executed)-1].duration()))
+}
+
+func formatDuration(ms int64) string {
+	return (time.Duration(ms) * time.Millisecond).String()
+}
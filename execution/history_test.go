@@ -0,0 +1,117 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package execution
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/filter"
+	gm "github.com/getgauge/gauge/gauge_messages"
+)
+
+func TestHistoryIsSavedAndReadOldestFirst(t *testing.T) {
+	dir, err := ioutil.TempDir("", "history")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	old := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = old }()
+	now := time.Now()
+
+	saveToHistory(&gm.ProtoSuiteResult{Timestamp: "second"}, now)
+	saveToHistory(&gm.ProtoSuiteResult{Timestamp: "first"}, now.Add(-time.Hour))
+	saveToHistory(&gm.ProtoSuiteResult{Timestamp: "third"}, now.Add(time.Hour))
+
+	var got []string
+	for _, res := range readHistory(2) {
+		got = append(got, res.GetTimestamp())
+	}
+	if want := []string{"second", "third"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected last 2 runs %v, got %v", want, got)
+	}
+}
+
+func TestHistoryTrendsOfScenarios(t *testing.T) {
+	old := config.ProjectRoot
+	config.ProjectRoot = "project"
+	defer func() { config.ProjectRoot = old }()
+	runs := []*gm.ProtoSuiteResult{
+		protoSuiteResultOf(scenarioOf("a", gm.ExecutionStatus_PASSED, 100), scenarioOf("b", gm.ExecutionStatus_FAILED, 100)),
+		protoSuiteResultOf(scenarioOf("a", gm.ExecutionStatus_PASSED, 100), scenarioOf("b", gm.ExecutionStatus_FAILED, 100)),
+		protoSuiteResultOf(scenarioOf("a", gm.ExecutionStatus_FAILED, 300), scenarioOf("b", gm.ExecutionStatus_PASSED, 100)),
+	}
+
+	trends := newHistoryTrends(runs)
+
+	if got := trends.scenariosChangedInLastRun(true); !reflect.DeepEqual(got, []string{"specs/a.spec:a"}) {
+		t.Errorf("Expected scenario a to be newly failing, got %v", got)
+	}
+	if got := trends.scenariosChangedInLastRun(false); !reflect.DeepEqual(got, []string{"specs/a.spec:b"}) {
+		t.Errorf("Expected scenario b to be newly fixed, got %v", got)
+	}
+	a := trends.specs.children["specs/a.spec"].children["a"]
+	if rate := a.passRate(); rate < 66.6 || rate > 66.7 {
+		t.Errorf("Expected pass rate of 66.7%% for scenario a, got %f", rate)
+	}
+}
+
+func TestSlowestGrowingSteps(t *testing.T) {
+	runs := []*gm.ProtoSuiteResult{
+		protoSuiteResultOf(scenarioOf("a", gm.ExecutionStatus_PASSED, 100, stepOf("fast", 10), stepOf("slow", 50), stepOf("slow", 70))),
+		protoSuiteResultOf(scenarioOf("a", gm.ExecutionStatus_PASSED, 100, stepOf("fast", 20), stepOf("slow", 100), stepOf("shrinking", 10))),
+		protoSuiteResultOf(scenarioOf("a", gm.ExecutionStatus_PASSED, 100, stepOf("fast", 30), stepOf("slow", 200), stepOf("shrinking", 5))),
+	}
+
+	got := newHistoryTrends(runs).slowestGrowingSteps(5)
+
+	if len(got) != 2 || got[0].name != "slow" || got[1].name != "fast" {
+		t.Fatalf("Expected steps slow and fast, got %v", got)
+	}
+	if earlier, last, _ := got[0].durationGrowth(); earlier != 80 || last != 200 {
+		t.Errorf("Expected slow step to grow from 80 to 200, got %d to %d", earlier, last)
+	}
+}
+
+func TestHistoryIsSavedOnlyForCompleteRuns(t *testing.T) {
+	defer func(save func() bool) { env.SaveExecutionHistory = save }(env.SaveExecutionHistory)
+	env.SaveExecutionHistory = func() bool { return true }
+	defer func() { RerunFailed = false; filter.Shard = ""; Watch = false }()
+
+	if !shouldSaveHistory() {
+		t.Error("Expected history to be saved for a complete run")
+	}
+	RerunFailed = true
+	if shouldSaveHistory() {
+		t.Error("Expected history not to be saved for the run of failed scenarios")
+	}
+	RerunFailed, filter.Shard = false, "1/2"
+	if shouldSaveHistory() {
+		t.Error("Expected history not to be saved for the run of a shard")
+	}
+	filter.Shard, Watch = "", true
+	if shouldSaveHistory() {
+		t.Error("Expected history not to be saved for a run in watch mode")
+	}
+}
@@ -18,20 +18,23 @@
 package execution
 
 import (
+	"sort"
 	"time"
 
-	"strings"
-
 	"github.com/getgauge/gauge/execution/result"
 	m "github.com/getgauge/gauge/gauge_messages"
 )
 
-func mergeDataTableSpecResults(sResult *result.SuiteResult) *result.SuiteResult {
+// mergeDataTableSpecResults combines the results of the parts of every spec which was split for execution. The execution time of a
+// spec is the longest one of its parts if they ran in parallel, the sum of them otherwise.
+func mergeDataTableSpecResults(sResult *result.SuiteResult, inParallel bool) *result.SuiteResult {
 	suiteRes := result.NewSuiteResult(sResult.Tags, time.Now())
 	suiteRes.IsFailed = sResult.IsFailed
 	suiteRes.ExecutionTime = sResult.ExecutionTime
 	suiteRes.PostSuite = sResult.PostSuite
 	suiteRes.PreSuite = sResult.PreSuite
+	suiteRes.SuiteSetup = sResult.SuiteSetup
+	suiteRes.SuiteTeardown = sResult.SuiteTeardown
 	suiteRes.UnhandledErrors = sResult.UnhandledErrors
 	suiteRes.Timestamp = sResult.Timestamp
 	suiteRes.ProjectName = sResult.ProjectName
@@ -47,10 +50,7 @@ func mergeDataTableSpecResults(sResult *result.SuiteResult) *result.SuiteResult
 		combinedResults[fileName] = append(combinedResults[fileName], res)
 	}
 	for _, res := range combinedResults {
-		mergedRes := res[0]
-		if len(res) > 1 {
-			mergedRes = mergeResults(res)
-		}
+		mergedRes := mergeSpecResults(res, inParallel)
 		if mergedRes.GetFailed() {
 			suiteRes.SpecsFailedCount++
 		} else if mergedRes.Skipped {
@@ -61,7 +61,18 @@ func mergeDataTableSpecResults(sResult *result.SuiteResult) *result.SuiteResult
 	return suiteRes
 }
 
-func mergeResults(results []*result.SpecResult) *result.SpecResult {
+// mergeSpecResults combines the results of the parts of a spec, data table specs are split by rows and the others by scenarios.
+func mergeSpecResults(results []*result.SpecResult, inParallel bool) *result.SpecResult {
+	if len(results) == 1 {
+		return results[0]
+	}
+	if results[0].ProtoSpec.GetIsTableDriven() {
+		return mergeResults(results, inParallel)
+	}
+	return mergeScenarioSpecResults(results, inParallel)
+}
+
+func mergeResults(results []*result.SpecResult, inParallel bool) *result.SpecResult {
 	specResult := &result.SpecResult{ProtoSpec: &m.ProtoSpec{IsTableDriven: true}}
 	var scnResults []*m.ProtoItem
 	table := &m.ProtoTable{}
@@ -94,7 +105,7 @@ func mergeResults(results []*result.SpecResult) *result.SpecResult {
 		addHookFailure(table, res.GetPreHook(), specResult.AddPreHook)
 		addHookFailure(table, res.GetPostHook(), specResult.AddPostHook)
 	}
-	if InParallel {
+	if inParallel {
 		specResult.ExecutionTime = max
 	}
 	aggregateDataTableScnStats(dataTableScnResults, specResult)
@@ -105,6 +116,98 @@ func mergeResults(results []*result.SpecResult) *result.SpecResult {
 	return specResult
 }
 
+// mergeScenarioSpecResults combines the results of specs which were split per scenario, restoring the order of scenarios in the spec file.
+func mergeScenarioSpecResults(results []*result.SpecResult, inParallel bool) *result.SpecResult {
+	specResult := &result.SpecResult{ProtoSpec: &m.ProtoSpec{}, Skipped: true}
+	var scnResults []*m.ProtoItem
+	max := results[0].ExecutionTime
+	for _, res := range results {
+		specResult.Errors = appendErrors(specResult.Errors, res.Errors)
+		specResult.ExecutionTime += res.ExecutionTime
+		if res.ExecutionTime > max {
+			max = res.ExecutionTime
+		}
+		if res.GetFailed() {
+			specResult.IsFailed = true
+		}
+		if !res.Skipped {
+			specResult.Skipped = false
+		}
+		// the rows of a scenario data table may be split across the results, they are counted once all are collected
+		count, failed, skipped := result.ScenarioTableStats(res.ProtoSpec.Items)
+		specResult.ScenarioCount += res.ScenarioCount - count
+		specResult.ScenarioFailedCount += res.ScenarioFailedCount - failed
+		specResult.ScenarioSkippedCount += res.ScenarioSkippedCount - skipped
+		for _, item := range res.ProtoSpec.Items {
+			if item.ItemType == m.ProtoItem_Scenario || item.ItemType == m.ProtoItem_TableDrivenScenario {
+				scnResults = append(scnResults, item)
+			}
+		}
+		specResult.AddPreHook(res.GetPreHook()...)
+		specResult.AddPostHook(res.GetPostHook()...)
+	}
+	count, failed, skipped := result.ScenarioTableStats(scnResults)
+	specResult.ScenarioCount += count
+	specResult.ScenarioFailedCount += failed
+	specResult.ScenarioSkippedCount += skipped
+	if inParallel {
+		specResult.ExecutionTime = max
+	}
+	sort.SliceStable(scnResults, func(i, j int) bool {
+		lineI, rowI := scenarioPosition(scnResults[i])
+		lineJ, rowJ := scenarioPosition(scnResults[j])
+		return lineI < lineJ || (lineI == lineJ && rowI < rowJ)
+	})
+	specResult.ProtoSpec.FileName = results[0].ProtoSpec.FileName
+	specResult.ProtoSpec.Tags = results[0].ProtoSpec.Tags
+	specResult.ProtoSpec.SpecHeading = results[0].ProtoSpec.SpecHeading
+	specResult.ProtoSpec.Items = replaceScenarios(results[0].ProtoSpec.Items, scnResults)
+	return specResult
+}
+
+// replaceScenarios replaces the scenarios among the items with the given scenarios, which take the place of the first one.
+// A result can have several scenarios of the spec, the given scenarios are still added only once.
+func replaceScenarios(items []*m.ProtoItem, scenarios []*m.ProtoItem) (replaced []*m.ProtoItem) {
+	added := false
+	for _, item := range items {
+		switch item.ItemType {
+		case m.ProtoItem_Scenario, m.ProtoItem_TableDrivenScenario:
+			if !added {
+				replaced = append(replaced, scenarios...)
+				added = true
+			}
+		default:
+			replaced = append(replaced, item)
+		}
+	}
+	return
+}
+
+// appendErrors adds the errors which are not there yet, the parts of a spec share the errors of the spec.
+func appendErrors(errs []*m.Error, more []*m.Error) []*m.Error {
+	for _, e := range more {
+		exists := false
+		for _, existing := range errs {
+			if existing.Type == e.Type && existing.Filename == e.Filename && existing.LineNumber == e.LineNumber && existing.Message == e.Message {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			errs = append(errs, e)
+		}
+	}
+	return errs
+}
+
+// scenarioPosition gives the line number of the scenario, scenario data table rows are ordered by their row index.
+func scenarioPosition(item *m.ProtoItem) (line int64, row int32) {
+	if item.ItemType == m.ProtoItem_TableDrivenScenario {
+		return item.TableDrivenScenario.GetScenario().GetSpan().GetStart(), item.TableDrivenScenario.GetScenarioTableRowIndex()
+	}
+	return item.GetScenario().GetSpan().GetStart(), 0
+}
+
 func addHookFailure(table *m.ProtoTable, f []*m.ProtoHookFailure, add func(...*m.ProtoHookFailure)) {
 	for _, h := range f {
 		h.TableRowIndex = int32(len(table.Rows) - 1)
@@ -132,20 +235,16 @@ func getItems(table *m.ProtoTable, scnResults []*m.ProtoItem, results []*result.
 func aggregateDataTableScnStats(results map[string][]*m.ProtoTableDrivenScenario, specResult *result.SpecResult) {
 	for _, dResult := range results {
 		for _, res := range dResult {
-			isTableIndicesExcluded := false
+			if res.Scenario.GetExcludedTableRow() {
+				continue
+			}
 			if res.Scenario.ExecutionStatus == m.ExecutionStatus_FAILED {
 				specResult.ScenarioFailedCount++
-			} else if res.Scenario.ExecutionStatus == m.ExecutionStatus_SKIPPED &&
-				!strings.Contains(res.Scenario.SkipErrors[0], "--table-rows") {
+			} else if res.Scenario.ExecutionStatus == m.ExecutionStatus_SKIPPED {
 				specResult.ScenarioSkippedCount++
 				specResult.Skipped = true
-			} else if res.Scenario.ExecutionStatus == m.ExecutionStatus_SKIPPED &&
-				strings.Contains(res.Scenario.SkipErrors[0], "--table-rows") {
-				isTableIndicesExcluded = true
-			}
-			if !isTableIndicesExcluded {
-				specResult.ScenarioCount++
 			}
+			specResult.ScenarioCount++
 		}
 	}
 }
@@ -62,8 +62,9 @@ func TestAggregateDataTableScnStats(t *testing.T) {
 			{Scenario: &gm.ProtoScenario{ExecutionStatus: gm.ExecutionStatus_PASSED}},
 			{Scenario: &gm.ProtoScenario{ExecutionStatus: gm.ExecutionStatus_FAILED}},
 			{Scenario: &gm.ProtoScenario{
-				ExecutionStatus: gm.ExecutionStatus_SKIPPED,
-				SkipErrors:      []string{"--table-rows"},
+				ExecutionStatus:  gm.ExecutionStatus_SKIPPED,
+				SkipErrors:       []string{"skipped Reason: Doesn't satisfy --table-rows flag condition"},
+				ExcludedTableRow: true,
 			}},
 		},
 		"heading2": []*gm.ProtoTableDrivenScenario{{Scenario: &gm.ProtoScenario{
@@ -119,7 +120,7 @@ func TestMergeResults(t *testing.T) {
 				},
 			}, ExecutionTime: int64(2),
 		},
-	})
+	}, false)
 	want := &result.SpecResult{
 		ProtoSpec: &gm.ProtoSpec{
 			PreHookFailures: []*gm.ProtoHookFailure{{StackTrace: "stacktrace"}, {StackTrace: "stacktrace1", TableRowIndex: 1}},
@@ -187,7 +188,7 @@ func TestMergeSkippedResults(t *testing.T) {
 			}, ExecutionTime: int64(2),
 			Skipped: true,
 		},
-	})
+	}, false)
 	want := &result.SpecResult{
 		ProtoSpec: &gm.ProtoSpec{
 			PreHookFailures: []*gm.ProtoHookFailure{{StackTrace: "stacktrace"}, {StackTrace: "stacktrace1", TableRowIndex: 1}},
@@ -219,8 +220,6 @@ func TestMergeSkippedResults(t *testing.T) {
 }
 
 func TestMergeResultsExecutionTimeInParallel(t *testing.T) {
-	InParallel = true
-
 	got := mergeResults([]*result.SpecResult{
 		{
 			ProtoSpec: &gm.ProtoSpec{
@@ -232,10 +231,9 @@ func TestMergeResultsExecutionTimeInParallel(t *testing.T) {
 				SpecHeading: "heading", FileName: "filename", Tags: []string{"tags"},
 			}, ExecutionTime: int64(2),
 		},
-	})
+	}, true)
 
 	want := int64(2)
-	InParallel = false
 
 	if !reflect.DeepEqual(got.ExecutionTime, want) {
 		t.Errorf("Execution time in parallel data table spec results.\n\tWant: %v\n\tGot: %v", want, got.ExecutionTime)
@@ -258,7 +256,7 @@ func TestMergeDataTableSpecResults(t *testing.T) {
 			},
 		},
 	}
-	got := mergeDataTableSpecResults(res)
+	got := mergeDataTableSpecResults(res, false)
 
 	want := &result.SuiteResult{
 		Environment: "env",
@@ -302,3 +300,123 @@ func TestGetItems(t *testing.T) {
 		t.Errorf("Merge data table spec results failed.\n\tWant: %v\n\tGot: %v", want, got)
 	}
 }
+
+func TestMergeScenarioSpecResults(t *testing.T) {
+	res := &result.SuiteResult{
+		SpecResults: []*result.SpecResult{
+			{
+				ProtoSpec: &gm.ProtoSpec{
+					SpecHeading: "heading", FileName: "filename", Tags: []string{"tags"},
+					Items: []*gm.ProtoItem{
+						{ItemType: gm.ProtoItem_Comment, Comment: &gm.ProtoComment{Text: "comment"}},
+						{ItemType: gm.ProtoItem_Scenario, Scenario: &gm.ProtoScenario{ExecutionStatus: gm.ExecutionStatus_FAILED, ScenarioHeading: "scenario 2", Span: &gm.Span{Start: 10}}},
+					},
+					PreHookFailures: []*gm.ProtoHookFailure{{ErrorMessage: "before spec"}},
+				},
+				ScenarioCount: 1, ScenarioFailedCount: 1, IsFailed: true, ExecutionTime: int64(2),
+			},
+			{
+				ProtoSpec: &gm.ProtoSpec{
+					SpecHeading: "heading", FileName: "filename", Tags: []string{"tags"},
+					Items: []*gm.ProtoItem{
+						{ItemType: gm.ProtoItem_Comment, Comment: &gm.ProtoComment{Text: "comment"}},
+						{ItemType: gm.ProtoItem_Scenario, Scenario: &gm.ProtoScenario{ExecutionStatus: gm.ExecutionStatus_PASSED, ScenarioHeading: "scenario 1", Span: &gm.Span{Start: 5}}},
+					},
+				},
+				ScenarioCount: 1, ExecutionTime: int64(3),
+			},
+		},
+	}
+
+	got := mergeDataTableSpecResults(res, true)
+
+	want := &result.SpecResult{
+		ProtoSpec: &gm.ProtoSpec{
+			SpecHeading: "heading", FileName: "filename", Tags: []string{"tags"},
+			Items: []*gm.ProtoItem{
+				{ItemType: gm.ProtoItem_Comment, Comment: &gm.ProtoComment{Text: "comment"}},
+				{ItemType: gm.ProtoItem_Scenario, Scenario: &gm.ProtoScenario{ExecutionStatus: gm.ExecutionStatus_PASSED, ScenarioHeading: "scenario 1", Span: &gm.Span{Start: 5}}},
+				{ItemType: gm.ProtoItem_Scenario, Scenario: &gm.ProtoScenario{ExecutionStatus: gm.ExecutionStatus_FAILED, ScenarioHeading: "scenario 2", Span: &gm.Span{Start: 10}}},
+			},
+			PreHookFailures: []*gm.ProtoHookFailure{{ErrorMessage: "before spec"}},
+		},
+		ScenarioCount: 2, ScenarioFailedCount: 1, IsFailed: true, ExecutionTime: int64(3),
+	}
+
+	if len(got.SpecResults) != 1 {
+		t.Fatalf("Expected scenario spec results to be merged into one spec result, got %d", len(got.SpecResults))
+	}
+	if got.SpecsFailedCount != 1 {
+		t.Errorf("Expected failed specs count to be 1, got %d", got.SpecsFailedCount)
+	}
+	if !reflect.DeepEqual(got.SpecResults[0], want) {
+		t.Errorf("Merge scenario spec results failed.\n\tWant: %v\n\tGot: %v", want, got.SpecResults[0])
+	}
+}
+
+func TestMergeScenarioSpecResultsCountsTheRowsOfAScenarioDataTableOnce(t *testing.T) {
+	rowResult := func(row int32, status gm.ExecutionStatus, failed bool) *result.SpecResult {
+		res := &result.SpecResult{
+			ProtoSpec: &gm.ProtoSpec{
+				SpecHeading: "heading", FileName: "filename",
+				Items: []*gm.ProtoItem{{ItemType: gm.ProtoItem_TableDrivenScenario, TableDrivenScenario: &gm.ProtoTableDrivenScenario{
+					IsScenarioTableDriven: true, ScenarioTableRowIndex: row,
+					Scenario: &gm.ProtoScenario{ExecutionStatus: status, ScenarioHeading: "scenario", Span: &gm.Span{Start: 5}},
+				}}},
+			},
+			ScenarioCount: 1, IsFailed: failed,
+		}
+		if failed {
+			res.ScenarioFailedCount = 1
+		}
+		return res
+	}
+	res := &result.SuiteResult{SpecResults: []*result.SpecResult{
+		rowResult(1, gm.ExecutionStatus_FAILED, true),
+		rowResult(0, gm.ExecutionStatus_PASSED, false),
+	}}
+
+	got := mergeDataTableSpecResults(res, false).SpecResults[0]
+
+	if got.ScenarioCount != 1 || got.ScenarioFailedCount != 1 || !got.IsFailed {
+		t.Errorf("Expected the rows to be counted as 1 failed scenario, got %d scenarios and %d failed", got.ScenarioCount, got.ScenarioFailedCount)
+	}
+	if got.ProtoSpec.Items[0].TableDrivenScenario.ScenarioTableRowIndex != 0 {
+		t.Errorf("Expected the rows to be ordered by their index")
+	}
+}
+
+func TestReplaceScenariosAddsTheScenariosOnceWhenThereAreSeveral(t *testing.T) {
+	comment := &gm.ProtoItem{ItemType: gm.ProtoItem_Comment, Comment: &gm.ProtoComment{Text: "comment"}}
+	scn := func(heading string) *gm.ProtoItem {
+		return &gm.ProtoItem{ItemType: gm.ProtoItem_Scenario, Scenario: &gm.ProtoScenario{ScenarioHeading: heading}}
+	}
+	merged := []*gm.ProtoItem{scn("scenario 1"), scn("scenario 2"), scn("scenario 3")}
+
+	got := replaceScenarios([]*gm.ProtoItem{comment, scn("scenario 2"), scn("scenario 3")}, merged)
+
+	want := append([]*gm.ProtoItem{comment}, merged...)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected the scenarios to be added once.\n\tWant: %v\n\tGot: %v", want, got)
+	}
+}
+
+func TestMergeScenarioSpecResultsKeepsTheErrorsOfAllParts(t *testing.T) {
+	specErr := &gm.Error{Type: gm.Error_PARSE_ERROR, Filename: "filename", LineNumber: 1, Message: "spec error"}
+	scnErr := &gm.Error{Type: gm.Error_VALIDATION_ERROR, Filename: "filename", LineNumber: 7, Message: "step error"}
+	part := func(line int64, errs ...*gm.Error) *result.SpecResult {
+		return &result.SpecResult{
+			ProtoSpec: &gm.ProtoSpec{FileName: "filename", Items: []*gm.ProtoItem{
+				{ItemType: gm.ProtoItem_Scenario, Scenario: &gm.ProtoScenario{Span: &gm.Span{Start: line}}},
+			}},
+			Errors: errs, ScenarioCount: 1,
+		}
+	}
+
+	got := mergeScenarioSpecResults([]*result.SpecResult{part(3, specErr), part(6, specErr, scnErr)}, false)
+
+	want := []*gm.Error{specErr, scnErr}
+	if !reflect.DeepEqual(got.Errors, want) {
+		t.Errorf("Expected the errors of all parts once.\n\tWant: %v\n\tGot: %v", want, got.Errors)
+	}
+}
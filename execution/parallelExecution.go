@@ -38,12 +38,13 @@ import (
 	"github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/plugin"
 	"github.com/getgauge/gauge/reporter"
 	"github.com/getgauge/gauge/runner"
 )
 
-// Strategy for execution, can be either 'Eager' or 'Lazy'
+// Strategy for execution, can be either 'Eager', 'Lazy' or 'Timed'
 var Strategy string
 
 // Eager is a parallelization strategy for execution. In this case tests are distributed before execution, thus making them an equal number based distribution.
@@ -52,6 +53,9 @@ const Eager string = "eager"
 // Lazy is a parallelization strategy for execution. In this case tests assignment will be dynamic during execution, i.e. assign the next spec in line to the stream that has completed it’s previous execution and is waiting for more work.
 const Lazy string = "lazy"
 
+// Timed is a parallelization strategy for execution. In this case tests are distributed before execution based on the execution times recorded in the previous run, thus making the expected execution time of every stream as equal as possible.
+const Timed string = "timed"
+
 type parallelExecution struct {
 	wg                       sync.WaitGroup
 	manifest                 *manifest.Manifest
@@ -64,6 +68,9 @@ type parallelExecution struct {
 	tagsToFilter             string
 	errMaps                  *gauge.BuildErrors
 	startTime                time.Time
+	resumed                  []*result.SpecResult
+	suiteSpec                *gauge.Specification
+	specHooks                *specHooks
 }
 
 func newParallelExecution(e *executionInfo) *parallelExecution {
@@ -75,6 +82,8 @@ func newParallelExecution(e *executionInfo) *parallelExecution {
 		numberOfExecutionStreams: e.numberOfStreams,
 		tagsToFilter:             e.tagsToFilter,
 		errMaps:                  e.errMaps,
+		resumed:                  e.resumed,
+		suiteSpec:                e.suiteSpec,
 	}
 }
 
@@ -108,6 +117,42 @@ func (e *parallelExecution) start() {
 
 func (e *parallelExecution) run() *result.SuiteResult {
 	e.start()
+	var res []*result.SuiteResult
+	suite, ok := e.setUpSuite()
+	if ok {
+		res = e.executeStreams()
+	}
+	if suite != nil {
+		suite.tearDownSuite()
+		res = append(res, suite.suiteResult)
+	}
+	e.aggregateResults(res)
+
+	e.finish()
+	return e.suiteResult
+}
+
+// setUpSuite executes the contexts of the suite spec once before the streams start, on the runner used for validation.
+// Its teardown steps are executed on the same runner once all the streams are done. The suite hooks are not run on it,
+// they run on the runner of every stream. It returns the execution of the suite spec, if there is one, and false if the
+// streams are not to be executed.
+func (e *parallelExecution) setUpSuite() (*simpleExecution, bool) {
+	if e.suiteSpec == nil {
+		return nil, true
+	}
+	ei := newExecutionInfo(gauge.NewSpecCollection(nil, false), e.runner, e.pluginHandler, e.errMaps, false, 0)
+	ei.suiteSpec = e.suiteSpec
+	se := newSimpleExecution(ei, false)
+	se.suiteResult = result.NewSuiteResult(ExecuteTags, e.startTime)
+	if res := se.initSuiteDataStore(); res.GetFailed() {
+		se.suiteResult.AddUnhandledError(fmt.Errorf("Failed to initialize suite datastore. Error: %s", res.GetErrorMessage()))
+		return se, false
+	}
+	se.setUpSuite()
+	return se, !se.suiteResult.GetFailed()
+}
+
+func (e *parallelExecution) executeStreams() []*result.SuiteResult {
 	var res []*result.SuiteResult
 	if env.AllowFilteredParallelExecution() && e.tagsToFilter != "" {
 		p, s := filter.FilterSpecForParallelRun(e.specCollection.Specs(), e.tagsToFilter)
@@ -121,7 +166,17 @@ func (e *parallelExecution) run() *result.SuiteResult {
 		}
 	}
 
+	if env.AllowScenarioParallelism() {
+		logger.Infof(true, "Distributing scenarios across streams.")
+		e.specCollection = gauge.NewSpecCollection(parser.GetSpecsForScenarios(e.specCollection.Specs(), e.errMaps), false)
+		e.specHooks = newSpecHooks(e.specCollection.Specs())
+	}
+	e.specCollection = gauge.NewSpecCollectionOfGroups(dependencyChains(e.specCollection.Specs()))
+
 	nStreams := e.numberOfStreams()
+	if nStreams > 1 {
+		executedStreams = nStreams
+	}
 	logger.Infof(true, "Executing in %s parallel streams.", strconv.Itoa(nStreams))
 	resChan := make(chan *result.SuiteResult)
 
@@ -130,6 +185,8 @@ func (e *parallelExecution) run() *result.SuiteResult {
 		go e.executeMultithreaded(nStreams, resChan)
 	} else if isLazy() {
 		go e.executeLazily(nStreams, resChan)
+	} else if isTimed() {
+		go e.executeByExecutionTime(nStreams, resChan)
 	} else {
 		go e.executeEagerly(nStreams, resChan)
 	}
@@ -137,10 +194,7 @@ func (e *parallelExecution) run() *result.SuiteResult {
 	for r := range resChan {
 		res = append(res, r)
 	}
-	e.aggregateResults(res)
-
-	e.finish()
-	return e.suiteResult
+	return res
 }
 
 func printAdditionalExecutionInfo(p []*gauge.Specification, s []*gauge.Specification, tags string) {
@@ -200,8 +254,23 @@ func (e *parallelExecution) startMultithreaded(r runner.Runner, resChan chan *re
 }
 
 func (e *parallelExecution) executeEagerly(distributions int, resChan chan *result.SuiteResult) {
-	specs := filter.DistributeSpecs(e.specCollection.Specs(), distributions)
-	e.wg.Add(distributions)
+	specs := distributeChains(e.specCollection.Specs(), func(s []*gauge.Specification) []*gauge.SpecCollection {
+		return filter.DistributeSpecs(s, distributions)
+	})
+	e.wg.Add(len(specs))
+	for i, s := range specs {
+		go e.startStream(s, resChan, i+1)
+	}
+	e.wg.Wait()
+	close(resChan)
+}
+
+func (e *parallelExecution) executeByExecutionTime(distributions int, resChan chan *result.SuiteResult) {
+	execTimes := specExecutionTimesFromLastRun()
+	specs := distributeChains(e.specCollection.Specs(), func(s []*gauge.Specification) []*gauge.SpecCollection {
+		return filter.DistributeSpecsByExecutionTime(s, distributions, execTimes)
+	})
+	e.wg.Add(len(specs))
 	for i, s := range specs {
 		go e.startStream(s, resChan, i+1)
 	}
@@ -209,6 +278,22 @@ func (e *parallelExecution) executeEagerly(distributions int, resChan chan *resu
 	close(resChan)
 }
 
+func specExecutionTimesFromLastRun() map[string]int64 {
+	execTimes := make(map[string]int64)
+	res, err := readLastRunResult()
+	if err != nil {
+		logger.Warningf(true, "Unable to read execution times of previous run, specs will be distributed evenly. %s", err.Error())
+		return execTimes
+	}
+	for _, specResult := range res.GetSpecResults() {
+		if specResult.GetSkipped() {
+			continue
+		}
+		execTimes[specResult.GetProtoSpec().GetFileName()] = specResult.GetExecutionTime()
+	}
+	return execTimes
+}
+
 func (e *parallelExecution) startStream(s *gauge.SpecCollection, resChan chan *result.SuiteResult, stream int) {
 	defer e.wg.Done()
 	runner, err := e.startRunner(s, stream)
@@ -237,9 +322,10 @@ func (e *parallelExecution) startRunner(s *gauge.SpecCollection, stream int) (ru
 
 func (e *parallelExecution) startSpecsExecutionWithRunner(s *gauge.SpecCollection, resChan chan *result.SuiteResult, runner runner.Runner, stream int) {
 	executionInfo := newExecutionInfo(s, runner, e.pluginHandler, e.errMaps, false, stream)
+	executionInfo.specHooks = e.specHooks
 	se := newSimpleExecution(executionInfo, false)
 	se.execute()
-	runner.Kill()
+	se.runner.Kill()
 	resChan <- se.suiteResult
 }
 
@@ -251,12 +337,13 @@ func (e *parallelExecution) executeSpecsInSerial(s *gauge.SpecCollection) *resul
 	executionInfo := newExecutionInfo(s, runner, e.pluginHandler, e.errMaps, false, 1)
 	se := newSimpleExecution(executionInfo, false)
 	se.execute()
-	runner.Kill()
+	se.runner.Kill()
 	return se.suiteResult
 }
 
 func (e *parallelExecution) finish() {
-	e.suiteResult = mergeDataTableSpecResults(e.suiteResult)
+	e.suiteResult.AddSpecResults(e.resumed)
+	e.suiteResult = mergeDataTableSpecResults(e.suiteResult, true)
 	event.Notify(event.NewExecutionEvent(event.SuiteEnd, nil, e.suiteResult, 0, gauge_messages.ExecutionInfo{}))
 	message := &gauge_messages.Message{
 		MessageType: gauge_messages.Message_SuiteExecutionResult,
@@ -282,6 +369,12 @@ func (e *parallelExecution) aggregateResults(suiteResults []*result.SuiteResult)
 		if result.PostSuite != nil {
 			r.PostSuite = result.PostSuite
 		}
+		if result.SuiteSetup != nil {
+			r.SuiteSetup = result.SuiteSetup
+		}
+		if result.SuiteTeardown != nil {
+			r.SuiteTeardown = result.SuiteTeardown
+		}
 		if result.UnhandledErrors != nil {
 			r.UnhandledErrors = append(r.UnhandledErrors, result.UnhandledErrors...)
 		}
@@ -295,9 +388,13 @@ func isLazy() bool {
 	return strings.ToLower(Strategy) == Lazy
 }
 
+func isTimed() bool {
+	return strings.ToLower(Strategy) == Timed
+}
+
 func isValidStrategy(strategy string) bool {
 	strategy = strings.ToLower(strategy)
-	return strategy == Lazy || strategy == Eager
+	return strategy == Lazy || strategy == Eager || strategy == Timed
 }
 
 func (e *parallelExecution) isMultithreaded() bool {
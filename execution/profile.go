@@ -0,0 +1,271 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package execution
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/reporter"
+	"github.com/getgauge/gauge/util"
+)
+
+const (
+	profileFile = "profile.json"
+	// profileTableSize is the number of rows of the profile printed on console, the JSON profile has all of them
+	profileTableSize = 20
+	suiteHooks       = "Suite"
+)
+
+// Profile if true reports the time taken by every step implementation, the hooks of every spec and the idle time
+// of the runners at the end of the execution.
+var Profile bool
+
+var hookTimes = newTimeRecorder()
+
+// executedStreams is the number of streams which executed the specs, set once the specs are distributed to the streams.
+var executedStreams = 1
+
+// timeRecorder collects the execution times by name, from all the streams of the execution.
+type timeRecorder struct {
+	mu    sync.Mutex
+	times map[string][]int64
+}
+
+func newTimeRecorder() *timeRecorder {
+	return &timeRecorder{times: make(map[string][]int64)}
+}
+
+func (r *timeRecorder) record(name string, t int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.times[name] = append(r.times[name], t)
+}
+
+// stats gives the statistics of every name, the one taking the most time in total first.
+func (r *timeRecorder) stats() []*timeStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stats := make([]*timeStats, 0, len(r.times))
+	for name, times := range r.times {
+		stats = append(stats, newTimeStats(name, times))
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Total == stats[j].Total {
+			return stats[i].Name < stats[j].Name
+		}
+		return stats[i].Total > stats[j].Total
+	})
+	return stats
+}
+
+func (r *timeRecorder) total() int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var total int64
+	for _, times := range r.times {
+		for _, t := range times {
+			total += t
+		}
+	}
+	return total
+}
+
+// timeStats holds the execution times, in milliseconds, of a step implementation or of the hooks of a spec.
+type timeStats struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+	Total int64  `json:"total"`
+	Mean  int64  `json:"mean"`
+	P95   int64  `json:"p95"`
+}
+
+func newTimeStats(name string, times []int64) *timeStats {
+	sorted := append([]int64{}, times...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	s := &timeStats{Name: name, Count: len(sorted)}
+	for _, t := range sorted {
+		s.Total += t
+	}
+	s.Mean = s.Total / int64(s.Count)
+	// nearest rank of the 95th percentile
+	s.P95 = sorted[(95*s.Count+99)/100-1]
+	return s
+}
+
+// executionProfile is the profile of an execution. The runner idle time is the time the runners of all the streams
+// were not executing steps or hooks, i.e. spent on gauge, plugins and the communication with the runners.
+type executionProfile struct {
+	ExecutionTime  int64        `json:"executionTime"`
+	Streams        int          `json:"streams"`
+	StepTime       int64        `json:"stepTime"`
+	HookTime       int64        `json:"hookTime"`
+	RunnerIdleTime int64        `json:"runnerIdleTime"`
+	Steps          []*timeStats `json:"steps"`
+	Hooks          []*timeStats `json:"hooks"`
+}
+
+func newExecutionProfile(res *gauge_messages.ProtoSuiteResult, executionTime int64, streams int) *executionProfile {
+	stepTimes := newTimeRecorder()
+	for _, specRes := range res.GetSpecResults() {
+		for _, item := range specRes.GetProtoSpec().GetItems() {
+			scn := item.GetScenario()
+			if item.GetItemType() == gauge_messages.ProtoItem_TableDrivenScenario {
+				scn = item.GetTableDrivenScenario().GetScenario()
+			}
+			forEachExecutedStep(scn, func(step *gauge_messages.ProtoStep, res *gauge_messages.ProtoExecutionResult) {
+				stepTimes.record(step.GetParsedText(), res.GetExecutionTime())
+			})
+		}
+	}
+	p := &executionProfile{
+		ExecutionTime: executionTime,
+		Streams:       streams,
+		StepTime:      stepTimes.total(),
+		HookTime:      hookTimes.total(),
+		Steps:         stepTimes.stats(),
+		Hooks:         hookTimes.stats(),
+	}
+	if idle := executionTime*int64(streams) - p.StepTime - p.HookTime; idle > 0 {
+		p.RunnerIdleTime = idle
+	}
+	return p
+}
+
+// forEachExecutedStep calls f with every step of the scenario which was executed, including the steps of its concepts.
+func forEachExecutedStep(scn *gauge_messages.ProtoScenario, f func(*gauge_messages.ProtoStep, *gauge_messages.ProtoExecutionResult)) {
+	var walk func(items []*gauge_messages.ProtoItem)
+	walk = func(items []*gauge_messages.ProtoItem) {
+		for _, item := range items {
+			switch item.GetItemType() {
+			case gauge_messages.ProtoItem_Step:
+				res := item.GetStep().GetStepExecutionResult()
+				if res.GetExecutionResult() != nil && !res.GetSkipped() {
+					f(item.GetStep(), res.GetExecutionResult())
+				}
+			case gauge_messages.ProtoItem_Concept:
+				walk(item.GetConcept().GetSteps())
+			}
+		}
+	}
+	walk(scn.GetContexts())
+	walk(scn.GetScenarioItems())
+	walk(scn.GetTearDownSteps())
+}
+
+// recordHookTime records the time taken by the hook in the given message against the spec it was run for,
+// if the execution is profiled.
+func recordHookTime(m *gauge_messages.Message, res *gauge_messages.ProtoExecutionResult) {
+	if !Profile {
+		return
+	}
+	var info *gauge_messages.ExecutionInfo
+	switch m.GetMessageType() {
+	case gauge_messages.Message_SpecExecutionStarting:
+		info = m.GetSpecExecutionStartingRequest().GetCurrentExecutionInfo()
+	case gauge_messages.Message_SpecExecutionEnding:
+		info = m.GetSpecExecutionEndingRequest().GetCurrentExecutionInfo()
+	case gauge_messages.Message_ScenarioExecutionStarting:
+		info = m.GetScenarioExecutionStartingRequest().GetCurrentExecutionInfo()
+	case gauge_messages.Message_ScenarioExecutionEnding:
+		info = m.GetScenarioExecutionEndingRequest().GetCurrentExecutionInfo()
+	case gauge_messages.Message_StepExecutionStarting:
+		info = m.GetStepExecutionStartingRequest().GetCurrentExecutionInfo()
+	case gauge_messages.Message_StepExecutionEnding:
+		info = m.GetStepExecutionEndingRequest().GetCurrentExecutionInfo()
+	}
+	name := suiteHooks
+	if info.GetCurrentSpec().GetFileName() != "" {
+		name = filepath.ToSlash(util.RelPathToProjectRoot(info.GetCurrentSpec().GetFileName()))
+	}
+	hookTimes.record(name, res.GetExecutionTime())
+}
+
+// ListenSuiteEndAndWriteProfile listens to execution events, prints the profile of the execution on console and
+// writes it as JSON to the reports directory.
+func ListenSuiteEndAndWriteProfile(wg *sync.WaitGroup) {
+	ch := make(chan event.ExecutionEvent, 0)
+	event.Register(ch, event.SuiteStart, event.SuiteEnd)
+	wg.Add(1)
+	hookTimes = newTimeRecorder()
+	executedStreams = 1
+
+	go func() {
+		var startTime time.Time
+		for {
+			e := <-ch
+			switch e.Topic {
+			case event.SuiteStart:
+				startTime = time.Now()
+			case event.SuiteEnd:
+				executionTime := int64(time.Since(startTime) / time.Millisecond)
+				p := newExecutionProfile(gauge.ConvertToProtoSuiteResult(e.Result.(*result.SuiteResult)), executionTime, executedStreams)
+				printProfile(p)
+				writeProfile(p, filepath.Join(reporter.ReportsDir(), profileFile))
+				wg.Done()
+				return
+			}
+		}
+	}()
+}
+
+func printProfile(p *executionProfile) {
+	logger.Infof(true, "\n[Steps]\nTotal\tCount\tMean\tP95\tStep")
+	printTimeStats(p.Steps)
+	logger.Infof(true, "[Hooks]\nTotal\tCount\tMean\tP95\tSpec")
+	printTimeStats(p.Hooks)
+	logger.Infof(true, "[Runners]\n%d stream(s) for %s: %s in steps, %s in hooks, %s idle\n", p.Streams, formatDuration(p.ExecutionTime),
+		formatDuration(p.StepTime), formatDuration(p.HookTime), formatDuration(p.RunnerIdleTime))
+}
+
+func printTimeStats(stats []*timeStats) {
+	for i, s := range stats {
+		if i == profileTableSize {
+			logger.Infof(true, "... %d more", len(stats)-profileTableSize)
+			return
+		}
+		logger.Infof(true, "%s\t%d\t%s\t%s\t%s", formatDuration(s.Total), s.Count, formatDuration(s.Mean), formatDuration(s.P95), s.Name)
+	}
+}
+
+func writeProfile(p *executionProfile, file string) {
+	if err := os.MkdirAll(filepath.Dir(file), common.NewDirectoryPermissions); err != nil {
+		logger.Errorf(true, "Failed to create directory in %s. Reason: %s", filepath.Dir(file), err.Error())
+		return
+	}
+	contents, err := json.MarshalIndent(p, "", "\t")
+	if err != nil {
+		logger.Errorf(true, "Unable to marshal execution profile, skipping save. %s", err.Error())
+		return
+	}
+	if err = ioutil.WriteFile(file, contents, common.NewFilePermissions); err != nil {
+		logger.Errorf(true, "Failed to write to %s. Reason: %s", file, err.Error())
+		return
+	}
+	logger.Infof(true, "Execution profile written to %s", file)
+}
@@ -0,0 +1,77 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package execution
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/getgauge/gauge/config"
+	gm "github.com/getgauge/gauge/gauge_messages"
+)
+
+func TestTimeStats(t *testing.T) {
+	times := make([]int64, 0, 20)
+	for i := 20; i > 0; i-- {
+		times = append(times, int64(i*10))
+	}
+
+	s := newTimeStats("step", times)
+
+	if s.Count != 20 || s.Total != 2100 || s.Mean != 105 || s.P95 != 190 {
+		t.Errorf("Unexpected stats %+v", s)
+	}
+}
+
+func TestRecordHookTimeBySpec(t *testing.T) {
+	old := config.ProjectRoot
+	config.ProjectRoot = "project"
+	defer func() {
+		config.ProjectRoot = old
+		Profile = false
+		hookTimes = newTimeRecorder()
+	}()
+	Profile = true
+	hookTimes = newTimeRecorder()
+	info := &gm.ExecutionInfo{CurrentSpec: &gm.SpecInfo{FileName: filepath.Join("project", "specs", "a.spec")}}
+
+	recordHookTime(&gm.Message{MessageType: gm.Message_ExecutionStarting, ExecutionStartingRequest: &gm.ExecutionStartingRequest{}}, &gm.ProtoExecutionResult{ExecutionTime: 50})
+	recordHookTime(&gm.Message{MessageType: gm.Message_ScenarioExecutionStarting, ScenarioExecutionStartingRequest: &gm.ScenarioExecutionStartingRequest{CurrentExecutionInfo: info}}, &gm.ProtoExecutionResult{ExecutionTime: 20})
+	recordHookTime(&gm.Message{MessageType: gm.Message_StepExecutionEnding, StepExecutionEndingRequest: &gm.StepExecutionEndingRequest{CurrentExecutionInfo: info}}, &gm.ProtoExecutionResult{ExecutionTime: 40})
+
+	stats := hookTimes.stats()
+	if len(stats) != 2 || stats[0].Name != "specs/a.spec" || stats[0].Total != 60 || stats[1].Name != suiteHooks || stats[1].Total != 50 {
+		t.Errorf("Unexpected hook stats %+v %+v", stats[0], stats[1])
+	}
+}
+
+func TestExecutionProfile(t *testing.T) {
+	defer func() { hookTimes = newTimeRecorder() }()
+	hookTimes = newTimeRecorder()
+	hookTimes.record(suiteHooks, 100)
+	concept := &gm.ProtoItem{ItemType: gm.ProtoItem_Concept, Concept: &gm.ProtoConcept{Steps: []*gm.ProtoItem{stepOf("inner", 250)}}}
+	res := protoSuiteResultOf(scenarioOf("a", gm.ExecutionStatus_PASSED, 0, stepOf("outer", 100), concept, stepOf("outer", 200)))
+
+	p := newExecutionProfile(res, 500, 2)
+
+	if p.StepTime != 550 || p.HookTime != 100 || p.RunnerIdleTime != 350 {
+		t.Errorf("Unexpected times %+v", p)
+	}
+	if len(p.Steps) != 2 || p.Steps[0].Name != "outer" || p.Steps[0].Count != 2 || p.Steps[1].Name != "inner" {
+		t.Errorf("Unexpected step stats %+v", p.Steps)
+	}
+}
@@ -103,13 +103,14 @@ func ListenFailedScenarios(wg *sync.WaitGroup, specDirs []string) {
 				failedMeta.aggregateFailedItems()
 				writeFailedMeta(getJSON(failedMeta))
 				wg.Done()
+				return
 			}
 		}
 	}()
 }
 
 func prepareScenarioFailedMetadata(res *result.ScenarioResult, sce *gauge.Scenario, executionInfo gauge_messages.ExecutionInfo) {
-	if res.GetFailed() {
+	if res.GetFailed() || res.Cancelled() {
 		specPath := executionInfo.GetCurrentSpec().GetFileName()
 		failedScenario := util.RelPathToProjectRoot(specPath)
 		failedMeta.addFailedItem(specPath, fmt.Sprintf("%s:%v", failedScenario, sce.Span.Start))
@@ -141,6 +142,47 @@ func addFailedMetadata(res result.Result, args []string, add func(res result.Res
 	}
 }
 
+// WriteFailedItems writes the failed scenarios of the suite result to be executed by the next run with --failed.
+// Specs with hook failures are executed entirely, all specDirs are executed if a suite hook failed.
+func WriteFailedItems(res *gauge_messages.ProtoSuiteResult, specDirs []string) {
+	meta := newFailedMetaData()
+	if res.GetPreHookFailure() != nil || res.GetPostHookFailure() != nil {
+		for _, dir := range specDirs {
+			path, err := filepath.Abs(dir)
+			path = util.RelPathToProjectRoot(path)
+			if err == nil {
+				meta.addFailedItem(path, path)
+			}
+		}
+	} else {
+		for _, specResult := range res.GetSpecResults() {
+			addFailedSpecItems(meta, specResult.GetProtoSpec())
+		}
+	}
+	meta.aggregateFailedItems()
+	writeFailedMeta(getJSON(meta))
+}
+
+func addFailedSpecItems(meta *failedMetadata, spec *gauge_messages.ProtoSpec) {
+	fileName := util.RelPathToProjectRoot(spec.GetFileName())
+	if len(spec.GetPreHookFailures()) > 0 || len(spec.GetPostHookFailures()) > 0 {
+		meta.addFailedItem(fileName, fileName)
+		return
+	}
+	for _, item := range spec.GetItems() {
+		var sce *gauge_messages.ProtoScenario
+		switch item.GetItemType() {
+		case gauge_messages.ProtoItem_Scenario:
+			sce = item.GetScenario()
+		case gauge_messages.ProtoItem_TableDrivenScenario:
+			sce = item.GetTableDrivenScenario().GetScenario()
+		}
+		if sce.GetExecutionStatus() == gauge_messages.ExecutionStatus_FAILED {
+			meta.addFailedItem(fileName, fmt.Sprintf("%s:%v", fileName, sce.GetSpan().GetStart()))
+		}
+	}
+}
+
 func writeFailedMeta(contents string) {
 	failuresFile := filepath.Join(config.ProjectRoot, common.DotGauge, failedFile)
 	dotGaugeDir := filepath.Join(config.ProjectRoot, common.DotGauge)
@@ -134,3 +134,43 @@ func (s *MySuite) TestGetAllFailedItems(c *C) {
 
 	c.Assert(failedItems, DeepEquals, []string{"scn1", "scn2", "scn3"})
 }
+
+func (s *MySuite) TestAddFailedSpecItemsAddsFailedScenarios(c *C) {
+	spec1Rel := filepath.Join("specs", "example1.spec")
+	spec1Abs := filepath.Join(config.ProjectRoot, spec1Rel)
+	spec := &gauge_messages.ProtoSpec{FileName: spec1Abs, Items: []*gauge_messages.ProtoItem{
+		{ItemType: gauge_messages.ProtoItem_Comment, Comment: &gauge_messages.ProtoComment{Text: "comment"}},
+		{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: &gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_FAILED, Span: &gauge_messages.Span{Start: 3}}},
+		{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: &gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_PASSED, Span: &gauge_messages.Span{Start: 6}}},
+		{ItemType: gauge_messages.ProtoItem_TableDrivenScenario, TableDrivenScenario: &gauge_messages.ProtoTableDrivenScenario{Scenario: &gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_FAILED, Span: &gauge_messages.Span{Start: 9}}}},
+	}}
+	meta := newFailedMetaData()
+
+	addFailedSpecItems(meta, spec)
+
+	c.Assert(meta.failedItemsMap[spec1Rel], DeepEquals, map[string]bool{spec1Rel + ":3": true, spec1Rel + ":9": true})
+}
+
+func (s *MySuite) TestAddFailedSpecItemsAddsSpecWithHookFailure(c *C) {
+	spec1Rel := filepath.Join("specs", "example1.spec")
+	spec1Abs := filepath.Join(config.ProjectRoot, spec1Rel)
+	spec := &gauge_messages.ProtoSpec{FileName: spec1Abs, PreHookFailures: []*gauge_messages.ProtoHookFailure{{ErrorMessage: "error"}}, Items: []*gauge_messages.ProtoItem{
+		{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: &gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_FAILED, Span: &gauge_messages.Span{Start: 3}}},
+	}}
+	meta := newFailedMetaData()
+
+	addFailedSpecItems(meta, spec)
+
+	c.Assert(meta.failedItemsMap[spec1Rel], DeepEquals, map[string]bool{spec1Rel: true})
+}
+
+func (s *MySuite) TestGetCancelledScenarioFailedMetadata(c *C) {
+	spec1Rel := filepath.Join("specs", "example1.spec")
+	spec1Abs := filepath.Join(config.ProjectRoot, spec1Rel)
+	sce := &gauge.Scenario{Span: &gauge.Span{Start: 2}}
+	sr1 := &result.ScenarioResult{ProtoScenario: &gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_SKIPPED, SkipErrors: []string{result.ExecutionCancelled}}}
+
+	prepareScenarioFailedMetadata(sr1, sce, gauge_messages.ExecutionInfo{CurrentSpec: &gauge_messages.SpecInfo{FileName: spec1Abs}})
+
+	c.Assert(failedMeta.failedItemsMap[spec1Abs][spec1Rel+":2"], Equals, true)
+}
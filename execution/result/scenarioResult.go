@@ -21,11 +21,16 @@ import (
 	"github.com/getgauge/gauge/gauge_messages"
 )
 
+// ExecutionCancelled is the reason a scenario is skipped when the execution is cancelled before it is completed.
+const ExecutionCancelled = "Execution was cancelled"
+
 type ScenarioResult struct {
 	ProtoScenario             *gauge_messages.ProtoScenario
 	ScenarioDataTableRow      *gauge_messages.ProtoTable
 	ScenarioDataTableRowIndex int
 	ScenarioDataTable         *gauge_messages.ProtoTable
+	// Retries is the number of failed attempts of the scenario before this one
+	Retries int
 }
 
 func NewScenarioResult(sce *gauge_messages.ProtoScenario) *ScenarioResult {
@@ -43,6 +48,24 @@ func (s ScenarioResult) GetFailed() bool {
 	return s.ProtoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_FAILED
 }
 
+// Flaky returns true if the scenario passed only after being retried
+func (s ScenarioResult) Flaky() bool {
+	return s.Retries > 0 && s.ProtoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_PASSED
+}
+
+// Cancelled returns true if the scenario was skipped because the execution was cancelled
+func (s ScenarioResult) Cancelled() bool {
+	if s.ProtoScenario.GetExecutionStatus() != gauge_messages.ExecutionStatus_SKIPPED {
+		return false
+	}
+	for _, e := range s.ProtoScenario.GetSkipErrors() {
+		if e == ExecutionCancelled {
+			return true
+		}
+	}
+	return false
+}
+
 func (s ScenarioResult) AddItems(protoItems []*gauge_messages.ProtoItem) {
 	s.ProtoScenario.ScenarioItems = append(s.ProtoScenario.ScenarioItems, protoItems...)
 }
@@ -60,10 +60,11 @@ func (specResult *SpecResult) AddScenarioResults(scenarioResults []Result) {
 	specResult.ScenarioCount += len(scenarioResults)
 }
 
+// AddTableDrivenScenarioResult adds the result of a scenario executed for a row of its data table. It does not update
+// the scenario counts, the rows of a scenario are counted together by ScenarioTableStats.
 func (specResult *SpecResult) AddTableDrivenScenarioResult(r *ScenarioResult, t *gauge_messages.ProtoTable, scenarioRowIndex int, specRowIndex int, specTableDriven bool) {
 	if r.GetFailed() {
 		specResult.IsFailed = true
-		specResult.ScenarioFailedCount++
 	}
 	specResult.AddExecTime(r.ExecTime())
 	pItem := &gauge_messages.ProtoItem{
@@ -93,7 +94,12 @@ func (specResult *SpecResult) AddTableRelatedScenarioResult(scenarioResults [][]
 				scenarioFailed = true
 				specResult.FailedDataTableRows = append(specResult.FailedDataTableRows, int32(index))
 			}
-			protoTableDrivenScenario := &gauge_messages.ProtoTableDrivenScenario{Scenario: protoScenario, TableRowIndex: int32(index)}
+			protoTableDrivenScenario := &gauge_messages.ProtoTableDrivenScenario{Scenario: protoScenario, TableRowIndex: int32(index), IsSpecTableDriven: true}
+			if r, ok := eachRow[scenarioIndex].(*ScenarioResult); ok && len(r.ScenarioDataTableRow.GetRows()) > 0 {
+				protoTableDrivenScenario.IsScenarioTableDriven = true
+				protoTableDrivenScenario.ScenarioTableRowIndex = int32(r.ScenarioDataTableRowIndex)
+				protoTableDrivenScenario.ScenarioDataTable = r.ScenarioDataTable
+			}
 			protoItem := &gauge_messages.ProtoItem{ItemType: gauge_messages.ProtoItem_TableDrivenScenario, TableDrivenScenario: protoTableDrivenScenario}
 			specResult.ProtoSpec.Items = append(specResult.ProtoSpec.Items, protoItem)
 		}
@@ -106,6 +112,46 @@ func (specResult *SpecResult) AddTableRelatedScenarioResult(scenarioResults [][]
 	specResult.ScenarioCount += numberOfScenarios
 }
 
+// ScenarioTableStats gives the number of scenarios, failed scenarios and skipped scenarios among the results of
+// scenarios executed for the rows of their data tables in specs without a data table. The rows of a scenario count as
+// one scenario, which fails if any of its rows fails and is skipped if all of its rows are skipped. The rows excluded
+// by --table-rows are not counted.
+func ScenarioTableStats(items []*gauge_messages.ProtoItem) (count, failed, skipped int) {
+	type rows struct{ total, failed, skipped int }
+	scenarios := make(map[int64]*rows)
+	for _, item := range items {
+		t := item.GetTableDrivenScenario()
+		if item.GetItemType() != gauge_messages.ProtoItem_TableDrivenScenario || !t.GetIsScenarioTableDriven() || t.GetIsSpecTableDriven() {
+			continue
+		}
+		scn := t.GetScenario()
+		if scn.GetExcludedTableRow() {
+			continue
+		}
+		r, ok := scenarios[scn.GetSpan().GetStart()]
+		if !ok {
+			r = &rows{}
+			scenarios[scn.GetSpan().GetStart()] = r
+		}
+		r.total++
+		switch scn.GetExecutionStatus() {
+		case gauge_messages.ExecutionStatus_FAILED:
+			r.failed++
+		case gauge_messages.ExecutionStatus_SKIPPED:
+			r.skipped++
+		}
+	}
+	for _, r := range scenarios {
+		count++
+		if r.failed > 0 {
+			failed++
+		} else if r.skipped == r.total {
+			skipped++
+		}
+	}
+	return
+}
+
 func (specResult *SpecResult) AddExecTime(execTime int64) {
 	specResult.ExecutionTime += execTime
 }
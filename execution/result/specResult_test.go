@@ -63,3 +63,33 @@ func (s *MySuite) TestAddTableRelatedScenarioResult(c *gc.C) {
 	c.Assert(specResult.ScenarioFailedCount, gc.Equals, 0)
 
 }
+
+func (s *MySuite) TestScenarioTableStatsCountsTheRowsOfAScenarioOnce(c *gc.C) {
+	row := func(start int64, status gauge_messages.ExecutionStatus, skipErrors ...string) *gauge_messages.ProtoItem {
+		return &gauge_messages.ProtoItem{ItemType: gauge_messages.ProtoItem_TableDrivenScenario, TableDrivenScenario: &gauge_messages.ProtoTableDrivenScenario{
+			IsScenarioTableDriven: true,
+			Scenario:              &gauge_messages.ProtoScenario{Span: &gauge_messages.Span{Start: start}, ExecutionStatus: status, SkipErrors: skipErrors},
+		}}
+	}
+	excludedRow := func(start int64) *gauge_messages.ProtoItem {
+		r := row(start, gauge_messages.ExecutionStatus_SKIPPED, "skipped Reason: Doesn't satisfy --table-rows flag condition")
+		r.TableDrivenScenario.Scenario.ExcludedTableRow = true
+		return r
+	}
+	items := []*gauge_messages.ProtoItem{
+		{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: &gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_FAILED}},
+		row(2, gauge_messages.ExecutionStatus_PASSED),
+		row(2, gauge_messages.ExecutionStatus_FAILED),
+		row(8, gauge_messages.ExecutionStatus_SKIPPED, "Step implementation not found"),
+		row(8, gauge_messages.ExecutionStatus_SKIPPED, "Step implementation not found"),
+		row(14, gauge_messages.ExecutionStatus_PASSED),
+		excludedRow(14),
+		excludedRow(20),
+	}
+
+	count, failed, skipped := ScenarioTableStats(items)
+
+	c.Assert(count, gc.Equals, 3)
+	c.Assert(failed, gc.Equals, 1)
+	c.Assert(skipped, gc.Equals, 1)
+}
@@ -44,6 +44,9 @@ type SuiteResult struct {
 	PostHookMessages    []string
 	PreHookScreenshots  [][]byte
 	PostHookScreenshots [][]byte
+	// SuiteSetup and SuiteTeardown are the results of the contexts and the teardown steps of the suite spec
+	SuiteSetup    *ScenarioResult
+	SuiteTeardown *ScenarioResult
 }
 
 // NewSuiteResult is a constructor for SuitResult
@@ -0,0 +1,58 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution
+
+import (
+	"path/filepath"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/result"
+	gm "github.com/getgauge/gauge/gauge_messages"
+)
+
+// Builders for the results used by the tests of the listeners and reports working on the results of a run.
+
+func scenarioOf(heading string, status gm.ExecutionStatus, executionTime int64, items ...*gm.ProtoItem) *gm.ProtoScenario {
+	return &gm.ProtoScenario{ScenarioHeading: heading, ExecutionStatus: status, ExecutionTime: executionTime, ScenarioItems: items, Failed: status == gm.ExecutionStatus_FAILED}
+}
+
+func stepOf(text string, executionTime int64) *gm.ProtoItem {
+	return &gm.ProtoItem{ItemType: gm.ProtoItem_Step, Step: &gm.ProtoStep{ParsedText: text, StepExecutionResult: &gm.ProtoStepExecutionResult{
+		ExecutionResult: &gm.ProtoExecutionResult{ExecutionTime: executionTime},
+	}}}
+}
+
+func scenarioResultOf(scenario *gm.ProtoScenario, retries int) *result.ScenarioResult {
+	return &result.ScenarioResult{ProtoScenario: scenario, Retries: retries}
+}
+
+// suiteResultOf returns the result of a suite with a single spec, specs/a.spec, made of the given scenarios.
+func suiteResultOf(scenarios ...*gm.ProtoScenario) *result.SuiteResult {
+	spec := &gm.ProtoSpec{FileName: filepath.Join(config.ProjectRoot, "specs", "a.spec")}
+	failed := false
+	for _, scn := range scenarios {
+		spec.Items = append(spec.Items, &gm.ProtoItem{ItemType: gm.ProtoItem_Scenario, Scenario: scn})
+		failed = failed || scn.GetExecutionStatus() == gm.ExecutionStatus_FAILED
+	}
+	specRes := &result.SpecResult{ProtoSpec: spec, IsFailed: failed, ScenarioCount: len(scenarios)}
+	return &result.SuiteResult{SpecResults: []*result.SpecResult{specRes}, IsFailed: failed}
+}
+
+func protoSuiteResultOf(scenarios ...*gm.ProtoScenario) *gm.ProtoSuiteResult {
+	return &gm.ProtoSuiteResult{SpecResults: []*gm.ProtoSpecResult{toProtoSpecResult(suiteResultOf(scenarios...).SpecResults[0])}}
+}
@@ -27,9 +27,11 @@ import (
 
 	"github.com/getgauge/common"
 	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/execution/event"
 	"github.com/getgauge/gauge/execution/result"
 	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/logger"
 	"github.com/golang/protobuf/proto"
 )
@@ -51,11 +53,41 @@ func ListenSuiteEndAndSaveResult(wg *sync.WaitGroup) {
 			if e.Topic == event.SuiteEnd {
 				writeResult(e.Result.(*result.SuiteResult))
 				wg.Done()
+				return
 			}
 		}
 	}()
 }
 
+// shouldSaveResult tells whether the result of the execution should be saved. The timed strategy needs the result of
+// the previous run, so it is saved for a timed parallel execution even when save_execution_result is false.
+func shouldSaveResult() bool {
+	if env.SaveExecutionResult() {
+		return true
+	}
+	if InParallel && isTimed() {
+		logger.Infof(true, "Saving the execution result in spite of save_execution_result=false, as the timed strategy distributes specs by the execution times of the last run.")
+		return true
+	}
+	return false
+}
+
+func readLastRunResult() (*gauge_messages.ProtoSuiteResult, error) {
+	return readSuiteResult(filepath.Join(config.ProjectRoot, dotGauge, lastRunResult))
+}
+
+func readSuiteResult(file string) (*gauge_messages.ProtoSuiteResult, error) {
+	contents, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	res := &gauge_messages.ProtoSuiteResult{}
+	if err = proto.Unmarshal(contents, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func writeResult(res *result.SuiteResult) {
 	dotGaugeDir := filepath.Join(config.ProjectRoot, dotGauge)
 	resultFile := filepath.Join(config.ProjectRoot, dotGauge, lastRunResult)
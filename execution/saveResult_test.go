@@ -23,7 +23,9 @@ import (
 
 	"github.com/getgauge/common"
 	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge_messages"
 )
 
 func TestIfResultFileIsCreated(t *testing.T) {
@@ -38,3 +40,33 @@ func TestIfResultFileIsCreated(t *testing.T) {
 	}
 	os.RemoveAll(filepath.Join(config.ProjectRoot, dotGauge))
 }
+
+func TestIfResultFileIsReadBack(t *testing.T) {
+	writeResult(&result.SuiteResult{ExecutionTime: 42, SpecResults: []*result.SpecResult{&result.SpecResult{ProtoSpec: &gauge_messages.ProtoSpec{FileName: "foo.spec"}, ExecutionTime: 21}}})
+	defer os.RemoveAll(filepath.Join(config.ProjectRoot, dotGauge))
+
+	res, err := readLastRunResult()
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err.Error())
+	}
+	if len(res.SpecResults) != 1 || res.SpecResults[0].ProtoSpec.FileName != "foo.spec" || res.SpecResults[0].ExecutionTime != 21 {
+		t.Errorf("Expected saved spec result to be read back, got %v", res.SpecResults)
+	}
+}
+
+func TestResultIsSavedForTimedStrategyEvenIfSaveExecutionResultIsOff(t *testing.T) {
+	oldSave, oldInParallel, oldStrategy := env.SaveExecutionResult, InParallel, Strategy
+	defer func() { env.SaveExecutionResult, InParallel, Strategy = oldSave, oldInParallel, oldStrategy }()
+	env.SaveExecutionResult = func() bool { return false }
+	InParallel = true
+
+	Strategy = Timed
+	if !shouldSaveResult() {
+		t.Errorf("Expected the result to be saved for the timed strategy")
+	}
+	Strategy = Eager
+	if shouldSaveResult() {
+		t.Errorf("Expected the result not to be saved for the eager strategy")
+	}
+}
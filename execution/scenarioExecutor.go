@@ -19,6 +19,7 @@ package execution
 
 import (
 	"fmt"
+	"time"
 
 	"errors"
 
@@ -41,6 +42,9 @@ type scenarioExecutor struct {
 	stream               int
 	contexts             []*gauge.Step
 	teardowns            []*gauge.Step
+	timeout              time.Duration
+	deadline             time.Time
+	stepRetries          int
 }
 
 func newScenarioExecutor(r runner.Runner, ph plugin.Handler, ei *gauge_messages.ExecutionInfo, errMap *gauge.BuildErrors, contexts []*gauge.Step, teardowns []*gauge.Step, stream int) *scenarioExecutor {
@@ -60,15 +64,13 @@ func (e *scenarioExecutor) execute(i gauge.Item, r result.Result) {
 	scenarioResult := r.(*result.ScenarioResult)
 	scenarioResult.ProtoScenario.ExecutionStatus = gauge_messages.ExecutionStatus_PASSED
 	scenarioResult.ProtoScenario.Skipped = false
-	if scenario.SpecDataTableRow.IsInitialized() && !shouldExecuteForRow(scenario.SpecDataTableRowIndex) {
-		e.errMap.ScenarioErrs[scenario] = append([]error{errors.New("skipped Reason: Doesn't satisfy --table-rows flag condition")}, e.errMap.ScenarioErrs[scenario]...)
-		setSkipInfoInResult(scenarioResult, scenario, e.errMap)
+	if excludedByTableRows(scenario) {
+		setSkipInfoInResult(scenarioResult, append([]error{errors.New("skipped Reason: Doesn't satisfy --table-rows flag condition")}, e.errMap.ScenarioErrs[scenario]...))
+		scenarioResult.ProtoScenario.ExcludedTableRow = true
 		return
 	}
-	if _, ok := e.errMap.ScenarioErrs[scenario]; ok {
-		setSkipInfoInResult(scenarioResult, scenario, e.errMap)
-		event.Notify(event.NewExecutionEvent(event.ScenarioStart, scenario, scenarioResult, e.stream, *e.currentExecutionInfo))
-		event.Notify(event.NewExecutionEvent(event.ScenarioEnd, scenario, scenarioResult, e.stream, *e.currentExecutionInfo))
+	if errs, skipped := e.skipErrors(scenario); skipped {
+		skipScenario(scenario, scenarioResult, errs, e.stream, e.currentExecutionInfo)
 		return
 	}
 	event.Notify(event.NewExecutionEvent(event.ScenarioStart, scenario, scenarioResult, e.stream, *e.currentExecutionInfo))
@@ -79,20 +81,61 @@ func (e *scenarioExecutor) execute(i gauge.Item, r result.Result) {
 		e.handleScenarioDataStoreFailure(scenarioResult, scenario, fmt.Errorf("Failed to initialize scenario datastore. Error: %s", res.GetErrorMessage()))
 		return
 	}
+	e.timeout = scenarioTimeout(e.currentExecutionInfo.GetCurrentSpec().GetTags(), getTagValue(scenario.Tags))
+	e.stepRetries = stepRetries(e.currentExecutionInfo.GetCurrentSpec().GetTags(), getTagValue(scenario.Tags))
+	e.startTimeout()
 	e.notifyBeforeScenarioHook(scenarioResult)
 
-	if !scenarioResult.GetFailed() {
+	stepsExecuted := !scenarioResult.GetFailed()
+	if stepsExecuted {
 		protoContexts := scenarioResult.ProtoScenario.GetContexts()
 		protoScenItems := scenarioResult.ProtoScenario.GetScenarioItems()
-		e.executeSteps(append(e.contexts, scenario.Steps...), append(protoContexts, protoScenItems...), scenarioResult)
-		// teardowns are not appended to previous call to executeSteps to ensure they are run irrespective of context/step failures
-		e.executeSteps(e.teardowns, scenarioResult.ProtoScenario.GetTearDownSteps(), scenarioResult)
+		e.executeSteps(append(e.contexts, scenario.Steps...), append(protoContexts, protoScenItems...), scenarioResult, true)
 	}
 
+	// the teardowns and the after scenario hook clean up after the scenario, they share a second timeout so that they
+	// are run even if the scenario timed out or the execution was cancelled
+	e.startTimeout()
+	if stepsExecuted {
+		// teardowns are not appended to previous call to executeSteps to ensure they are run irrespective of context/step failures
+		e.executeSteps(e.teardowns, scenarioResult.ProtoScenario.GetTearDownSteps(), scenarioResult, false)
+	}
 	e.notifyAfterScenarioHook(scenarioResult)
 	scenarioResult.UpdateExecutionTime()
 }
 
+// skipErrors gives the errors the scenario is skipped for, if it is. The reasons found at the time of execution are added to
+// its validation errors only in its result, the error map is shared by the streams executing in parallel.
+func (e *scenarioExecutor) skipErrors(scenario *gauge.Scenario) ([]error, bool) {
+	errs, skipped := e.errMap.ScenarioErrs[scenario]
+	var reasons []error
+	if isCancelled() {
+		reasons = append(reasons, errExecutionCancelled)
+	}
+	if restartedInSpec(e.runner) {
+		reasons = append(reasons, errRunnerRestarted)
+	}
+	if err := dependencies.unmetScenarioDependency(e.currentExecutionInfo.GetCurrentSpec().GetFileName(), scenario); err != nil {
+		reasons = append(reasons, err)
+	}
+	if len(reasons) == 0 {
+		return errs, skipped
+	}
+	return append(append([]error{}, errs...), reasons...), true
+}
+
+// hookRunner bounds the scenario hooks by the deadline of the scenario.
+func (e *scenarioExecutor) hookRunner() runner.Runner {
+	return &timedRunner{Runner: e.runner, timeout: e.timeout, deadline: e.deadline, info: e.currentExecutionInfo}
+}
+
+func (e *scenarioExecutor) startTimeout() {
+	e.deadline = time.Time{}
+	if e.timeout > 0 {
+		e.deadline = time.Now().Add(e.timeout)
+	}
+}
+
 func (e *scenarioExecutor) initScenarioDataStore() *gauge_messages.ProtoExecutionResult {
 	msg := &gauge_messages.Message{MessageType: gauge_messages.Message_ScenarioDataStoreInit,
 		ScenarioDataStoreInitRequest: &gauge_messages.ScenarioDataStoreInitRequest{}}
@@ -104,14 +147,21 @@ func (e *scenarioExecutor) handleScenarioDataStoreFailure(scenarioResult *result
 	validationError := validation.NewStepValidationError(&gauge.Step{LineNo: scenario.Heading.LineNo, LineText: scenario.Heading.Value},
 		err.Error(), e.currentExecutionInfo.CurrentSpec.GetFileName(), nil, "")
 	e.errMap.ScenarioErrs[scenario] = []error{validationError}
-	setSkipInfoInResult(scenarioResult, scenario, e.errMap)
+	setSkipInfoInResult(scenarioResult, e.errMap.ScenarioErrs[scenario])
+}
+
+// skipScenario marks the scenario skipped for the errors and notifies its start and end.
+func skipScenario(scenario *gauge.Scenario, scenarioResult *result.ScenarioResult, errs []error, stream int, ei *gauge_messages.ExecutionInfo) {
+	setSkipInfoInResult(scenarioResult, errs)
+	event.Notify(event.NewExecutionEvent(event.ScenarioStart, scenario, scenarioResult, stream, *ei))
+	event.Notify(event.NewExecutionEvent(event.ScenarioEnd, scenario, scenarioResult, stream, *ei))
 }
 
-func setSkipInfoInResult(result *result.ScenarioResult, scenario *gauge.Scenario, errMap *gauge.BuildErrors) {
+func setSkipInfoInResult(result *result.ScenarioResult, errs []error) {
 	result.ProtoScenario.ExecutionStatus = gauge_messages.ExecutionStatus_SKIPPED
 	result.ProtoScenario.Skipped = true
 	var errors []string
-	for _, err := range errMap.ScenarioErrs[scenario] {
+	for _, err := range errs {
 		errors = append(errors, err.Error())
 	}
 	result.ProtoScenario.SkipErrors = errors
@@ -121,7 +171,7 @@ func (e *scenarioExecutor) notifyBeforeScenarioHook(scenarioResult *result.Scena
 	message := &gauge_messages.Message{MessageType: gauge_messages.Message_ScenarioExecutionStarting,
 		ScenarioExecutionStartingRequest: &gauge_messages.ScenarioExecutionStartingRequest{CurrentExecutionInfo: e.currentExecutionInfo}}
 	e.pluginHandler.NotifyPlugins(message)
-	res := executeHook(message, scenarioResult, e.runner)
+	res := executeHook(message, scenarioResult, e.hookRunner())
 	scenarioResult.ProtoScenario.PreHookMessages = res.Message
 	scenarioResult.ProtoScenario.PreHookScreenshots = res.Screenshots
 	if res.GetFailed() {
@@ -133,7 +183,7 @@ func (e *scenarioExecutor) notifyBeforeScenarioHook(scenarioResult *result.Scena
 func (e *scenarioExecutor) notifyAfterScenarioHook(scenarioResult *result.ScenarioResult) {
 	message := &gauge_messages.Message{MessageType: gauge_messages.Message_ScenarioExecutionEnding,
 		ScenarioExecutionEndingRequest: &gauge_messages.ScenarioExecutionEndingRequest{CurrentExecutionInfo: e.currentExecutionInfo}}
-	res := executeHook(message, scenarioResult, e.runner)
+	res := executeHook(message, scenarioResult, e.hookRunner())
 	scenarioResult.ProtoScenario.PostHookMessages = res.Message
 	scenarioResult.ProtoScenario.PostHookScreenshots = res.Screenshots
 	if res.GetFailed() {
@@ -143,10 +193,16 @@ func (e *scenarioExecutor) notifyAfterScenarioHook(scenarioResult *result.Scenar
 	e.pluginHandler.NotifyPlugins(message)
 }
 
-func (e *scenarioExecutor) executeSteps(steps []*gauge.Step, protoItems []*gauge_messages.ProtoItem, scenarioResult *result.ScenarioResult) {
+// executeSteps executes the steps until one fails with an unrecoverable error. If stopOnCancel is true, the remaining
+// steps are not executed once the execution is cancelled and the scenario is marked skipped.
+func (e *scenarioExecutor) executeSteps(steps []*gauge.Step, protoItems []*gauge_messages.ProtoItem, scenarioResult *result.ScenarioResult, stopOnCancel bool) {
 	var stepsIndex int
 	for _, protoItem := range protoItems {
 		if protoItem.GetItemType() == gauge_messages.ProtoItem_Concept || protoItem.GetItemType() == gauge_messages.ProtoItem_Step {
+			if stopOnCancel && isCancelled() {
+				setCancelledInResult(scenarioResult)
+				return
+			}
 			failed, recoverable := e.executeStep(steps[stepsIndex], protoItem, scenarioResult)
 			stepsIndex++
 			if failed {
@@ -168,7 +224,7 @@ func (e *scenarioExecutor) executeStep(step *gauge.Step, protoItem *gauge_messag
 		recoverable = res.GetRecoverable()
 
 	} else if protoItem.GetItemType() == gauge_messages.ProtoItem_Step {
-		se := &stepExecutor{runner: e.runner, pluginHandler: e.pluginHandler, currentExecutionInfo: e.currentExecutionInfo, stream: e.stream}
+		se := &stepExecutor{runner: e.runner, pluginHandler: e.pluginHandler, currentExecutionInfo: e.currentExecutionInfo, stream: e.stream, timeout: e.timeout, deadline: e.deadline, retries: e.stepRetries}
 		res := se.executeStep(step, protoItem.GetStep())
 		protoItem.GetStep().StepExecutionResult = res.ProtoStepExecResult()
 		failed = res.GetFailed()
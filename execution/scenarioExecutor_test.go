@@ -157,3 +157,26 @@ func TestNotifyAfterScenarioShouldAddAfterScenarioHookScreenshots(t *testing.T)
 		}
 	}
 }
+
+func TestScenarioForRowNotSelectedByTableRowsIsExcluded(t *testing.T) {
+	defer func(old []int) { tableRowsIndexes = old }(tableRowsIndexes)
+	tableRowsIndexes = []int{0}
+	errMap := gauge.NewBuildErrors()
+	sce := newScenarioExecutor(&mockRunner{}, &mockPluginHandler{}, &gauge_messages.ExecutionInfo{}, errMap, nil, nil, 0)
+	scenario := &gauge.Scenario{
+		Heading:                   &gauge.Heading{Value: "A scenario"},
+		Span:                      &gauge.Span{Start: 2, End: 10},
+		ScenarioDataTableRow:      *gauge.NewTable([]string{"id"}, [][]gauge.TableCell{{{Value: "2", CellType: gauge.Static}}}, 3),
+		ScenarioDataTableRowIndex: 1,
+	}
+	scenarioResult := result.NewScenarioResult(gauge.NewProtoScenario(scenario))
+
+	sce.execute(scenario, scenarioResult)
+
+	if !scenarioResult.ProtoScenario.GetExcludedTableRow() || !scenarioResult.ProtoScenario.GetSkipped() {
+		t.Errorf("Expected scenario to be skipped as excluded by --table-rows, got %v", scenarioResult.ProtoScenario)
+	}
+	if len(errMap.ScenarioErrs) != 0 {
+		t.Errorf("Expected the error map shared by the streams to be unchanged, got %v", errMap.ScenarioErrs)
+	}
+}
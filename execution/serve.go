@@ -0,0 +1,235 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package execution
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+)
+
+const clientBufferSize = 256
+
+// ServePort is the local port on which the execution events and results are served over HTTP. 0 means they are not served.
+var ServePort int
+
+var server *executionServer
+
+// serverEvent is an execution event as sent to the clients of the events endpoint.
+type serverEvent struct {
+	Topic         string                       `json:"topic"`
+	Stream        int                          `json:"stream"`
+	ExecutionInfo gauge_messages.ExecutionInfo `json:"executionInfo"`
+	Failed        bool                         `json:"failed,omitempty"`
+	ExecutionTime int64                        `json:"executionTime,omitempty"`
+	Result        interface{}                  `json:"result,omitempty"`
+}
+
+// executionServer serves the execution events as they happen, the execution info of every stream and a snapshot of
+// the suite result built from the specs executed so far.
+type executionServer struct {
+	mu          sync.Mutex
+	startTime   time.Time
+	info        map[int]gauge_messages.ExecutionInfo
+	specResults []*result.SpecResult
+	suiteResult *result.SuiteResult
+	clients     map[chan []byte]bool
+}
+
+func newExecutionServer() *executionServer {
+	return &executionServer{startTime: time.Now(), info: make(map[int]gauge_messages.ExecutionInfo), clients: make(map[chan []byte]bool)}
+}
+
+// ListenExecutionEventsAndServe serves the execution events on ServePort. The server is started on the first execution
+// and kept across executions, i.e. when watching the specs.
+func ListenExecutionEventsAndServe(wg *sync.WaitGroup) {
+	if server == nil {
+		s := newExecutionServer()
+		if err := s.start(ServePort); err != nil {
+			logger.Errorf(true, "Failed to serve execution events on port %d. %s", ServePort, err.Error())
+			return
+		}
+		logger.Infof(true, "Serving execution events on http://localhost:%d/events", ServePort)
+		server = s
+	}
+	ch := make(chan event.ExecutionEvent, 0)
+	event.Register(ch, event.SuiteStart, event.SpecStart, event.SpecEnd, event.ScenarioStart, event.ScenarioEnd, event.StepStart, event.StepEnd, event.ConceptStart, event.ConceptEnd, event.SuiteEnd)
+	wg.Add(1)
+
+	go func() {
+		for {
+			e := <-ch
+			server.update(e)
+			if e.Topic == event.SuiteEnd {
+				wg.Done()
+				return
+			}
+		}
+	}()
+}
+
+func (s *executionServer) start(port int) error {
+	l, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", port))
+	if err != nil {
+		return err
+	}
+	go http.Serve(l, s.handler())
+	return nil
+}
+
+func (s *executionServer) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/events", s.serveEvents)
+	mux.HandleFunc("/info", s.serveInfo)
+	mux.HandleFunc("/result", s.serveResult)
+	return mux
+}
+
+// update records the state of the execution and sends the event to every client. A client which does not keep up
+// misses events rather than holding up the execution.
+func (s *executionServer) update(e event.ExecutionEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch e.Topic {
+	case event.SuiteStart:
+		s.startTime = time.Now()
+		s.info = make(map[int]gauge_messages.ExecutionInfo)
+		s.specResults = nil
+		s.suiteResult = nil
+	case event.SpecEnd:
+		s.specResults = append(s.specResults, e.Result.(*result.SpecResult))
+	case event.SuiteEnd:
+		s.suiteResult = e.Result.(*result.SuiteResult)
+	}
+	s.info[e.Stream] = e.ExecutionInfo
+	b, err := json.Marshal(newServerEvent(e))
+	if err != nil {
+		logger.Debugf(true, "Failed to marshal %s event. %s", e.Topic, err.Error())
+		return
+	}
+	for c := range s.clients {
+		select {
+		case c <- b:
+		default:
+			logger.Debugf(true, "Dropping %s event for a slow client.", e.Topic)
+		}
+	}
+}
+
+func newServerEvent(e event.ExecutionEvent) serverEvent {
+	se := serverEvent{Topic: e.Topic.String(), Stream: e.Stream, ExecutionInfo: e.ExecutionInfo}
+	if e.Result == nil || !isEndTopic(e.Topic) {
+		return se
+	}
+	se.Failed = e.Result.GetFailed()
+	se.ExecutionTime = e.Result.ExecTime()
+	se.Result = e.Result.Item()
+	if res, ok := e.Result.(*result.SuiteResult); ok {
+		se.Result = gauge.ConvertToProtoSuiteResult(res)
+	}
+	return se
+}
+
+// serveEvents streams the execution events to the client as server-sent events, until the client goes away.
+func (s *executionServer) serveEvents(w http.ResponseWriter, r *http.Request) {
+	f, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming is not supported.", http.StatusInternalServerError)
+		return
+	}
+	c := make(chan []byte, clientBufferSize)
+	s.mu.Lock()
+	s.clients[c] = true
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.clients, c)
+		s.mu.Unlock()
+	}()
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	f.Flush()
+	for {
+		select {
+		case b := <-c:
+			fmt.Fprintf(w, "data: %s\n\n", b)
+			f.Flush()
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// serveInfo writes the current execution info of every stream.
+func (s *executionServer) serveInfo(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	streams := make([]int, 0, len(s.info))
+	for stream := range s.info {
+		streams = append(streams, stream)
+	}
+	sort.Ints(streams)
+	info := make([]gauge_messages.ExecutionInfo, 0, len(streams))
+	for _, stream := range streams {
+		info = append(info, s.info[stream])
+	}
+	s.mu.Unlock()
+	writeJSON(w, info)
+}
+
+// serveResult writes the suite result, which only has the specs executed so far while the execution is in progress.
+func (s *executionServer) serveResult(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	res := s.suiteResult
+	if res == nil {
+		res = result.NewSuiteResult(ExecuteTags, s.startTime)
+		res.AddSpecResults(s.specResults)
+		res.SetSpecsSkippedCount()
+	}
+	protoRes := gauge.ConvertToProtoSuiteResult(res)
+	s.mu.Unlock()
+	writeJSON(w, protoRes)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(b)
+}
+
+func isEndTopic(t event.Topic) bool {
+	switch t {
+	case event.StepEnd, event.ConceptEnd, event.ScenarioEnd, event.SpecEnd, event.SuiteEnd:
+		return true
+	}
+	return false
+}
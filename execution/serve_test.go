@@ -0,0 +1,126 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package execution
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge_messages"
+)
+
+func TestServeResultGivesSpecsExecutedSoFar(t *testing.T) {
+	s := newExecutionServer()
+	ts := httptest.NewServer(s.handler())
+	defer ts.Close()
+	specRes := &result.SpecResult{ProtoSpec: &gauge_messages.ProtoSpec{SpecHeading: "Spec 1"}, IsFailed: true, ExecutionTime: 10}
+
+	s.update(event.NewExecutionEvent(event.SuiteStart, nil, nil, 0, gauge_messages.ExecutionInfo{}))
+	s.update(event.NewExecutionEvent(event.SpecEnd, nil, specRes, 0, gauge_messages.ExecutionInfo{}))
+
+	got := &gauge_messages.ProtoSuiteResult{}
+	getJSON(t, ts.URL+"/result", got)
+	if len(got.GetSpecResults()) != 1 || got.GetSpecResults()[0].GetProtoSpec().GetSpecHeading() != "Spec 1" {
+		t.Errorf("Expected result of Spec 1, got %v", got.GetSpecResults())
+	}
+	if !got.GetFailed() || got.GetSpecsFailedCount() != 1 {
+		t.Errorf("Expected a failed suite result with 1 failed spec, got %v", got)
+	}
+}
+
+func TestServeInfoGivesExecutionInfoOfEveryStream(t *testing.T) {
+	s := newExecutionServer()
+	ts := httptest.NewServer(s.handler())
+	defer ts.Close()
+	scenario := &gauge_messages.ScenarioInfo{Name: "Scenario 1"}
+
+	s.update(event.NewExecutionEvent(event.ScenarioStart, nil, nil, 2, gauge_messages.ExecutionInfo{CurrentScenario: scenario}))
+	s.update(event.NewExecutionEvent(event.SpecStart, nil, nil, 1, gauge_messages.ExecutionInfo{}))
+
+	var got []gauge_messages.ExecutionInfo
+	getJSON(t, ts.URL+"/info", &got)
+	if len(got) != 2 || got[1].GetCurrentScenario().GetName() != "Scenario 1" {
+		t.Errorf("Expected execution info of 2 streams ordered by stream, got %v", got)
+	}
+}
+
+func TestServeEventsStreamsExecutionEvents(t *testing.T) {
+	s := newExecutionServer()
+	ts := httptest.NewServer(s.handler())
+	defer ts.Close()
+	resp, err := http.Get(ts.URL + "/events")
+	if err != nil {
+		t.Fatalf("Failed to connect to events. %s", err.Error())
+	}
+	defer resp.Body.Close()
+	if resp.Header.Get("Content-Type") != "text/event-stream" {
+		t.Errorf("Expected server-sent events, got %s", resp.Header.Get("Content-Type"))
+	}
+	waitForClients(s, 1)
+
+	stepRes := result.NewStepResult(&gauge_messages.ProtoStep{ActualText: "Step 1", StepExecutionResult: &gauge_messages.ProtoStepExecutionResult{
+		ExecutionResult: &gauge_messages.ProtoExecutionResult{Failed: true},
+	}})
+	s.update(event.NewExecutionEvent(event.StepEnd, nil, stepRes, 1, gauge_messages.ExecutionInfo{}))
+
+	line, err := bufio.NewReader(resp.Body).ReadString('\n')
+	if err != nil {
+		t.Fatalf("Failed to read event. %s", err.Error())
+	}
+	got := struct {
+		Topic  string                    `json:"topic"`
+		Stream int                       `json:"stream"`
+		Failed bool                      `json:"failed"`
+		Result *gauge_messages.ProtoStep `json:"result"`
+	}{}
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &got); err != nil {
+		t.Fatalf("Invalid event %s. %s", line, err.Error())
+	}
+	if got.Topic != "StepEnd" || got.Stream != 1 || !got.Failed || got.Result.GetActualText() != "Step 1" {
+		t.Errorf("Unexpected event %s", line)
+	}
+}
+
+func getJSON(t *testing.T, url string, v interface{}) {
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("Failed to get %s. %s", url, err.Error())
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		t.Fatalf("Invalid response from %s. %s", url, err.Error())
+	}
+}
+
+func waitForClients(s *executionServer, n int) {
+	for i := 0; i < 100; i++ {
+		s.mu.Lock()
+		count := len(s.clients)
+		s.mu.Unlock()
+		if count == n {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
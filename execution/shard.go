@@ -0,0 +1,221 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package execution
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/rerun"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/filter"
+	"github.com/getgauge/gauge/gauge"
+	m "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/plugin"
+	"github.com/getgauge/gauge/reporter"
+	"github.com/getgauge/gauge/util"
+)
+
+const (
+	// ShardByHash assigns scenarios to shards by hashing their spec file and heading.
+	ShardByHash = "hash"
+	// ShardByTime assigns scenarios to shards by the execution times recorded in the last run result.
+	ShardByTime = "time"
+)
+
+// ShardBy is the partitioning used for --shard, one of ShardByHash and ShardByTime.
+var ShardBy = ShardByHash
+
+func isValidShardBy(shardBy string) bool {
+	return shardBy == ShardByHash || shardBy == ShardByTime
+}
+
+// scenarioExecutionTimesFromLastRun gives the execution times of the scenarios in the last run result keyed by filter.ScenarioKey.
+// Times of data table rows of a scenario are added up.
+func scenarioExecutionTimesFromLastRun() map[string]int64 {
+	execTimes := make(map[string]int64)
+	res, err := readLastRunResult()
+	if err != nil {
+		logger.Warningf(true, "Unable to read execution times of previous run, scenarios will be sharded by hash. %s", err.Error())
+		return execTimes
+	}
+	for _, specResult := range res.GetSpecResults() {
+		for _, item := range specResult.GetProtoSpec().GetItems() {
+			s := item.GetScenario()
+			if item.GetItemType() == m.ProtoItem_TableDrivenScenario {
+				s = item.GetTableDrivenScenario().GetScenario()
+			}
+			if s == nil || s.GetExecutionStatus() == m.ExecutionStatus_SKIPPED {
+				continue
+			}
+			execTimes[filter.ScenarioKey(specResult.GetProtoSpec().GetFileName(), s.GetScenarioHeading())] += s.GetExecutionTime()
+		}
+	}
+	return execTimes
+}
+
+// MergeResults combines the execution results saved by the runs of every shard into one suite result and ends the suite with it.
+func MergeResults(files []string) int {
+	var results []*m.ProtoSuiteResult
+	for _, file := range files {
+		res, err := readSuiteResult(file)
+		if err != nil {
+			logger.Errorf(true, "Failed to read execution result from %s. %s", file, err.Error())
+			return ExecutionFailed
+		}
+		results = append(results, res)
+	}
+	return finishMergedRun(mergeSuiteResults(results), true)
+}
+
+// finishMergedRun ends a suite whose result is merged from the results of other runs instead of being executed. The result is saved
+// as the last run result and reported, its failed scenarios are saved for a rerun with --failed and the reporting plugins are notified of it.
+func finishMergedRun(merged *result.SuiteResult, isParsingOk bool) int {
+	event.InitRegistry()
+	wg := &sync.WaitGroup{}
+	ListenSuiteEndAndSaveResult(wg)
+	if env.SaveExecutionHistory() {
+		ListenSuiteEndAndSaveHistory(wg)
+	}
+	if reporter.ReportFormat != "" {
+		reporter.ListenSuiteEndAndWriteReport(wg)
+	}
+	event.Notify(event.NewExecutionEvent(event.SuiteEnd, nil, merged, 0, m.ExecutionInfo{}))
+	wg.Wait()
+	rerun.WriteFailedItems(gauge.ConvertToProtoSuiteResult(merged), util.GetSpecDirs())
+	notifyReportingPlugins(merged)
+	return printExecutionResult(merged, isParsingOk)
+}
+
+func notifyReportingPlugins(res *result.SuiteResult) {
+	mf, err := manifest.ProjectManifest()
+	if err != nil {
+		logger.Warningf(true, "Unable to read project manifest, reporting plugins are not notified. %s", err.Error())
+		return
+	}
+	handler := plugin.StartPlugins(mf)
+	handler.NotifyPlugins(&m.Message{
+		MessageType: m.Message_SuiteExecutionResult,
+		SuiteExecutionResult: &m.SuiteExecutionResult{
+			SuiteResult: gauge.ConvertToProtoSuiteResult(res),
+		},
+	})
+	handler.GracefullyKillPlugins()
+}
+
+// mergeSuiteResults combines the results of shards, the results of a spec split across shards are merged into one. Specs
+// are matched by their path relative to the project root, the spec files in the merged result are the files of this project.
+func mergeSuiteResults(results []*m.ProtoSuiteResult) *result.SuiteResult {
+	suiteRes := result.NewSuiteResult("", time.Now())
+	var fileNames []string
+	specResults := make(map[string][]*result.SpecResult)
+	for i, res := range results {
+		if i == 0 || res.GetTimestamp() < suiteRes.Timestamp {
+			suiteRes.Timestamp = res.GetTimestamp()
+		}
+		if i == 0 {
+			suiteRes.Environment = res.GetEnvironment()
+			suiteRes.Tags = res.GetTags()
+			suiteRes.ProjectName = res.GetProjectName()
+		}
+		if res.GetFailed() {
+			suiteRes.IsFailed = true
+		}
+		if res.GetExecutionTime() > suiteRes.ExecutionTime {
+			suiteRes.ExecutionTime = res.GetExecutionTime()
+		}
+		if suiteRes.PreSuite == nil {
+			suiteRes.PreSuite = res.GetPreHookFailure()
+		}
+		if suiteRes.PostSuite == nil {
+			suiteRes.PostSuite = res.GetPostHookFailure()
+		}
+		suiteRes.PreHookMessages = append(suiteRes.PreHookMessages, res.GetPreHookMessages()...)
+		suiteRes.PostHookMessages = append(suiteRes.PostHookMessages, res.GetPostHookMessages()...)
+		suiteRes.PreHookScreenshots = append(suiteRes.PreHookScreenshots, res.GetPreHookScreenshots()...)
+		suiteRes.PostHookScreenshots = append(suiteRes.PostHookScreenshots, res.GetPostHookScreenshots()...)
+		for _, specRes := range res.GetSpecResults() {
+			specRes.ProtoSpec.FileName = localSpecFile(specRes.GetProtoSpec().GetFileName())
+			fileName := filepath.ToSlash(util.RelPathToProjectRoot(specRes.GetProtoSpec().GetFileName()))
+			if _, ok := specResults[fileName]; !ok {
+				fileNames = append(fileNames, fileName)
+			}
+			specResults[fileName] = append(specResults[fileName], convertFromProtoSpecResult(specRes))
+		}
+	}
+	for _, fileName := range fileNames {
+		res := specResults[fileName]
+		// shards run in parallel
+		mergedRes := mergeSpecResults(res, true)
+		if mergedRes.GetFailed() {
+			suiteRes.SpecsFailedCount++
+		}
+		suiteRes.SpecResults = append(suiteRes.SpecResults, mergedRes)
+	}
+	suiteRes.SetSpecsSkippedCount()
+	return suiteRes
+}
+
+// localSpecFile gives the path in this project of a spec file executed by a shard. Shards can run in workspaces at other
+// paths, so it is the longest trailing part of the path which is a file in the project root. The path is unchanged if
+// there is no such file.
+func localSpecFile(fileName string) string {
+	parts := strings.Split(strings.Replace(fileName, `\`, "/", -1), "/")
+	for i := range parts {
+		path := filepath.Join(config.ProjectRoot, filepath.Join(parts[i:]...))
+		if common.FileExists(path) {
+			return path
+		}
+	}
+	return fileName
+}
+
+func convertFromProtoSpecResult(res *m.ProtoSpecResult) *result.SpecResult {
+	return &result.SpecResult{
+		ProtoSpec:            res.GetProtoSpec(),
+		ScenarioCount:        int(res.GetScenarioCount()),
+		ScenarioFailedCount:  int(res.GetScenarioFailedCount()),
+		IsFailed:             res.GetFailed(),
+		FailedDataTableRows:  res.GetFailedDataTableRows(),
+		ExecutionTime:        res.GetExecutionTime(),
+		Skipped:              res.GetSkipped(),
+		ScenarioSkippedCount: int(res.GetScenarioSkippedCount()),
+		Errors:               res.GetErrors(),
+	}
+}
+
+func validateShard() error {
+	if filter.Shard == "" {
+		return nil
+	}
+	if _, _, err := filter.ParseShard(filter.Shard); err != nil {
+		return fmt.Errorf("invalid input(%s) to --shard flag. %s", filter.Shard, err.Error())
+	}
+	if !isValidShardBy(ShardBy) {
+		return fmt.Errorf("invalid input(%s) to --shard-by flag", ShardBy)
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package execution
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge/config"
+	gm "github.com/getgauge/gauge/gauge_messages"
+)
+
+func scenarioItem(heading string, line int64, status gm.ExecutionStatus) *gm.ProtoItem {
+	return &gm.ProtoItem{ItemType: gm.ProtoItem_Scenario, Scenario: &gm.ProtoScenario{ExecutionStatus: status, ScenarioHeading: heading, Span: &gm.Span{Start: line}}}
+}
+
+func tableItem(rows ...string) *gm.ProtoItem {
+	table := &gm.ProtoTable{Headers: &gm.ProtoTableRow{Cells: []string{"id"}}}
+	for _, r := range rows {
+		table.Rows = append(table.Rows, &gm.ProtoTableRow{Cells: []string{r}})
+	}
+	return &gm.ProtoItem{ItemType: gm.ProtoItem_Table, Table: table}
+}
+
+func tableDrivenScenarioItem(status gm.ExecutionStatus) *gm.ProtoItem {
+	return &gm.ProtoItem{ItemType: gm.ProtoItem_TableDrivenScenario, TableDrivenScenario: &gm.ProtoTableDrivenScenario{
+		Scenario: &gm.ProtoScenario{ExecutionStatus: status, ScenarioHeading: "scenario", Span: &gm.Span{Start: 6}},
+	}}
+}
+
+func TestMergeSuiteResultsOfShardsWithDataTableSpecSplitAcrossShards(t *testing.T) {
+	shard := func(row string, status gm.ExecutionStatus, executionTime int64) *gm.ProtoSuiteResult {
+		spec := &gm.ProtoSpecResult{
+			ProtoSpec: &gm.ProtoSpec{FileName: "spec", SpecHeading: "spec", IsTableDriven: true,
+				Items: []*gm.ProtoItem{tableItem(row), tableDrivenScenarioItem(status)}},
+			ScenarioCount: 1, ExecutionTime: executionTime,
+		}
+		if status == gm.ExecutionStatus_FAILED {
+			spec.ScenarioFailedCount, spec.Failed = 1, true
+		}
+		return &gm.ProtoSuiteResult{SpecResults: []*gm.ProtoSpecResult{spec}}
+	}
+
+	got := mergeSuiteResults([]*gm.ProtoSuiteResult{shard("1", gm.ExecutionStatus_PASSED, 10), shard("2", gm.ExecutionStatus_FAILED, 20)})
+
+	if len(got.SpecResults) != 1 {
+		t.Fatalf("Expected rows of a data table spec across shards to be merged, got %d spec results", len(got.SpecResults))
+	}
+	spec := got.SpecResults[0]
+	items := spec.ProtoSpec.Items
+	if len(items) != 3 || !reflect.DeepEqual(items[0], tableItem("1", "2")) {
+		t.Fatalf("Expected the data table with the rows of both shards followed by a scenario per row, got %v", items)
+	}
+	if items[1].TableDrivenScenario.GetTableRowIndex() != 0 || items[2].TableDrivenScenario.GetTableRowIndex() != 1 {
+		t.Errorf("Expected the scenarios to refer to the rows of the merged data table, got %v", items)
+	}
+	if spec.ScenarioCount != 2 || spec.ScenarioFailedCount != 1 || !spec.IsFailed || spec.ExecutionTime != 20 {
+		t.Errorf("Unexpected merged spec result stats %+v", spec)
+	}
+	if got.SpecsFailedCount != 1 {
+		t.Errorf("Expected 1 failed spec, got %d", got.SpecsFailedCount)
+	}
+}
+
+func TestMergeSuiteResultsOfShards(t *testing.T) {
+	shard1 := &gm.ProtoSuiteResult{
+		Timestamp: "Oct 18, 2026 at 10:05am", ExecutionTime: 20, Failed: true, ProjectName: "project",
+		SpecResults: []*gm.ProtoSpecResult{
+			{
+				ProtoSpec: &gm.ProtoSpec{FileName: "spec1", SpecHeading: "spec 1", Items: []*gm.ProtoItem{
+					scenarioItem("scenario 1", 3, gm.ExecutionStatus_PASSED),
+					scenarioItem("scenario 3", 9, gm.ExecutionStatus_FAILED),
+				}},
+				ScenarioCount: 2, ScenarioFailedCount: 1, Failed: true, ExecutionTime: 15,
+			},
+		},
+	}
+	shard2 := &gm.ProtoSuiteResult{
+		Timestamp: "Oct 18, 2026 at 10:04am", ExecutionTime: 30, ProjectName: "project",
+		PostHookFailure: &gm.ProtoHookFailure{ErrorMessage: "after suite"},
+		SpecResults: []*gm.ProtoSpecResult{
+			{
+				ProtoSpec: &gm.ProtoSpec{FileName: "spec1", SpecHeading: "spec 1", Items: []*gm.ProtoItem{
+					scenarioItem("scenario 2", 6, gm.ExecutionStatus_PASSED),
+				}},
+				ScenarioCount: 1, ExecutionTime: 5,
+			},
+			{
+				ProtoSpec: &gm.ProtoSpec{FileName: "spec2", SpecHeading: "spec 2", Items: []*gm.ProtoItem{
+					scenarioItem("scenario", 3, gm.ExecutionStatus_SKIPPED),
+				}},
+				ScenarioCount: 1, ScenarioSkippedCount: 1, Skipped: true,
+			},
+		},
+	}
+
+	got := mergeSuiteResults([]*gm.ProtoSuiteResult{shard1, shard2})
+
+	if !got.IsFailed || got.SpecsFailedCount != 1 || got.SpecsSkippedCount != 1 {
+		t.Errorf("Expected failed suite with 1 failed and 1 skipped spec, got failed: %v, failed specs: %d, skipped specs: %d", got.IsFailed, got.SpecsFailedCount, got.SpecsSkippedCount)
+	}
+	if got.ExecutionTime != 30 || got.Timestamp != "Oct 18, 2026 at 10:04am" || got.ProjectName != "project" {
+		t.Errorf("Expected execution time and timestamp of the longest and earliest shard, got %d and %s", got.ExecutionTime, got.Timestamp)
+	}
+	if got.PostSuite.GetErrorMessage() != "after suite" {
+		t.Errorf("Expected after suite hook failure of shard, got %v", got.PostSuite)
+	}
+	if len(got.SpecResults) != 2 {
+		t.Fatalf("Expected results of a spec across shards to be merged, got %d spec results", len(got.SpecResults))
+	}
+	spec1 := got.SpecResults[0]
+	want := []*gm.ProtoItem{
+		scenarioItem("scenario 1", 3, gm.ExecutionStatus_PASSED),
+		scenarioItem("scenario 2", 6, gm.ExecutionStatus_PASSED),
+		scenarioItem("scenario 3", 9, gm.ExecutionStatus_FAILED),
+	}
+	if !reflect.DeepEqual(spec1.ProtoSpec.Items, want) {
+		t.Errorf("Expected scenarios in order of the spec file.\n\tWant: %v\n\tGot: %v", want, spec1.ProtoSpec.Items)
+	}
+	if spec1.ScenarioCount != 3 || spec1.ScenarioFailedCount != 1 || !spec1.IsFailed || spec1.ExecutionTime != 15 {
+		t.Errorf("Unexpected merged spec result stats %+v", spec1)
+	}
+}
+
+func TestMergeSuiteResultsOfShardsInWorkspacesAtOtherPaths(t *testing.T) {
+	dir, err := ioutil.TempDir("", "shard")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	old := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = old }()
+	specFile := filepath.Join(dir, "specs", "spec1.spec")
+	os.MkdirAll(filepath.Dir(specFile), 0755)
+	ioutil.WriteFile(specFile, []byte("# spec 1"), 0644)
+	shard := func(fileName string, item *gm.ProtoItem) *gm.ProtoSuiteResult {
+		return &gm.ProtoSuiteResult{SpecResults: []*gm.ProtoSpecResult{
+			{ProtoSpec: &gm.ProtoSpec{FileName: fileName, SpecHeading: "spec 1", Items: []*gm.ProtoItem{item}}, ScenarioCount: 1},
+		}}
+	}
+
+	got := mergeSuiteResults([]*gm.ProtoSuiteResult{
+		shard("/ci/agent1/project/specs/spec1.spec", scenarioItem("scenario 1", 3, gm.ExecutionStatus_PASSED)),
+		shard(`C:\ci\agent2\project\specs\spec1.spec`, scenarioItem("scenario 2", 6, gm.ExecutionStatus_FAILED)),
+	})
+
+	if len(got.SpecResults) != 1 {
+		t.Fatalf("Expected results of a spec in shards at other paths to be merged, got %d spec results", len(got.SpecResults))
+	}
+	if got.SpecResults[0].ProtoSpec.FileName != specFile {
+		t.Errorf("Expected the spec file of the project %s, got %s", specFile, got.SpecResults[0].ProtoSpec.FileName)
+	}
+}
@@ -50,6 +50,10 @@ type simpleExecution struct {
 	errMaps              *gauge.BuildErrors
 	startTime            time.Time
 	stream               int
+	keepRunnerAlive      bool
+	resumed              []*result.SpecResult
+	suiteSpec            *gauge.Specification
+	specHooks            *specHooks
 }
 
 func newSimpleExecution(executionInfo *executionInfo, combineDataTableSpecs bool) *simpleExecution {
@@ -57,12 +61,16 @@ func newSimpleExecution(executionInfo *executionInfo, combineDataTableSpecs bool
 		executionInfo.specs = gauge.NewSpecCollection(executionInfo.specs.Specs(), true)
 	}
 	return &simpleExecution{
-		manifest:       executionInfo.manifest,
-		specCollection: executionInfo.specs,
-		runner:         executionInfo.runner,
-		pluginHandler:  executionInfo.pluginHandler,
-		errMaps:        executionInfo.errMaps,
-		stream:         executionInfo.stream,
+		manifest:        executionInfo.manifest,
+		specCollection:  executionInfo.specs,
+		runner:          newRecoverableRunner(executionInfo.runner, executionInfo.stream),
+		pluginHandler:   executionInfo.pluginHandler,
+		errMaps:         executionInfo.errMaps,
+		stream:          executionInfo.stream,
+		keepRunnerAlive: executionInfo.keepRunnerAlive,
+		resumed:         executionInfo.resumed,
+		suiteSpec:       executionInfo.suiteSpec,
+		specHooks:       executionInfo.specHooks,
 	}
 }
 
@@ -80,23 +88,40 @@ func (e *simpleExecution) execute() {
 		e.suiteResult.SetSpecsSkippedCount()
 	}
 
-	initSuiteDataStoreResult := e.initSuiteDataStore()
-	if initSuiteDataStoreResult.GetFailed() {
-		e.suiteResult.AddUnhandledError(fmt.Errorf("Failed to initialize suite datastore. Error: %s", initSuiteDataStoreResult.GetErrorMessage()))
+	if !e.beforeSuite() {
 		setResultMeta()
 		return
 	}
-
-	e.notifyBeforeSuite()
 	if !e.suiteResult.GetFailed() {
 		results := e.executeSpecs(e.specCollection)
 		e.suiteResult.AddSpecResults(results)
 	}
-	e.notifyAfterSuite()
+	e.afterSuite()
 
 	setResultMeta()
 }
 
+// beforeSuite initializes the suite datastore, executes the before suite hook and the contexts of the suite spec.
+// It returns false if the suite datastore could not be initialized.
+func (e *simpleExecution) beforeSuite() bool {
+	initSuiteDataStoreResult := e.initSuiteDataStore()
+	if initSuiteDataStoreResult.GetFailed() {
+		e.suiteResult.AddUnhandledError(fmt.Errorf("Failed to initialize suite datastore. Error: %s", initSuiteDataStoreResult.GetErrorMessage()))
+		return false
+	}
+	e.notifyBeforeSuite()
+	if !e.suiteResult.GetFailed() {
+		e.setUpSuite()
+	}
+	return true
+}
+
+// afterSuite executes the teardown steps of the suite spec and the after suite hook.
+func (e *simpleExecution) afterSuite() {
+	e.tearDownSuite()
+	e.notifyAfterSuite()
+}
+
 func (e *simpleExecution) start() {
 	e.startTime = time.Now()
 	event.Notify(event.NewExecutionEvent(event.SuiteStart, nil, nil, 0, gauge_messages.ExecutionInfo{}))
@@ -104,7 +129,8 @@ func (e *simpleExecution) start() {
 }
 
 func (e *simpleExecution) finish() {
-	e.suiteResult = mergeDataTableSpecResults(e.suiteResult)
+	e.suiteResult.AddSpecResults(e.resumed)
+	e.suiteResult = mergeDataTableSpecResults(e.suiteResult, false)
 	event.Notify(event.NewExecutionEvent(event.SuiteEnd, nil, e.suiteResult, 0, gauge_messages.ExecutionInfo{}))
 	e.notifyExecutionResult()
 	e.stopAllPlugins()
@@ -112,6 +138,9 @@ func (e *simpleExecution) finish() {
 
 func (e *simpleExecution) stopAllPlugins() {
 	e.notifyExecutionStop()
+	if e.keepRunnerAlive {
+		return
+	}
 	if err := e.runner.Kill(); err != nil {
 		logger.Errorf(true, "Failed to kill Runner: %s", err.Error())
 	}
@@ -119,30 +148,47 @@ func (e *simpleExecution) stopAllPlugins() {
 
 func (e *simpleExecution) executeSpecs(sc *gauge.SpecCollection) (results []*result.SpecResult) {
 	for sc.HasNext() {
-		specs := sc.Next()
-		var preHookFailures, postHookFailures []*gauge_messages.ProtoHookFailure
-		var specResults []*result.SpecResult
-		var before, after = true, false
-		for i, spec := range specs {
-			if i == len(specs)-1 {
-				after = true
-			}
-			res := newSpecExecutor(spec, e.runner, e.pluginHandler, e.errMaps, e.stream).execute(before, preHookFailures == nil, after)
-			before = false
-			specResults = append(specResults, res)
-			preHookFailures = append(preHookFailures, res.GetPreHook()...)
-			postHookFailures = append(postHookFailures, res.GetPostHook()...)
-			res.ProtoSpec.PreHookFailures, res.ProtoSpec.PostHookFailures = []*gauge_messages.ProtoHookFailure{}, []*gauge_messages.ProtoHookFailure{}
+		for _, specs := range specsByFileName(sc.Next()) {
+			results = append(results, e.executeSpecsOfFile(specs)...)
 		}
-		for _, res := range specResults {
-			for _, preHook := range preHookFailures {
-				res.AddPreHook(&gauge_messages.ProtoHookFailure{StackTrace: preHook.StackTrace, ErrorMessage: preHook.ErrorMessage, ScreenShot: preHook.ScreenShot, TableRowIndex: preHook.TableRowIndex})
-			}
-			for _, postHook := range postHookFailures {
-				res.AddPostHook(&gauge_messages.ProtoHookFailure{StackTrace: postHook.StackTrace, ErrorMessage: postHook.ErrorMessage, ScreenShot: postHook.ScreenShot, TableRowIndex: postHook.TableRowIndex})
-			}
-			results = append(results, res)
+	}
+	return results
+}
+
+// executeSpecsOfFile executes the specs created from the same file, i.e. for its data table rows, such that the hooks
+// of the spec are executed once for all of them. If the specs created from a file are spread across streams, the
+// hooks are run once for all the streams by the shared spec hooks instead.
+func (e *simpleExecution) executeSpecsOfFile(specs []*gauge.Specification) (results []*result.SpecResult) {
+	if e.specHooks != nil {
+		for _, spec := range specs {
+			se := newSpecExecutor(spec, e.runner, e.pluginHandler, e.errMaps, e.stream)
+			se.specHooks = e.specHooks
+			results = append(results, se.execute(true, true, true))
+		}
+		return results
+	}
+	var preHookFailures, postHookFailures []*gauge_messages.ProtoHookFailure
+	var specResults []*result.SpecResult
+	var before, after = true, false
+	for i, spec := range specs {
+		if i == len(specs)-1 {
+			after = true
 		}
+		res := newSpecExecutor(spec, e.runner, e.pluginHandler, e.errMaps, e.stream).execute(before, preHookFailures == nil, after)
+		before = false
+		specResults = append(specResults, res)
+		preHookFailures = append(preHookFailures, res.GetPreHook()...)
+		postHookFailures = append(postHookFailures, res.GetPostHook()...)
+		res.ProtoSpec.PreHookFailures, res.ProtoSpec.PostHookFailures = []*gauge_messages.ProtoHookFailure{}, []*gauge_messages.ProtoHookFailure{}
+	}
+	for _, res := range specResults {
+		for _, preHook := range preHookFailures {
+			res.AddPreHook(&gauge_messages.ProtoHookFailure{StackTrace: preHook.StackTrace, ErrorMessage: preHook.ErrorMessage, ScreenShot: preHook.ScreenShot, TableRowIndex: preHook.TableRowIndex})
+		}
+		for _, postHook := range postHookFailures {
+			res.AddPostHook(&gauge_messages.ProtoHookFailure{StackTrace: postHook.StackTrace, ErrorMessage: postHook.ErrorMessage, ScreenShot: postHook.ScreenShot, TableRowIndex: postHook.TableRowIndex})
+		}
+		results = append(results, res)
 	}
 	return results
 }
@@ -177,7 +223,9 @@ func (e *simpleExecution) initSuiteDataStore() *(gauge_messages.ProtoExecutionRe
 
 func (e *simpleExecution) executeHook(m *gauge_messages.Message) *(gauge_messages.ProtoExecutionResult) {
 	e.pluginHandler.NotifyPlugins(m)
-	return e.runner.ExecuteAndGetStatus(m)
+	res := e.runner.ExecuteAndGetStatus(m)
+	recordHookTime(m, res)
+	return res
 }
 
 func (e *simpleExecution) notifyExecutionResult() {
@@ -44,6 +44,13 @@ type specExecutor struct {
 	errMap               *gauge.BuildErrors
 	stream               int
 	scenarioExecutor     executor
+	// skipErrs are the errors the spec is skipped for at the time of execution, they are kept here as the error map
+	// is shared by the streams executing in parallel
+	skipErrs []error
+	// scenarioSkipErrs are the errors the scenarios of the spec are skipped for at the time of execution
+	scenarioSkipErrs []error
+	// specHooks runs the spec hooks once for all the parts of the spec, when they are executed on several streams
+	specHooks *specHooks
 }
 
 func newSpecExecutor(s *gauge.Specification, r runner.Runner, ph plugin.Handler, e *gauge.BuildErrors, stream int) *specExecutor {
@@ -83,19 +90,28 @@ func (e *specExecutor) execute(executeBefore, execute, executeAfter bool) *resul
 		logger.Fatalf(true, "Failed to resolve Specifications : %s", err.Error())
 	}
 	e.specResult.AddSpecItems(resolvedSpecItems)
+	defer dependencies.recordSpec(e.specification, e.specResult)
+	if err := dependencies.unmetSpecDependency(e.specification); err != nil {
+		e.skipSpecForUnmetDependency(err)
+	}
+	if executeBefore && isCancelled() {
+		e.skipErrs = append(e.skipErrs, validation.NewSpecValidationError(result.ExecutionCancelled, e.specification.FileName))
+	}
 	if executeBefore {
+		startSpec(e.runner)
 		event.Notify(event.NewExecutionEvent(event.SpecStart, e.specification, e.specResult, e.stream, *e.currentExecutionInfo))
-		if _, ok := e.errMap.SpecErrs[e.specification]; !ok {
+		if !e.skipped() {
 			if res := e.initSpecDataStore(); res.GetFailed() {
 				e.skipSpecForError(fmt.Errorf("Failed to initialize spec datastore. Error: %s", res.GetErrorMessage()))
 			} else {
-				e.notifyBeforeSpecHook()
+				e.executeBeforeSpecHook()
 			}
 		} else {
 			e.specResult.SetSkipped(true)
-			e.specResult.Errors = e.convertErrors(e.errMap.SpecErrs[e.specification])
+			e.specResult.Errors = e.convertErrors(append(append([]error{}, e.errMap.SpecErrs[e.specification]...), e.skipErrs...))
 		}
 	}
+	scenarioCount := len(e.specification.Scenarios)
 	if execute && !e.specResult.GetFailed() {
 		if e.specification.DataTable.Table.GetRowCount() == 0 {
 			others, tableDriven := parser.FilterTableRelatedScenarios(e.specification.Scenarios, func(s *gauge.Scenario) bool {
@@ -106,12 +122,8 @@ func (e *specExecutor) execute(executeBefore, execute, executeAfter bool) *resul
 				logger.Fatalf(true, "Failed to resolve Specifications : %s", err.Error())
 			}
 			e.specResult.AddScenarioResults(results)
-			scnMap := make(map[int]bool, 0)
+			skipped := e.specResult.ScenarioSkippedCount
 			for _, s := range tableDriven {
-				if _, ok := scnMap[s.Span.Start]; !ok {
-					scnMap[s.Span.Start] = true
-				}
-
 				r, err := e.executeScenario(s)
 				if err != nil {
 					logger.Fatalf(true, "Failed to resolve Specifications : %s", err.Error())
@@ -119,14 +131,19 @@ func (e *specExecutor) execute(executeBefore, execute, executeAfter bool) *resul
 				e.specResult.AddTableDrivenScenarioResult(r, gauge.ConvertToProtoTable(&s.DataTable.Table),
 					s.ScenarioDataTableRowIndex, s.SpecDataTableRowIndex, s.SpecDataTableRow.IsInitialized())
 			}
-			e.specResult.ScenarioCount += len(scnMap)
+			// the rows of a scenario data table are counted as one scenario
+			count, failed, skippedScenarios := result.ScenarioTableStats(e.specResult.ProtoSpec.Items)
+			e.specResult.ScenarioCount += count
+			e.specResult.ScenarioFailedCount += failed
+			e.specResult.ScenarioSkippedCount = skipped + skippedScenarios
+			scenarioCount = len(others) + count
 		} else {
 			e.executeSpec()
 		}
 	}
-	e.specResult.SetSkipped(e.specResult.Skipped || e.specResult.ScenarioSkippedCount == len(e.specification.Scenarios))
+	e.specResult.SetSkipped(e.specResult.Skipped || e.specResult.ScenarioSkippedCount == scenarioCount)
 	if executeAfter {
-		if _, ok := e.errMap.SpecErrs[e.specification]; !ok {
+		if e.runsAfterSpecHook() {
 			e.notifyAfterSpecHook()
 		}
 		event.Notify(event.NewExecutionEvent(event.SpecEnd, e.specification, e.specResult, e.stream, *e.currentExecutionInfo))
@@ -180,6 +197,31 @@ func (e *specExecutor) notifyBeforeSpecHook() {
 	}
 }
 
+// executeBeforeSpecHook runs the before spec hook. When the parts of the spec are executed on several streams, the part
+// which runs the hook is the first one, the other parts fail along with it.
+func (e *specExecutor) executeBeforeSpecHook() {
+	if e.specHooks == nil {
+		e.notifyBeforeSpecHook()
+		return
+	}
+	failures, ran := e.specHooks.before(e.specification.FileName, func() []*gauge_messages.ProtoHookFailure {
+		e.notifyBeforeSpecHook()
+		return e.specResult.GetPreHook()
+	})
+	if !ran && len(failures) > 0 {
+		setSpecFailure(e.currentExecutionInfo)
+		e.specResult.SetFailure()
+	}
+}
+
+// runsAfterSpecHook tells if the after spec hook is run at the end of this part of the spec.
+func (e *specExecutor) runsAfterSpecHook() bool {
+	if e.specHooks == nil {
+		return !e.skipped()
+	}
+	return e.specHooks.after(e.specification.FileName)
+}
+
 func (e *specExecutor) notifyAfterSpecHook() {
 	e.currentExecutionInfo.CurrentScenario = nil
 	m := &gauge_messages.Message{MessageType: gauge_messages.Message_SpecExecutionEnding,
@@ -196,8 +238,7 @@ func (e *specExecutor) notifyAfterSpecHook() {
 
 func (e *specExecutor) skipSpecForError(err error) {
 	logger.Errorf(true, err.Error())
-	validationError := validation.NewStepValidationError(&gauge.Step{LineNo: e.specification.Heading.LineNo, LineText: e.specification.Heading.Value},
-		err.Error(), e.specification.FileName, nil, "")
+	validationError := e.headingError(err)
 	for _, scenario := range e.specification.Scenarios {
 		e.errMap.ScenarioErrs[scenario] = []error{validationError}
 	}
@@ -206,6 +247,27 @@ func (e *specExecutor) skipSpecForError(err error) {
 	e.specResult.SetSkipped(true)
 }
 
+// skipped tells if the spec is skipped, for its validation errors or for errors found at the time of execution.
+func (e *specExecutor) skipped() bool {
+	_, ok := e.errMap.SpecErrs[e.specification]
+	return ok || len(e.skipErrs) > 0
+}
+
+// skipSpecForUnmetDependency skips the spec and all its scenarios as a spec it depends on did not pass.
+func (e *specExecutor) skipSpecForUnmetDependency(err error) {
+	logger.Warningf(true, "%s", err.Error())
+	validationError := e.headingError(err)
+	e.skipErrs = []error{validationError}
+	e.scenarioSkipErrs = []error{validationError}
+	e.specResult.Errors = e.convertErrors(e.skipErrs)
+	e.specResult.SetSkipped(true)
+}
+
+func (e *specExecutor) headingError(err error) error {
+	return validation.NewStepValidationError(&gauge.Step{LineNo: e.specification.Heading.LineNo, LineText: e.specification.Heading.Value},
+		err.Error(), e.specification.FileName, nil, "")
+}
+
 func (e *specExecutor) failSpec() {
 	e.specResult.Errors = e.convertErrors(e.errMap.SpecErrs[e.specification])
 	e.specResult.SetFailure()
@@ -275,19 +337,7 @@ func (e *specExecutor) executeScenarios(scenarios []*gauge.Scenario) ([]result.R
 func (e *specExecutor) executeScenario(scenario *gauge.Scenario) (*result.ScenarioResult, error) {
 	var scenarioResult *result.ScenarioResult
 
-	shouldRetry := RetryOnlyTags == ""
-
-	if !shouldRetry {
-		spec := e.specification
-		tagValues := make([]string, 0)
-		if spec.Tags != nil {
-			tagValues = spec.Tags.Values()
-		}
-
-		specFilter := filter.NewScenarioFilterBasedOnTags(tagValues, RetryOnlyTags)
-
-		shouldRetry = !(specFilter.Filter(scenario))
-	}
+	shouldRetry := shouldRetryScenario(e.specification, scenario)
 
 	for i := 0; i < MaxRetriesCount; i++ {
 		e.currentExecutionInfo.CurrentScenario = &gauge_messages.ScenarioInfo{
@@ -301,24 +351,42 @@ func (e *specExecutor) executeScenario(scenario *gauge.Scenario) (*result.Scenar
 			ScenarioDataTableRow:      gauge.ConvertToProtoTable(&scenario.ScenarioDataTableRow),
 			ScenarioDataTableRowIndex: scenario.ScenarioDataTableRowIndex,
 			ScenarioDataTable:         gauge.ConvertToProtoTable(&scenario.DataTable.Table),
+			Retries:                   i,
 		}
 		if err := e.addAllItemsForScenarioExecution(scenario, scenarioResult); err != nil {
 			return nil, err
 		}
 
-		e.scenarioExecutor.execute(scenario, scenarioResult)
+		if len(e.scenarioSkipErrs) > 0 {
+			skipScenario(scenario, scenarioResult, e.scenarioSkipErrs, e.stream, e.currentExecutionInfo)
+		} else {
+			e.scenarioExecutor.execute(scenario, scenarioResult)
+		}
 
 		if scenarioResult.ProtoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_SKIPPED {
 			e.specResult.ScenarioSkippedCount++
 		}
 
-		if !(shouldRetry && scenarioResult.GetFailed()) {
+		if !(shouldRetry && scenarioResult.GetFailed()) || isCancelled() {
 			break
 		}
 	}
+	dependencies.recordScenario(e.specification.FileName, scenario, scenarioResult)
 	return scenarioResult, nil
 }
 
+// shouldRetryScenario tells if the scenario is retried when it fails, i.e. if it matches --retry-only.
+func shouldRetryScenario(spec *gauge.Specification, scenario *gauge.Scenario) bool {
+	if retryOnly == nil {
+		return true
+	}
+	tagValues := make([]string, 0)
+	if spec.Tags != nil {
+		tagValues = spec.Tags.Values()
+	}
+	return !filter.NewScenarioFilterBasedOnTags(tagValues, retryOnly).Filter(scenario)
+}
+
 func (e *specExecutor) addAllItemsForScenarioExecution(scenario *gauge.Scenario, scenarioResult *result.ScenarioResult) error {
 	contexts, err := e.getItemsForScenarioExecution(e.specification.Contexts)
 	if err != nil {
@@ -359,6 +427,15 @@ func setSpecFailure(executionInfo *gauge_messages.ExecutionInfo) {
 	executionInfo.CurrentSpec.IsFailed = true
 }
 
+// excludedByTableRows tells if the scenario is executed for a data table row which is not selected by --table-rows. The
+// rows are those of the spec data table for the scenarios driven by it, and those of the scenario data table for others.
+func excludedByTableRows(scenario *gauge.Scenario) bool {
+	if scenario.SpecDataTableRow.IsInitialized() {
+		return !shouldExecuteForRow(scenario.SpecDataTableRowIndex)
+	}
+	return scenario.ScenarioDataTableRow.IsInitialized() && !shouldExecuteForRow(scenario.ScenarioDataTableRowIndex)
+}
+
 func shouldExecuteForRow(i int) bool {
 	if len(tableRowsIndexes) < 1 {
 		return true
@@ -394,6 +471,7 @@ func getDataTableRows(tableRows string) (tableRowIndexes []int) {
 func executeHook(message *gauge_messages.Message, execTimeTracker result.ExecTimeTracker, r runner.Runner) *gauge_messages.ProtoExecutionResult {
 	executionResult := r.ExecuteAndGetStatus(message)
 	execTimeTracker.AddExecTime(executionResult.GetExecutionTime())
+	recordHookTime(message, executionResult)
 	return executionResult
 }
 
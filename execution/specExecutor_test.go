@@ -668,6 +668,7 @@ var exampleSpecWithTags = &gauge.Specification{
 func TestExecuteScenarioShouldNotRetryIfNotMatchTags(t *testing.T) {
 	MaxRetriesCount = 2
 	RetryOnlyTags = "tagN"
+	parseRetryOnlyTags([]*gauge.Specification{exampleSpecWithTags})
 
 	se := newSpecExecutorForTestsWithRetry()
 	sceResult, _ := se.executeScenario(exampleSpecWithTags.Scenarios[0])
@@ -680,6 +681,7 @@ func TestExecuteScenarioShouldNotRetryIfNotMatchTags(t *testing.T) {
 func TestExecuteScenarioShouldRetryIfSpecificationMatchTags(t *testing.T) {
 	MaxRetriesCount = 2
 	RetryOnlyTags = "tagSpec"
+	parseRetryOnlyTags([]*gauge.Specification{exampleSpecWithTags})
 
 	se := newSpecExecutorForTestsWithRetry()
 
@@ -693,6 +695,7 @@ func TestExecuteScenarioShouldRetryIfSpecificationMatchTags(t *testing.T) {
 func TestExecuteScenarioShouldRetryIfScenarioMatchTags(t *testing.T) {
 	MaxRetriesCount = 2
 	RetryOnlyTags = "tagSce"
+	parseRetryOnlyTags([]*gauge.Specification{exampleSpecWithTags})
 
 	se := newSpecExecutorForTestsWithRetry()
 
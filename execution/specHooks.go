@@ -0,0 +1,78 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution
+
+import (
+	"sync"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+)
+
+// specHooks runs the before and after spec hooks once for a spec whose scenarios are executed as specs of their own,
+// spread across the parallel streams. The first part of the spec to be executed runs the before spec hook, while the
+// other parts wait for it. The last part to finish runs the after spec hook, once all the scenarios of the spec are done.
+type specHooks struct {
+	mutex sync.Mutex
+	specs map[string]*specHooksState
+}
+
+type specHooksState struct {
+	parts           int
+	beforeSpecDone  chan bool
+	preHookFailures []*gauge_messages.ProtoHookFailure
+}
+
+// newSpecHooks counts the parts of every spec among the specs, the parts of a spec have its file name.
+func newSpecHooks(specs []*gauge.Specification) *specHooks {
+	h := &specHooks{specs: make(map[string]*specHooksState)}
+	for _, s := range specs {
+		if _, ok := h.specs[s.FileName]; !ok {
+			h.specs[s.FileName] = &specHooksState{}
+		}
+		h.specs[s.FileName].parts++
+	}
+	return h
+}
+
+// before runs the before spec hook with run, unless another part of the spec has run it already. The parts which do not
+// run the hook wait for it to finish. It gives the failures of the hook and whether this part ran it.
+func (h *specHooks) before(fileName string, run func() []*gauge_messages.ProtoHookFailure) ([]*gauge_messages.ProtoHookFailure, bool) {
+	h.mutex.Lock()
+	s := h.specs[fileName]
+	if s.beforeSpecDone != nil {
+		h.mutex.Unlock()
+		<-s.beforeSpecDone
+		return s.preHookFailures, false
+	}
+	s.beforeSpecDone = make(chan bool)
+	h.mutex.Unlock()
+	s.preHookFailures = run()
+	close(s.beforeSpecDone)
+	return s.preHookFailures, true
+}
+
+// after records that a part of the spec is done and tells if the after spec hook is to be run. It is run by the last part
+// to finish, provided the before spec hook was run, i.e. the spec was not skipped.
+func (h *specHooks) after(fileName string) bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	s := h.specs[fileName]
+	s.parts--
+	return s.parts == 0 && s.beforeSpecDone != nil
+}
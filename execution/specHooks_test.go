@@ -0,0 +1,115 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/parser"
+)
+
+// hooksRunner records the spec hooks and the steps it executes in messages, which is shared by the runners of all streams.
+func hooksRunner(mu *sync.Mutex, messages *[]string, failBeforeSpec bool) *mockRunner {
+	return &mockRunner{ExecuteAndGetStatusFunc: func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		mu.Lock()
+		defer mu.Unlock()
+		switch m.MessageType {
+		case gauge_messages.Message_SpecExecutionStarting:
+			*messages = append(*messages, "before spec")
+			if failBeforeSpec {
+				return &gauge_messages.ProtoExecutionResult{Failed: true, ErrorMessage: "before spec failed"}
+			}
+		case gauge_messages.Message_SpecExecutionEnding:
+			*messages = append(*messages, "after spec")
+		case gauge_messages.Message_ExecuteStep:
+			*messages = append(*messages, m.ExecuteStepRequest.ParsedStepText)
+		}
+		return &gauge_messages.ProtoExecutionResult{}
+	}}
+}
+
+// executeScenariosOnStreams executes the scenarios of a spec as specs of their own, the first and last on stream 1 and
+// the second on stream 2, and gives the merged result of the spec.
+func executeScenariosOnStreams(t *testing.T, mu *sync.Mutex, messages *[]string, failBeforeSpec bool) *result.SpecResult {
+	spec, _, _ := new(parser.SpecParser).Parse("Spec\n====\nFirst\n-----\n* first\nSecond\n------\n* second\nThird\n-----\n* third\n",
+		gauge.NewConceptDictionary(), "a.spec")
+	errs := gauge.NewBuildErrors()
+	parts := parser.GetSpecsForScenarios([]*gauge.Specification{spec}, errs)
+	h := &mockPluginHandler{NotifyPluginsfunc: func(m *gauge_messages.Message) {}, GracefullyKillPluginsfunc: func() {}}
+	e := &parallelExecution{errMaps: errs, pluginHandler: h, specHooks: newSpecHooks(parts)}
+	streams := [][]*gauge.Specification{{parts[0], parts[2]}, {parts[1]}}
+	resChan := make(chan *result.SuiteResult, len(streams))
+	wg := &sync.WaitGroup{}
+	for i, s := range streams {
+		wg.Add(1)
+		go func(s []*gauge.Specification, stream int) {
+			defer wg.Done()
+			e.startSpecsExecutionWithRunner(gauge.NewSpecCollection(s, false), resChan, hooksRunner(mu, messages, failBeforeSpec), stream)
+		}(s, i+1)
+	}
+	wg.Wait()
+	close(resChan)
+	var specResults []*result.SpecResult
+	for r := range resChan {
+		specResults = append(specResults, r.SpecResults...)
+	}
+	if len(specResults) != 3 {
+		t.Fatalf("Expected a result for every scenario, got %d", len(specResults))
+	}
+	return mergeSpecResults(specResults, true)
+}
+
+func TestSpecHooksRunOnceForScenariosOnSeveralStreams(t *testing.T) {
+	defer func(retries int) { MaxRetriesCount = retries }(MaxRetriesCount)
+	MaxRetriesCount = 1
+	event.InitRegistry()
+	mu := &sync.Mutex{}
+	var messages []string
+
+	res := executeScenariosOnStreams(t, mu, &messages, false)
+
+	if len(messages) != 5 || messages[0] != "before spec" || messages[4] != "after spec" {
+		t.Errorf("Expected the before spec hook once before the scenarios and the after spec hook once after them, got %v", messages)
+	}
+	if res.GetFailed() || res.ScenarioCount != 3 {
+		t.Errorf("Expected the spec to pass with 3 scenarios, got %+v", res)
+	}
+}
+
+func TestScenariosOnSeveralStreamsFailWithBeforeSpecHook(t *testing.T) {
+	defer func(retries int) { MaxRetriesCount = retries }(MaxRetriesCount)
+	MaxRetriesCount = 1
+	event.InitRegistry()
+	mu := &sync.Mutex{}
+	var messages []string
+
+	res := executeScenariosOnStreams(t, mu, &messages, true)
+
+	if want := []string{"before spec", "after spec"}; !reflect.DeepEqual(messages, want) {
+		t.Errorf("Expected only the spec hooks to run, got %v", messages)
+	}
+	if !res.GetFailed() || len(res.GetPreHook()) != 1 || res.GetPreHook()[0].GetErrorMessage() != "before spec failed" {
+		t.Errorf("Expected the spec to fail once with the before spec hook, got %+v", res.GetPreHook())
+	}
+}
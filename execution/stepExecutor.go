@@ -18,6 +18,8 @@
 package execution
 
 import (
+	"time"
+
 	"github.com/getgauge/gauge/execution/event"
 	"github.com/getgauge/gauge/execution/result"
 	"github.com/getgauge/gauge/gauge"
@@ -31,6 +33,9 @@ type stepExecutor struct {
 	pluginHandler        plugin.Handler
 	currentExecutionInfo *gauge_messages.ExecutionInfo
 	stream               int
+	timeout              time.Duration
+	deadline             time.Time
+	retries              int
 }
 
 // TODO: stepExecutor should not consume both gauge.Step and gauge_messages.ProtoStep. The usage of ProtoStep should be eliminated.
@@ -50,7 +55,7 @@ func (e *stepExecutor) executeStep(step *gauge.Step, protoStep *gauge_messages.P
 	e.notifyBeforeStepHook(stepResult)
 	if !stepResult.GetFailed() {
 		executeStepMessage := &gauge_messages.Message{MessageType: gauge_messages.Message_ExecuteStep, ExecuteStepRequest: stepRequest}
-		stepExecutionStatus := e.runner.ExecuteAndGetStatus(executeStepMessage)
+		stepExecutionStatus := e.executeWithRetries(executeStepMessage)
 		stepExecutionStatus.Message = append(stepResult.ProtoStepExecResult().GetExecutionResult().Message, stepExecutionStatus.Message...)
 		stepExecutionStatus.Screenshots = append(stepResult.ProtoStepExecResult().GetExecutionResult().Screenshots, stepExecutionStatus.Screenshots...)
 		if stepExecutionStatus.GetFailed() {
@@ -68,6 +73,16 @@ func (e *stepExecutor) executeStep(step *gauge.Step, protoStep *gauge_messages.P
 	return stepResult
 }
 
+// executeAndGetStatus fails the step once the deadline of its scenario is reached.
+func (e *stepExecutor) executeAndGetStatus(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+	return executeWithDeadline(e.runner, m, e.timeout, e.deadline, e.currentExecutionInfo)
+}
+
+// hookRunner bounds the step hooks by the deadline of the scenario.
+func (e *stepExecutor) hookRunner() runner.Runner {
+	return &timedRunner{Runner: e.runner, timeout: e.timeout, deadline: e.deadline, info: e.currentExecutionInfo}
+}
+
 func (e *stepExecutor) createStepRequest(protoStep *gauge_messages.ProtoStep) *gauge_messages.ExecuteStepRequest {
 	stepRequest := &gauge_messages.ExecuteStepRequest{ParsedStepText: protoStep.GetParsedText(), ActualStepText: protoStep.GetActualText()}
 	stepRequest.Parameters = getParameters(protoStep.GetFragments())
@@ -80,7 +95,7 @@ func (e *stepExecutor) notifyBeforeStepHook(stepResult *result.StepResult) {
 		StepExecutionStartingRequest: &gauge_messages.StepExecutionStartingRequest{CurrentExecutionInfo: e.currentExecutionInfo},
 	}
 	e.pluginHandler.NotifyPlugins(m)
-	res := executeHook(m, stepResult, e.runner)
+	res := executeHook(m, stepResult, e.hookRunner())
 	stepResult.ProtoStep.PreHookMessages = res.Message
 	stepResult.ProtoStep.PreHookScreenshots = res.Screenshots
 	if res.GetFailed() {
@@ -95,7 +110,7 @@ func (e *stepExecutor) notifyAfterStepHook(stepResult *result.StepResult) {
 		StepExecutionEndingRequest: &gauge_messages.StepExecutionEndingRequest{CurrentExecutionInfo: e.currentExecutionInfo},
 	}
 
-	res := executeHook(m, stepResult, e.runner)
+	res := executeHook(m, stepResult, e.hookRunner())
 	stepResult.ProtoStep.PostHookMessages = res.Message
 	stepResult.ProtoStep.PostHookScreenshots = res.Screenshots
 	if res.GetFailed() {
@@ -0,0 +1,113 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package execution
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+)
+
+const stepRetriesTagPrefix = "step-retries:"
+
+// StepRetries is the number of times a failing step is retried before its scenario fails. 0 means no retries.
+var StepRetries int
+
+// StepRetryBackoff is the wait before the first retry of a step, it is doubled for every further retry.
+var StepRetryBackoff = time.Second
+
+// stepRetries gives the number of retries for the steps of a scenario. A `step-retries:<n>` tag on the scenario takes
+// precedence over the one on its spec, which in turn takes precedence over the --step-retries flag.
+func stepRetries(specTags, scenarioTags []string) int {
+	if n, ok := stepRetriesFromTags(scenarioTags); ok {
+		return n
+	}
+	if n, ok := stepRetriesFromTags(specTags); ok {
+		return n
+	}
+	return StepRetries
+}
+
+func stepRetriesFromTags(tags []string) (int, bool) {
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if !strings.HasPrefix(strings.ToLower(tag), stepRetriesTagPrefix) {
+			continue
+		}
+		value := strings.TrimSpace(tag[len(stepRetriesTagPrefix):])
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			logger.Warningf(true, "Ignoring tag '%s'. Invalid number of step retries '%s', expected a non negative number.", tag, value)
+			continue
+		}
+		return n, true
+	}
+	return 0, false
+}
+
+// stepRetryBackoff gives the wait before the given retry of a step, starting at 1.
+func stepRetryBackoff(retry int) time.Duration {
+	return StepRetryBackoff * time.Duration(1<<uint(retry-1))
+}
+
+// executeWithRetries executes a step until it passes or runs out of retries. The earlier attempts are recorded in the
+// messages of the result of the last one, so that they show up in the step result.
+func (e *stepExecutor) executeWithRetries(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+	res := e.executeAndGetStatus(m)
+	var attempts []string
+	executionTime := res.GetExecutionTime()
+	retry := 1
+	for ; res.GetFailed() && e.canRetryStep(retry); retry++ {
+		attempts = append(attempts, failedAttemptMessage(retry, res))
+		attempts = append(attempts, res.GetMessage()...)
+		backoff := stepRetryBackoff(retry)
+		logger.Debugf(true, "Step '%s' failed, retrying in %s.", m.GetExecuteStepRequest().GetActualStepText(), backoff)
+		time.Sleep(backoff)
+		res = e.executeAndGetStatus(m)
+		executionTime += res.GetExecutionTime()
+	}
+	if retry == 1 {
+		return res
+	}
+	status := "passed"
+	if res.GetFailed() {
+		status = "failed"
+	}
+	res.Message = append(append(attempts, fmt.Sprintf("Attempt %d %s", retry, status)), res.GetMessage()...)
+	res.ExecutionTime = executionTime
+	return res
+}
+
+// canRetryStep tells if a failed step can be attempted again. Steps of a cancelled execution are not retried, neither
+// are steps whose retry would start after the deadline of their scenario, which includes the steps that timed out.
+func (e *stepExecutor) canRetryStep(retry int) bool {
+	if retry > e.retries || isCancelled() {
+		return false
+	}
+	if e.deadline.IsZero() {
+		return true
+	}
+	return time.Now().Add(stepRetryBackoff(retry)).Before(e.deadline)
+}
+
+func failedAttemptMessage(attempt int, res *gauge_messages.ProtoExecutionResult) string {
+	return fmt.Sprintf("Attempt %d failed in %dms: %s", attempt, res.GetExecutionTime(), res.GetErrorMessage())
+}
@@ -0,0 +1,100 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package execution
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/getgauge/gauge/gauge_messages"
+)
+
+func TestStepRetriesPrefersScenarioTag(t *testing.T) {
+	defer func() { StepRetries = 0 }()
+	StepRetries = 5
+
+	if got := stepRetries([]string{"step-retries:2"}, []string{"Step-Retries: 3"}); got != 3 {
+		t.Errorf("Expected 3 retries from scenario tag, got %d", got)
+	}
+	if got := stepRetries([]string{"step-retries:2"}, []string{"step-retries:x"}); got != 2 {
+		t.Errorf("Expected 2 retries from spec tag, got %d", got)
+	}
+	if got := stepRetries(nil, []string{"smoke"}); got != 5 {
+		t.Errorf("Expected 5 retries from flag, got %d", got)
+	}
+}
+
+func TestStepRetryBackoffDoublesForEveryRetry(t *testing.T) {
+	defer func() { StepRetryBackoff = time.Second }()
+	StepRetryBackoff = 100 * time.Millisecond
+
+	got := []time.Duration{stepRetryBackoff(1), stepRetryBackoff(2), stepRetryBackoff(3)}
+
+	want := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected backoffs %v, got %v", want, got)
+	}
+}
+
+func TestExecuteWithRetriesRecordsEveryAttempt(t *testing.T) {
+	defer func() { StepRetryBackoff = time.Second }()
+	StepRetryBackoff = time.Millisecond
+	attempts := 0
+	r := &mockRunner{ExecuteAndGetStatusFunc: func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		attempts++
+		if attempts < 3 {
+			return &gauge_messages.ProtoExecutionResult{Failed: true, ErrorMessage: "not yet", ExecutionTime: 10, Message: []string{"polling"}}
+		}
+		return &gauge_messages.ProtoExecutionResult{ExecutionTime: 5, Message: []string{"done"}}
+	}}
+	se := &stepExecutor{runner: r, currentExecutionInfo: &gauge_messages.ExecutionInfo{}, retries: 3}
+
+	res := se.executeWithRetries(&gauge_messages.Message{MessageType: gauge_messages.Message_ExecuteStep})
+
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", attempts)
+	}
+	if res.GetFailed() {
+		t.Errorf("Expected step to pass on its last attempt")
+	}
+	want := []string{"Attempt 1 failed in 10ms: not yet", "polling", "Attempt 2 failed in 10ms: not yet", "polling", "Attempt 3 passed", "done"}
+	if !reflect.DeepEqual(res.GetMessage(), want) {
+		t.Errorf("Expected messages %v, got %v", want, res.GetMessage())
+	}
+	if res.GetExecutionTime() != 25 {
+		t.Errorf("Expected execution time of all attempts, got %d", res.GetExecutionTime())
+	}
+}
+
+func TestExecuteWithRetriesStopsAtScenarioDeadline(t *testing.T) {
+	attempts := 0
+	r := &mockRunner{ExecuteAndGetStatusFunc: func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		attempts++
+		return &gauge_messages.ProtoExecutionResult{Failed: true, ErrorMessage: "not yet"}
+	}}
+	se := &stepExecutor{runner: r, currentExecutionInfo: &gauge_messages.ExecutionInfo{}, retries: 3, deadline: time.Now().Add(time.Millisecond)}
+
+	res := se.executeWithRetries(&gauge_messages.Message{MessageType: gauge_messages.Message_ExecuteStep})
+
+	if attempts != 1 {
+		t.Errorf("Expected no retry past the deadline, got %d attempts", attempts)
+	}
+	if !res.GetFailed() || len(res.GetMessage()) != 0 {
+		t.Errorf("Expected failed result without attempt messages, got %v", res)
+	}
+}
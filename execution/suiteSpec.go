@@ -0,0 +1,105 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package execution
+
+import (
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/plugin"
+	"github.com/getgauge/gauge/runner"
+)
+
+const (
+	suiteSetupHeading    = "Suite setup"
+	suiteTeardownHeading = "Suite teardown"
+)
+
+// executeSuiteSteps executes the contexts or the teardown steps of the suite spec and reports them like the steps of
+// a scenario with the given heading. The steps are skipped if the suite spec has validation errors.
+func executeSuiteSteps(spec *gauge.Specification, heading string, steps []*gauge.Step, r runner.Runner, ph plugin.Handler, errMap *gauge.BuildErrors, stream int) *result.ScenarioResult {
+	scenario := &gauge.Scenario{
+		Heading: &gauge.Heading{Value: heading, LineNo: steps[0].LineNo, HeadingType: gauge.ScenarioHeading},
+		Steps:   steps,
+		Span:    &gauge.Span{Start: steps[0].LineNo, End: steps[len(steps)-1].LineNo},
+	}
+	res := result.NewScenarioResult(&gauge_messages.ProtoScenario{
+		ScenarioHeading: heading,
+		ExecutionStatus: gauge_messages.ExecutionStatus_PASSED,
+		Span:            &gauge_messages.Span{Start: int64(scenario.Span.Start), End: int64(scenario.Span.End)},
+	})
+	se := newSpecExecutor(spec, r, ph, errMap, stream)
+	if errs, ok := errMap.SpecErrs[spec]; ok {
+		res.ProtoScenario.ExecutionStatus = gauge_messages.ExecutionStatus_SKIPPED
+		res.ProtoScenario.Skipped = true
+		for _, err := range errs {
+			res.ProtoScenario.SkipErrors = append(res.ProtoScenario.SkipErrors, err.Error())
+		}
+		return res
+	}
+	items, err := se.getItemsForScenarioExecution(steps)
+	if err != nil {
+		res.ProtoScenario.ExecutionStatus = gauge_messages.ExecutionStatus_SKIPPED
+		res.ProtoScenario.Skipped = true
+		res.ProtoScenario.SkipErrors = []string{err.Error()}
+		return res
+	}
+	res.AddItems(items)
+	se.currentExecutionInfo.CurrentScenario = &gauge_messages.ScenarioInfo{Name: heading, IsFailed: false}
+	event.Notify(event.NewExecutionEvent(event.SuiteStepsStart, scenario, res, stream, *se.currentExecutionInfo))
+	newScenarioExecutor(r, ph, se.currentExecutionInfo, errMap, nil, nil, stream).executeSteps(steps, items, res, false)
+	res.UpdateExecutionTime()
+	event.Notify(event.NewExecutionEvent(event.SuiteStepsEnd, scenario, res, stream, *se.currentExecutionInfo))
+	return res
+}
+
+// setUpSuite executes the contexts of the suite spec. The suite fails if they fail or are skipped. In a parallel execution
+// they run once for all the streams, on another runner, so the state they set up is to be kept outside the runner.
+func (e *simpleExecution) setUpSuite() {
+	if e.suiteSpec == nil || len(e.suiteSpec.Contexts) == 0 {
+		return
+	}
+	res := executeSuiteSteps(e.suiteSpec, suiteSetupHeading, e.suiteSpec.Contexts, e.runner, e.pluginHandler, e.errMaps, e.stream)
+	e.suiteResult.SuiteSetup = res
+	if !suiteStepsPassed(res) {
+		e.suiteResult.SetFailure()
+		logger.Errorf(true, "%s in %s failed, the specifications are not executed.", suiteSetupHeading, e.suiteSpec.FileName)
+	}
+}
+
+// tearDownSuite executes the teardown steps of the suite spec, unless the before suite hook failed or its contexts
+// were skipped.
+func (e *simpleExecution) tearDownSuite() {
+	if e.suiteSpec == nil || len(e.suiteSpec.TearDownSteps) == 0 || e.suiteResult.PreSuite != nil {
+		return
+	}
+	if setup := e.suiteResult.SuiteSetup; setup != nil && setup.ProtoScenario.GetSkipped() {
+		return
+	}
+	res := executeSuiteSteps(e.suiteSpec, suiteTeardownHeading, e.suiteSpec.TearDownSteps, e.runner, e.pluginHandler, e.errMaps, e.stream)
+	e.suiteResult.SuiteTeardown = res
+	if !suiteStepsPassed(res) {
+		e.suiteResult.SetFailure()
+		logger.Errorf(true, "%s in %s failed.", suiteTeardownHeading, e.suiteSpec.FileName)
+	}
+}
+
+func suiteStepsPassed(res *result.ScenarioResult) bool {
+	return !res.GetFailed() && !res.ProtoScenario.GetSkipped()
+}
@@ -0,0 +1,173 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/parser"
+)
+
+// stepsRunner records the steps it executes and fails the ones in failing.
+type stepsRunner struct {
+	mockRunner
+	mu       sync.Mutex
+	executed []string
+}
+
+func newStepsRunner(failing ...string) *stepsRunner {
+	r := &stepsRunner{}
+	r.ExecuteAndGetStatusFunc = func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		if m.MessageType != gauge_messages.Message_ExecuteStep {
+			return &gauge_messages.ProtoExecutionResult{}
+		}
+		step := m.ExecuteStepRequest.ParsedStepText
+		r.mu.Lock()
+		r.executed = append(r.executed, step)
+		r.mu.Unlock()
+		for _, f := range failing {
+			if f == step {
+				return &gauge_messages.ProtoExecutionResult{Failed: true, ErrorMessage: step + " failed"}
+			}
+		}
+		return &gauge_messages.ProtoExecutionResult{}
+	}
+	return r
+}
+
+func suiteSpecExecution(r *stepsRunner, stream int) *simpleExecution {
+	spec, _, _ := new(parser.SpecParser).Parse("Spec\n====\nScenario\n--------\n* spec step\n", gauge.NewConceptDictionary(), "a.spec")
+	h := &mockPluginHandler{NotifyPluginsfunc: func(m *gauge_messages.Message) {}, GracefullyKillPluginsfunc: func() {}}
+	ei := newExecutionInfo(gauge.NewSpecCollection([]*gauge.Specification{spec}, false), r, h, gauge.NewBuildErrors(), false, stream)
+	ei.suiteSpec = &gauge.Specification{
+		Heading:       &gauge.Heading{Value: "Suite"},
+		FileName:      "_suite.spec",
+		Contexts:      []*gauge.Step{newStep("set up")},
+		TearDownSteps: []*gauge.Step{newStep("tear down")},
+	}
+	return newSimpleExecution(ei, false)
+}
+
+func TestSuiteSpecStepsRunBeforeAndAfterTheSpecs(t *testing.T) {
+	defer func(retries int) { MaxRetriesCount = retries }(MaxRetriesCount)
+	MaxRetriesCount = 1
+	event.InitRegistry()
+	r := newStepsRunner()
+	e := suiteSpecExecution(r, 0)
+
+	e.execute()
+
+	if want := []string{"set up", "spec step", "tear down"}; !reflect.DeepEqual(r.executed, want) {
+		t.Errorf("Expected steps %v to be executed, got %v", want, r.executed)
+	}
+	res := gauge.ConvertToProtoSuiteResult(e.suiteResult)
+	if res.GetFailed() || len(res.GetSpecResults()) != 1 {
+		t.Errorf("Expected the suite to pass with 1 spec, got %v", res)
+	}
+	if res.GetSuiteSetup().GetScenarioItems()[0].GetStep().GetActualText() != "set up" ||
+		res.GetSuiteTeardown().GetScenarioItems()[0].GetStep().GetActualText() != "tear down" {
+		t.Errorf("Expected suite setup and teardown steps in the suite result, got %v %v", res.GetSuiteSetup(), res.GetSuiteTeardown())
+	}
+	if res.GetPreHookFailure() != nil || res.GetPostHookFailure() != nil {
+		t.Errorf("Expected no hook failures, got %v %v", res.GetPreHookFailure(), res.GetPostHookFailure())
+	}
+}
+
+func TestSpecsAreSkippedWhenSuiteSetupFails(t *testing.T) {
+	defer func(retries int) { MaxRetriesCount = retries }(MaxRetriesCount)
+	MaxRetriesCount = 1
+	event.InitRegistry()
+	r := newStepsRunner("set up")
+	e := suiteSpecExecution(r, 0)
+
+	e.execute()
+
+	if want := []string{"set up", "tear down"}; !reflect.DeepEqual(r.executed, want) {
+		t.Errorf("Expected steps %v to be executed, got %v", want, r.executed)
+	}
+	res := gauge.ConvertToProtoSuiteResult(e.suiteResult)
+	if !res.GetFailed() || len(res.GetSpecResults()) != 0 || !res.GetSuiteSetup().GetFailed() {
+		t.Errorf("Expected the suite to fail without executing the specs, got %v", res)
+	}
+	if msg := res.GetPreHookFailure().GetErrorMessage(); !strings.Contains(msg, "set up failed") {
+		t.Errorf("Expected the error of the suite setup to be reported, got %q", msg)
+	}
+}
+
+func TestSuiteSpecStepsRunOnceForAllStreamsOfParallelExecution(t *testing.T) {
+	defer func(retries int) { MaxRetriesCount = retries }(MaxRetriesCount)
+	MaxRetriesCount = 1
+	event.InitRegistry()
+	suiteRunner := newStepsRunner()
+	runners := []*stepsRunner{newStepsRunner(), newStepsRunner()}
+	se := suiteSpecExecution(suiteRunner, 0)
+	e := &parallelExecution{runner: suiteRunner, errMaps: gauge.NewBuildErrors(), pluginHandler: se.pluginHandler, suiteSpec: se.suiteSpec}
+	resChan := make(chan *result.SuiteResult, len(runners))
+
+	suite, ok := e.setUpSuite()
+	if !ok {
+		t.Fatalf("Expected the streams to be executed after the suite setup")
+	}
+	var res []*result.SuiteResult
+	for i, r := range runners {
+		e.startSpecsExecutionWithRunner(suiteSpecExecution(r, i+1).specCollection, resChan, r, i+1)
+		res = append(res, <-resChan)
+	}
+	suite.tearDownSuite()
+	e.aggregateResults(append(res, suite.suiteResult))
+
+	if want := []string{"set up", "tear down"}; !reflect.DeepEqual(suiteRunner.executed, want) {
+		t.Errorf("Expected steps %v to be executed once for the streams, got %v", want, suiteRunner.executed)
+	}
+	for i, r := range runners {
+		if want := []string{"spec step"}; !reflect.DeepEqual(r.executed, want) {
+			t.Errorf("Expected steps %v to be executed in stream %d, got %v", want, i+1, r.executed)
+		}
+	}
+	if e.suiteResult.IsFailed || len(e.suiteResult.SpecResults) != 2 {
+		t.Errorf("Expected the suite to pass with the specs of both streams, got %d specs", len(e.suiteResult.SpecResults))
+	}
+	if e.suiteResult.SuiteSetup == nil || e.suiteResult.SuiteTeardown == nil {
+		t.Errorf("Expected the suite setup and teardown in the suite result, got %v %v", e.suiteResult.SuiteSetup, e.suiteResult.SuiteTeardown)
+	}
+}
+
+func TestStreamsAreNotExecutedWhenSuiteSetupOfParallelExecutionFails(t *testing.T) {
+	defer func(retries int) { MaxRetriesCount = retries }(MaxRetriesCount)
+	MaxRetriesCount = 1
+	event.InitRegistry()
+	suiteRunner := newStepsRunner("set up")
+	se := suiteSpecExecution(suiteRunner, 0)
+	e := &parallelExecution{runner: suiteRunner, errMaps: gauge.NewBuildErrors(), pluginHandler: se.pluginHandler, suiteSpec: se.suiteSpec}
+
+	suite, ok := e.setUpSuite()
+
+	if ok || !suite.suiteResult.GetFailed() || !suite.suiteResult.SuiteSetup.GetFailed() {
+		t.Errorf("Expected the failed suite setup to stop the streams, got %v", suite.suiteResult.SuiteSetup)
+	}
+	if want := []string{"set up"}; !reflect.DeepEqual(suiteRunner.executed, want) {
+		t.Errorf("Expected steps %v to be executed, got %v", want, suiteRunner.executed)
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/reporter"
+	"github.com/getgauge/gauge/runner"
+)
+
+const timeoutTagPrefix = "timeout:"
+
+var errRunnerRestarted = errors.New("skipped Reason: The runner was restarted after a timeout in this spec, set replay_hooks_on_restart to run the hooks again and continue the spec")
+
+// ScenarioTimeout is the maximum duration a scenario is allowed to run. 0 means no timeout.
+var ScenarioTimeout time.Duration
+
+// scenarioTimeout gives the timeout for a scenario. A `timeout:<duration>` tag on the scenario takes precedence
+// over the one on its spec, which in turn takes precedence over the --scenario-timeout flag and the scenario_timeout property.
+func scenarioTimeout(specTags, scenarioTags []string) time.Duration {
+	if t, ok := timeoutFromTags(scenarioTags); ok {
+		return t
+	}
+	if t, ok := timeoutFromTags(specTags); ok {
+		return t
+	}
+	if ScenarioTimeout > 0 {
+		return ScenarioTimeout
+	}
+	return env.ScenarioTimeout()
+}
+
+func timeoutFromTags(tags []string) (time.Duration, bool) {
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if !strings.HasPrefix(strings.ToLower(tag), timeoutTagPrefix) {
+			continue
+		}
+		value := strings.TrimSpace(tag[len(timeoutTagPrefix):])
+		t, err := time.ParseDuration(value)
+		if err != nil || t < 0 {
+			logger.Warningf(true, "Ignoring tag '%s'. Invalid timeout '%s', expected a duration like 30s or 2m.", tag, value)
+			continue
+		}
+		return t, true
+	}
+	return 0, false
+}
+
+// recoverableRunner wraps a language runner so that it can be replaced by a fresh instance once it stops responding,
+// i.e. when a step or hook exceeds the timeout of its scenario.
+type recoverableRunner struct {
+	runner.Runner
+	stream int
+	// restartedInSpec is set once the runner is restarted without replaying the hooks, the rest of the spec is then skipped
+	restartedInSpec bool
+}
+
+func newRecoverableRunner(r runner.Runner, stream int) runner.Runner {
+	switch r.(type) {
+	case nil, *recoverableRunner, *runner.MultithreadedRunner:
+		return r
+	}
+	return &recoverableRunner{Runner: r, stream: stream}
+}
+
+// restart kills the current runner and starts a new one with initialized data stores. The before suite, spec and
+// scenario hooks are run again on it only if replay_hooks_on_restart is set, as they may have side effects like
+// creating data or starting servers. Otherwise the rest of the spec is skipped.
+func (r *recoverableRunner) restart(info *gauge_messages.ExecutionInfo) error {
+	if err := r.replace(); err != nil {
+		return err
+	}
+	replayHooks := env.ReplayHooksOnRestart()
+	r.restartedInSpec = !replayHooks
+	for _, message := range recoveryMessages(info, replayHooks) {
+		if res := r.Runner.ExecuteAndGetStatus(message); res.GetFailed() {
+			return fmt.Errorf("%s failed on restarted runner. %s", message.GetMessageType(), res.GetErrorMessage())
+		}
+	}
+	return nil
+}
+
+// replace kills the current runner and starts a new one in its place.
+func (r *recoverableRunner) replace() error {
+	if err := r.Runner.Kill(); err != nil {
+		logger.Debugf(true, "Failed to kill runner: %s", err.Error())
+	}
+	m, err := manifest.ProjectManifest()
+	if err != nil {
+		return err
+	}
+	var w io.Writer = reporter.Current()
+	if r.stream > 0 {
+		w = reporter.ParallelReporter(r.stream)
+	}
+	newRunner, err := runner.Start(m, w, make(chan bool), false)
+	if err != nil {
+		return err
+	}
+	r.Runner = newRunner
+	return nil
+}
+
+func recoveryMessages(info *gauge_messages.ExecutionInfo, replayHooks bool) []*gauge_messages.Message {
+	if !replayHooks {
+		return []*gauge_messages.Message{
+			{MessageType: gauge_messages.Message_SuiteDataStoreInit, SuiteDataStoreInitRequest: &gauge_messages.SuiteDataStoreInitRequest{}},
+			{MessageType: gauge_messages.Message_SpecDataStoreInit, SpecDataStoreInitRequest: &gauge_messages.SpecDataStoreInitRequest{}},
+			{MessageType: gauge_messages.Message_ScenarioDataStoreInit, ScenarioDataStoreInitRequest: &gauge_messages.ScenarioDataStoreInitRequest{}},
+		}
+	}
+	return []*gauge_messages.Message{
+		{MessageType: gauge_messages.Message_SuiteDataStoreInit, SuiteDataStoreInitRequest: &gauge_messages.SuiteDataStoreInitRequest{}},
+		{MessageType: gauge_messages.Message_ExecutionStarting, ExecutionStartingRequest: &gauge_messages.ExecutionStartingRequest{}},
+		{MessageType: gauge_messages.Message_SpecDataStoreInit, SpecDataStoreInitRequest: &gauge_messages.SpecDataStoreInitRequest{}},
+		{MessageType: gauge_messages.Message_SpecExecutionStarting, SpecExecutionStartingRequest: &gauge_messages.SpecExecutionStartingRequest{CurrentExecutionInfo: info}},
+		{MessageType: gauge_messages.Message_ScenarioDataStoreInit, ScenarioDataStoreInitRequest: &gauge_messages.ScenarioDataStoreInitRequest{}},
+		{MessageType: gauge_messages.Message_ScenarioExecutionStarting, ScenarioExecutionStartingRequest: &gauge_messages.ScenarioExecutionStartingRequest{CurrentExecutionInfo: info}},
+	}
+}
+
+// startSpec clears the restart of the runner in the previous spec, so that the scenarios of the new spec are executed.
+func startSpec(r runner.Runner) {
+	if rr, ok := r.(*recoverableRunner); ok {
+		rr.restartedInSpec = false
+	}
+}
+
+// restartedInSpec tells if the runner was restarted in the current spec without replaying the hooks.
+func restartedInSpec(r runner.Runner) bool {
+	rr, ok := r.(*recoverableRunner)
+	return ok && rr.restartedInSpec
+}
+
+// timedRunner fails the messages sent to the runner once the deadline of the scenario is reached, so that hooks are
+// bounded by the timeout of the scenario like its steps.
+type timedRunner struct {
+	runner.Runner
+	timeout  time.Duration
+	deadline time.Time
+	info     *gauge_messages.ExecutionInfo
+}
+
+func (r *timedRunner) ExecuteAndGetStatus(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+	return executeWithDeadline(r.Runner, m, r.timeout, r.deadline, r.info)
+}
+
+// executeWithDeadline fails the message once the deadline is reached, the runner is then restarted as it might still
+// be busy executing it. The runner is killed before it is replaced, which ends the pending request.
+func executeWithDeadline(r runner.Runner, m *gauge_messages.Message, timeout time.Duration, deadline time.Time, info *gauge_messages.ExecutionInfo) *gauge_messages.ProtoExecutionResult {
+	rr, ok := r.(*recoverableRunner)
+	if deadline.IsZero() || !ok {
+		return r.ExecuteAndGetStatus(m)
+	}
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return timeoutResult(m, timeout)
+	}
+	current := rr.Runner
+	res := make(chan *gauge_messages.ProtoExecutionResult, 1)
+	go func() {
+		res <- current.ExecuteAndGetStatus(m)
+	}()
+	select {
+	case r := <-res:
+		return r
+	case <-time.After(remaining):
+	}
+	logger.Errorf(true, "%s timed out after %s. Restarting runner.", timedOutMessage(m), timeout)
+	if err := rr.restart(info); err != nil {
+		logger.Errorf(true, "Failed to restart runner. %s", err.Error())
+	}
+	return timeoutResult(m, timeout)
+}
+
+func timedOutMessage(m *gauge_messages.Message) string {
+	switch m.GetMessageType() {
+	case gauge_messages.Message_ExecuteStep:
+		return fmt.Sprintf("Step '%s'", m.GetExecuteStepRequest().GetActualStepText())
+	case gauge_messages.Message_ScenarioExecutionStarting:
+		return "Before scenario hook"
+	case gauge_messages.Message_ScenarioExecutionEnding:
+		return "After scenario hook"
+	case gauge_messages.Message_StepExecutionStarting:
+		return "Before step hook"
+	case gauge_messages.Message_StepExecutionEnding:
+		return "After step hook"
+	}
+	return m.GetMessageType().String()
+}
+
+func timeoutResult(m *gauge_messages.Message, timeout time.Duration) *gauge_messages.ProtoExecutionResult {
+	what := "Step execution"
+	if m.GetMessageType() != gauge_messages.Message_ExecuteStep {
+		what = timedOutMessage(m)
+	}
+	return &gauge_messages.ProtoExecutionResult{
+		Failed:           true,
+		ErrorMessage:     fmt.Sprintf("%s timed out. Scenario exceeded its timeout of %s.", what, timeout),
+		RecoverableError: false,
+	}
+}
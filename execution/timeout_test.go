@@ -0,0 +1,185 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+)
+
+func TestScenarioTimeoutPrefersScenarioTag(t *testing.T) {
+	got := scenarioTimeout([]string{"timeout:1m"}, []string{"smoke", "timeout:30s"})
+
+	if got != 30*time.Second {
+		t.Errorf("Expected timeout of 30s, got %s", got)
+	}
+}
+
+func TestScenarioTimeoutFallsBackToSpecTag(t *testing.T) {
+	got := scenarioTimeout([]string{"Timeout: 1m"}, []string{"smoke"})
+
+	if got != time.Minute {
+		t.Errorf("Expected timeout of 1m, got %s", got)
+	}
+}
+
+func TestScenarioTimeoutFallsBackToFlagAndEnv(t *testing.T) {
+	old := env.ScenarioTimeout
+	defer func() {
+		env.ScenarioTimeout = old
+		ScenarioTimeout = 0
+	}()
+	env.ScenarioTimeout = func() time.Duration { return 2 * time.Second }
+
+	if got := scenarioTimeout([]string{"timeout:abc"}, nil); got != 2*time.Second {
+		t.Errorf("Expected timeout from env of 2s, got %s", got)
+	}
+
+	ScenarioTimeout = 5 * time.Second
+	if got := scenarioTimeout(nil, nil); got != 5*time.Second {
+		t.Errorf("Expected timeout from flag of 5s, got %s", got)
+	}
+}
+
+func TestStepExecutionFailsOnceScenarioDeadlineIsReached(t *testing.T) {
+	executed := false
+	r := &mockRunner{ExecuteAndGetStatusFunc: func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		executed = true
+		return &gauge_messages.ProtoExecutionResult{}
+	}}
+	se := &stepExecutor{runner: newRecoverableRunner(r, 0), timeout: time.Second, deadline: time.Now().Add(-time.Millisecond)}
+
+	res := se.executeAndGetStatus(&gauge_messages.Message{MessageType: gauge_messages.Message_ExecuteStep})
+
+	if executed {
+		t.Errorf("Expected step not to be sent to runner after deadline")
+	}
+	if !res.GetFailed() || res.GetRecoverableError() {
+		t.Errorf("Expected step to fail with an unrecoverable error")
+	}
+	if res.GetErrorMessage() != "Step execution timed out. Scenario exceeded its timeout of 1s." {
+		t.Errorf("Unexpected error message: %s", res.GetErrorMessage())
+	}
+}
+
+func TestStepExecutionWithinScenarioDeadline(t *testing.T) {
+	r := &mockRunner{ExecuteAndGetStatusFunc: func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		return &gauge_messages.ProtoExecutionResult{ExecutionTime: 1}
+	}}
+	se := &stepExecutor{runner: newRecoverableRunner(r, 0), timeout: time.Minute, deadline: time.Now().Add(time.Minute)}
+
+	res := se.executeAndGetStatus(&gauge_messages.Message{MessageType: gauge_messages.Message_ExecuteStep})
+
+	if res.GetFailed() || res.GetExecutionTime() != 1 {
+		t.Errorf("Expected result from runner, got %v", res)
+	}
+}
+
+func TestRecoveryMessagesOnlyInitializeDataStoresUnlessHooksAreReplayed(t *testing.T) {
+	got := recoveryMessages(&gauge_messages.ExecutionInfo{}, false)
+
+	want := []gauge_messages.Message_MessageType{gauge_messages.Message_SuiteDataStoreInit, gauge_messages.Message_SpecDataStoreInit, gauge_messages.Message_ScenarioDataStoreInit}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d messages, got %d", len(want), len(got))
+	}
+	for i, m := range got {
+		if m.GetMessageType() != want[i] {
+			t.Errorf("Expected message %d to be %s, got %s", i, want[i], m.GetMessageType())
+		}
+	}
+
+	if got := recoveryMessages(&gauge_messages.ExecutionInfo{}, true); len(got) != 6 {
+		t.Errorf("Expected the hooks to be replayed, got %d messages", len(got))
+	}
+}
+
+func TestScenarioIsSkippedOnceRunnerIsRestartedInSpec(t *testing.T) {
+	executed := false
+	r := newRecoverableRunner(&mockRunner{ExecuteAndGetStatusFunc: func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		executed = true
+		return &gauge_messages.ProtoExecutionResult{}
+	}}, 0)
+	r.(*recoverableRunner).restartedInSpec = true
+	h := &mockPluginHandler{NotifyPluginsfunc: func(m *gauge_messages.Message) {}, GracefullyKillPluginsfunc: func() {}}
+	scenario := &gauge.Scenario{Heading: &gauge.Heading{Value: "A scenario"}, Span: &gauge.Span{Start: 2, End: 10}}
+	errMap := &gauge.BuildErrors{ScenarioErrs: make(map[*gauge.Scenario][]error), SpecErrs: make(map[*gauge.Specification][]error), StepErrs: make(map[*gauge.Step]error)}
+	sce := newScenarioExecutor(r, h, &gauge_messages.ExecutionInfo{}, errMap, nil, nil, 0)
+	scenarioResult := result.NewScenarioResult(gauge.NewProtoScenario(scenario))
+
+	sce.execute(scenario, scenarioResult)
+
+	if executed || !scenarioResult.ProtoScenario.GetSkipped() {
+		t.Errorf("Expected scenario to be skipped after a restart of the runner")
+	}
+	if len(errMap.ScenarioErrs) != 0 {
+		t.Errorf("Expected the error map shared by the streams to be unchanged, got %v", errMap.ScenarioErrs)
+	}
+	startSpec(r)
+	if restartedInSpec(r) {
+		t.Errorf("Expected the restart to be cleared at the start of the next spec")
+	}
+}
+
+func TestHookFailsOnceScenarioDeadlineIsReached(t *testing.T) {
+	unblock := make(chan bool)
+	defer close(unblock)
+	r := newRecoverableRunner(&mockRunner{ExecuteAndGetStatusFunc: func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		<-unblock
+		return &gauge_messages.ProtoExecutionResult{}
+	}}, 0)
+	hr := &timedRunner{Runner: r, timeout: 10 * time.Millisecond, deadline: time.Now().Add(10 * time.Millisecond)}
+
+	res := hr.ExecuteAndGetStatus(&gauge_messages.Message{MessageType: gauge_messages.Message_ScenarioExecutionEnding})
+
+	if !res.GetFailed() {
+		t.Fatalf("Expected hook to fail once the deadline is reached")
+	}
+	if res.GetErrorMessage() != "After scenario hook timed out. Scenario exceeded its timeout of 10ms." {
+		t.Errorf("Unexpected error message: %s", res.GetErrorMessage())
+	}
+}
+
+func TestTeardownsAndAfterScenarioHookShareOneTimeout(t *testing.T) {
+	defer func() { ScenarioTimeout = 0 }()
+	ScenarioTimeout = 100 * time.Millisecond
+	event.InitRegistry()
+	r := newRecoverableRunner(&mockRunner{ExecuteAndGetStatusFunc: func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		if m.MessageType == gauge_messages.Message_ExecuteStep || m.MessageType == gauge_messages.Message_ScenarioExecutionEnding {
+			time.Sleep(70 * time.Millisecond)
+		}
+		return &gauge_messages.ProtoExecutionResult{}
+	}}, 0)
+	h := &mockPluginHandler{NotifyPluginsfunc: func(m *gauge_messages.Message) {}, GracefullyKillPluginsfunc: func() {}}
+	teardowns := []*gauge.Step{newStep("tear down")}
+	sce := newScenarioExecutor(r, h, &gauge_messages.ExecutionInfo{CurrentSpec: &gauge_messages.SpecInfo{}, CurrentScenario: &gauge_messages.ScenarioInfo{}}, gauge.NewBuildErrors(), nil, teardowns, 0)
+	scenario := &gauge.Scenario{Heading: &gauge.Heading{Value: "A scenario"}, Span: &gauge.Span{Start: 2, End: 10}}
+	scenarioResult := result.NewScenarioResult(gauge.NewProtoScenario(scenario))
+	scenarioResult.ProtoScenario.TearDownSteps = protoItemsOf(teardowns)
+
+	sce.execute(scenario, scenarioResult)
+
+	if msg := scenarioResult.ProtoScenario.GetPostHookFailure().GetErrorMessage(); msg != "After scenario hook timed out. Scenario exceeded its timeout of 100ms." {
+		t.Errorf("Expected the after scenario hook to time out in the time left by the teardowns, got %q", msg)
+	}
+}
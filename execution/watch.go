@@ -0,0 +1,314 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution
+
+import (
+	"errors"
+	"os"
+	"os/signal"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/api"
+	"github.com/getgauge/gauge/api/infoGatherer"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/reporter"
+	"github.com/getgauge/gauge/runner"
+	"github.com/getgauge/gauge/skel"
+	"github.com/getgauge/gauge/util"
+	"github.com/getgauge/gauge/validation"
+)
+
+// Watch if true keeps the runner alive after execution and re-executes the specs affected by changes to the project files.
+var Watch bool
+
+// watchDebounce is the time to wait for more file changes before executing the affected specs
+var watchDebounce = 500 * time.Millisecond
+
+type specWatcher struct {
+	specDirs []string
+	runner   *recoverableRunner
+	// concepts holds the concepts parsed before the latest changes, to find the specs using removed concepts
+	concepts *gauge.ConceptDictionary
+	// implementationFiles holds the glob patterns of the implementation files of the runner
+	implementationFiles []string
+	changes             chan string
+}
+
+// WatchSpecs executes the specs and re-executes the affected specs whenever spec, concept or implementation files change.
+// A changed spec is executed again, along with the specs using the concepts of a changed concept file
+// or the steps implemented in a changed implementation file. It doesn't return till gauge is interrupted.
+func WatchSpecs(specDirs []string) {
+	if err := validateFlags(); err != nil {
+		logger.Fatal(true, err.Error())
+	}
+	skel.SetupPlugins(MachineReadable)
+	w, err := newSpecWatcher(specDirs)
+	if err != nil {
+		logger.Fatalf(true, "Failed to start runner. %s", err.Error())
+	}
+	w.killRunnerOnInterrupt()
+	w.execute(specDirs)
+
+	watcher, err := infoGatherer.WatchDirs(util.ProjectDirs(), w.onFileEvent)
+	if err != nil {
+		logger.Fatalf(true, "Failed to watch for file changes. %s", err.Error())
+	}
+	defer watcher.Close()
+	for {
+		logger.Infof(true, "\nWatching for changes in %s. Press Ctrl+C to stop.", strings.Join(specDirs, ", "))
+		w.executeAffectedSpecs(w.nextChanges())
+	}
+}
+
+func newSpecWatcher(specDirs []string) (*specWatcher, error) {
+	sc := api.StartAPI(false, reporter.Current())
+	select {
+	case r := <-sc.RunnerChan:
+		concepts, _, err := parser.CreateConceptsDictionary()
+		if err != nil {
+			concepts = gauge.NewConceptDictionary()
+		}
+		w := &specWatcher{specDirs: specDirs, runner: &recoverableRunner{Runner: r}, concepts: concepts, changes: make(chan string)}
+		w.implementationFiles = implementationFilePatterns(w.runner)
+		return w, nil
+	case err := <-sc.ErrorChan:
+		return nil, err
+	}
+}
+
+func (w *specWatcher) killRunnerOnInterrupt() {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt)
+	go func() {
+		<-c
+		w.runner.Kill()
+		os.Exit(ExecutionFailed)
+	}()
+}
+
+func (w *specWatcher) execute(args []string) int {
+	res := validation.ValidateSpecsWithRunner(args, w.runner)
+	return executeValidatedSpecs(res, args, true, newCheckpoint())
+}
+
+func (w *specWatcher) onFileEvent(event fsnotify.Event, watcher *fsnotify.Watcher) {
+	file, err := filepath.Abs(event.Name)
+	if err != nil || strings.HasPrefix(filepath.Base(file), ".") || strings.HasSuffix(file, "~") {
+		return
+	}
+	if util.IsDir(file) {
+		if event.Op&fsnotify.Create != 0 {
+			for _, dir := range append([]string{file}, util.FindAllNestedDirs(file)...) {
+				watcher.Add(dir)
+			}
+		}
+		return
+	}
+	if util.IsSpec(file) || util.IsConcept(file) || w.isImplementationFile(file) {
+		w.changes <- file
+	}
+}
+
+// implementationFilePatterns asks the runner for the glob patterns of its implementation files.
+func implementationFilePatterns(r runner.Runner) []string {
+	m := &gauge_messages.Message{MessageType: gauge_messages.Message_ImplementationFileGlobPatternRequest,
+		ImplementationFileGlobPatternRequest: &gauge_messages.ImplementationFileGlobPatternRequest{}}
+	res, err := r.ExecuteMessageWithTimeout(m)
+	if err != nil || len(res.GetImplementationFileGlobPatternResponse().GetGlobPatterns()) < 1 {
+		logger.Warningf(true, "The runner did not tell its implementation files, changes to any file other than specs and concepts restart it.")
+		return nil
+	}
+	return res.GetImplementationFileGlobPatternResponse().GetGlobPatterns()
+}
+
+func (w *specWatcher) isImplementationFile(file string) bool {
+	if len(w.implementationFiles) < 1 {
+		return true
+	}
+	for _, pattern := range w.implementationFiles {
+		if matchesGlob(pattern, file) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesGlob tells if the file matches the glob pattern, in which ** matches any number of directories.
+// Relative patterns are relative to the project root.
+func matchesGlob(pattern, file string) bool {
+	if !filepath.IsAbs(pattern) {
+		pattern = filepath.Join(config.ProjectRoot, pattern)
+	}
+	return matchSegments(strings.Split(filepath.ToSlash(pattern), "/"), strings.Split(filepath.ToSlash(file), "/"))
+}
+
+func matchSegments(pattern, segments []string) bool {
+	if len(pattern) == 0 {
+		return len(segments) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(segments); i++ {
+			if matchSegments(pattern[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(segments) == 0 {
+		return false
+	}
+	ok, err := path.Match(pattern[0], segments[0])
+	return err == nil && ok && matchSegments(pattern[1:], segments[1:])
+}
+
+// nextChanges waits for a file change and returns it along with the files changed after it, till no file
+// changes for watchDebounce.
+func (w *specWatcher) nextChanges() []string {
+	changed := map[string]bool{<-w.changes: true}
+	for {
+		select {
+		case file := <-w.changes:
+			changed[file] = true
+		case <-time.After(watchDebounce):
+			var files []string
+			for file := range changed {
+				files = append(files, file)
+			}
+			sort.Strings(files)
+			return files
+		}
+	}
+}
+
+func (w *specWatcher) executeAffectedSpecs(files []string) {
+	concepts, _, err := parser.ParseConcepts()
+	if err != nil {
+		logger.Errorf(true, "Failed to parse concepts. %s", err.Error())
+		return
+	}
+	var specFiles, implementationFiles []string
+	stepValues := make(map[string]bool)
+	for _, file := range files {
+		switch {
+		case util.IsSpec(file):
+			if common.FileExists(file) {
+				specFiles = append(specFiles, file)
+			}
+		case util.IsConcept(file):
+			addConceptValues(stepValues, w.concepts, file)
+			addConceptValues(stepValues, concepts, file)
+		default:
+			implementationFiles = append(implementationFiles, file)
+		}
+	}
+	runAll := false
+	if len(implementationFiles) > 0 {
+		if err := w.runner.replace(); err != nil {
+			logger.Fatalf(true, "Failed to restart runner. %s", err.Error())
+		}
+		runAll = addImplementedStepValues(stepValues, implementationFiles, w.runner) != nil
+	}
+	w.concepts = concepts
+	if runAll {
+		logger.Infof(true, "Executing all specifications as the steps of the changed implementation files are unknown.")
+		w.execute(w.specDirs)
+		return
+	}
+	specs, _ := parser.ParseSpecFiles(util.GetSpecFiles(w.specDirs), concepts, gauge.NewBuildErrors())
+	affected := affectedSpecFiles(specFiles, specs, stepValues)
+	if len(affected) < 1 {
+		logger.Infof(true, "No specifications are affected by the changes.")
+		return
+	}
+	w.execute(affected)
+}
+
+// addImplementedStepValues adds the steps implemented in the given files, as known to the runner.
+func addImplementedStepValues(stepValues map[string]bool, files []string, r runner.Runner) error {
+	for _, file := range files {
+		m := &gauge_messages.Message{MessageType: gauge_messages.Message_StepPositionsRequest,
+			StepPositionsRequest: &gauge_messages.StepPositionsRequest{FilePath: file}}
+		res, err := r.ExecuteMessageWithTimeout(m)
+		if err != nil {
+			return err
+		}
+		if res.GetStepPositionsResponse().GetError() != "" {
+			return errors.New(res.GetStepPositionsResponse().GetError())
+		}
+		for _, p := range res.GetStepPositionsResponse().GetStepPositions() {
+			stepValues[p.GetStepValue()] = true
+		}
+	}
+	return nil
+}
+
+func addConceptValues(stepValues map[string]bool, concepts *gauge.ConceptDictionary, file string) {
+	for value, c := range concepts.ConceptsMap {
+		if c.FileName == file {
+			stepValues[value] = true
+		}
+	}
+}
+
+// affectedSpecFiles returns the changed spec files along with the files of specs using any of the given steps or concepts.
+func affectedSpecFiles(changedSpecFiles []string, specs []*gauge.Specification, stepValues map[string]bool) []string {
+	affected := make(map[string]bool)
+	for _, f := range changedSpecFiles {
+		affected[f] = true
+	}
+	for _, spec := range specs {
+		if !affected[spec.FileName] && usesAnyStep(spec, stepValues) {
+			affected[spec.FileName] = true
+		}
+	}
+	var files []string
+	for f := range affected {
+		files = append(files, f)
+	}
+	sort.Strings(files)
+	return files
+}
+
+func usesAnyStep(spec *gauge.Specification, stepValues map[string]bool) bool {
+	var steps []*gauge.Step
+	steps = append(steps, spec.Contexts...)
+	steps = append(steps, spec.TearDownSteps...)
+	for _, scn := range spec.Scenarios {
+		steps = append(steps, scn.Steps...)
+	}
+	return containsAnyStep(steps, stepValues)
+}
+
+func containsAnyStep(steps []*gauge.Step, stepValues map[string]bool) bool {
+	for _, step := range steps {
+		if stepValues[step.Value] || (step.IsConcept && containsAnyStep(step.ConceptSteps, stepValues)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,116 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+)
+
+func TestAffectedSpecFilesIncludesSpecsUsingChangedSteps(t *testing.T) {
+	concept := &gauge.Step{Value: "login as {}", IsConcept: true, ConceptSteps: []*gauge.Step{{Value: "open browser"}}}
+	specs := []*gauge.Specification{
+		{FileName: "a.spec", Scenarios: []*gauge.Scenario{{Steps: []*gauge.Step{{Value: "say hello"}}}}},
+		{FileName: "b.spec", Scenarios: []*gauge.Scenario{{Steps: []*gauge.Step{concept}}}},
+		{FileName: "c.spec", Contexts: []*gauge.Step{{Value: "say hello"}}},
+		{FileName: "d.spec", TearDownSteps: []*gauge.Step{{Value: "open browser"}}},
+	}
+
+	got := affectedSpecFiles([]string{"e.spec"}, specs, map[string]bool{"say hello": true, "login as {}": true})
+
+	want := []string{"a.spec", "b.spec", "c.spec", "e.spec"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestAffectedSpecFilesIncludesSpecsUsingStepsOfNestedConcepts(t *testing.T) {
+	concept := &gauge.Step{Value: "login", IsConcept: true, ConceptSteps: []*gauge.Step{{Value: "open browser"}}}
+	specs := []*gauge.Specification{{FileName: "a.spec", Scenarios: []*gauge.Scenario{{Steps: []*gauge.Step{concept}}}}}
+
+	got := affectedSpecFiles(nil, specs, map[string]bool{"open browser": true})
+
+	if !reflect.DeepEqual(got, []string{"a.spec"}) {
+		t.Errorf("Expected [a.spec], got %v", got)
+	}
+}
+
+func TestAddConceptValuesOfFile(t *testing.T) {
+	dict := gauge.NewConceptDictionary()
+	dict.ConceptsMap["login as {}"] = &gauge.Concept{ConceptStep: &gauge.Step{Value: "login as {}"}, FileName: "a.cpt"}
+	dict.ConceptsMap["logout"] = &gauge.Concept{ConceptStep: &gauge.Step{Value: "logout"}, FileName: "b.cpt"}
+	values := make(map[string]bool)
+
+	addConceptValues(values, dict, "a.cpt")
+
+	if !reflect.DeepEqual(values, map[string]bool{"login as {}": true}) {
+		t.Errorf("Expected concepts of a.cpt, got %v", values)
+	}
+}
+
+func TestNextChangesWaitsForFileChangesToSettle(t *testing.T) {
+	old := watchDebounce
+	watchDebounce = 10 * time.Millisecond
+	defer func() { watchDebounce = old }()
+	w := &specWatcher{changes: make(chan string)}
+	go func() {
+		for _, f := range []string{"b.spec", "a.cpt", "b.spec"} {
+			w.changes <- f
+		}
+	}()
+
+	got := w.nextChanges()
+
+	if !reflect.DeepEqual(got, []string{"a.cpt", "b.spec"}) {
+		t.Errorf("Expected [a.cpt b.spec], got %v", got)
+	}
+}
+
+func TestIsImplementationFileMatchesGlobPatternsOfRunner(t *testing.T) {
+	old := config.ProjectRoot
+	config.ProjectRoot = filepath.Join(string(filepath.Separator), "project")
+	defer func() { config.ProjectRoot = old }()
+	w := &specWatcher{implementationFiles: []string{"tests/**/*.js", filepath.Join(config.ProjectRoot, "src", "*.ts")}}
+
+	for file, want := range map[string]bool{
+		filepath.Join(config.ProjectRoot, "tests", "step_implementation.js"):      true,
+		filepath.Join(config.ProjectRoot, "tests", "login", "steps.js"):           true,
+		filepath.Join(config.ProjectRoot, "src", "helpers.ts"):                    true,
+		filepath.Join(config.ProjectRoot, "src", "lib", "helpers.ts"):             false,
+		filepath.Join(config.ProjectRoot, "README.md"):                            false,
+		filepath.Join(config.ProjectRoot, "node_modules", "gauge-ts", "index.js"): false,
+		filepath.Join(config.ProjectRoot, "tests", "step_implementation.js.orig"): false,
+	} {
+		if got := w.isImplementationFile(file); got != want {
+			t.Errorf("Expected isImplementationFile(%s) to be %v", file, want)
+		}
+	}
+}
+
+func TestIsImplementationFileWithoutGlobPatterns(t *testing.T) {
+	w := &specWatcher{}
+
+	if !w.isImplementationFile("README.md") {
+		t.Error("Expected every file to be an implementation file when the runner does not tell its implementation files")
+	}
+}
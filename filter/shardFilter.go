@@ -0,0 +1,177 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package filter
+
+import (
+	"fmt"
+	"hash/fnv"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/util"
+)
+
+// Shard selects the part of the scenarios to execute, in the form i/N. All scenarios are executed if empty.
+var Shard string
+
+// ShardExecutionTimes holds the execution times of scenarios keyed by ScenarioKey. Scenarios are partitioned
+// by hashing their key if empty, otherwise such that the expected execution time of every shard is as even as possible.
+var ShardExecutionTimes map[string]int64
+
+type shardFilter struct {
+	shard     string
+	execTimes map[string]int64
+}
+
+type scenarioFilterBasedOnShard struct {
+	scenarios map[*gauge.Scenario]bool
+}
+
+// shardScenario is a scenario along with the key used to assign it to a shard.
+type shardScenario struct {
+	key      string
+	scenario *gauge.Scenario
+}
+
+// ParseShard parses a shard of the form i/N, where 1 <= i <= N.
+func ParseShard(shard string) (index int, count int, err error) {
+	parts := strings.Split(shard, "/")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("shard should be of the form i/N, got %s", shard)
+	}
+	if index, err = strconv.Atoi(strings.TrimSpace(parts[0])); err != nil {
+		return 0, 0, fmt.Errorf("invalid shard index %s", parts[0])
+	}
+	if count, err = strconv.Atoi(strings.TrimSpace(parts[1])); err != nil {
+		return 0, 0, fmt.Errorf("invalid shard count %s", parts[1])
+	}
+	if count < 1 || index < 1 || index > count {
+		return 0, 0, fmt.Errorf("shard index should be between 1 and %d, got %d", count, index)
+	}
+	return index, count, nil
+}
+
+// ScenarioKey identifies a scenario across machines by the spec file path relative to the project root and the scenario heading.
+func ScenarioKey(specFile, scenarioHeading string) string {
+	return fmt.Sprintf("%s:%s", filepath.ToSlash(util.RelPathToProjectRoot(specFile)), scenarioHeading)
+}
+
+func (sf *shardFilter) filter(specs []*gauge.Specification) []*gauge.Specification {
+	if sf.shard == "" {
+		return specs
+	}
+	index, count, err := ParseShard(sf.shard)
+	if err != nil {
+		return make([]*gauge.Specification, 0)
+	}
+	var scenarios []*gauge.Scenario
+	if len(sf.execTimes) > 0 {
+		scenarios = DistributeScenariosByExecutionTime(specs, count, sf.execTimes)[index-1]
+	} else {
+		scenarios = DistributeScenariosByHash(specs, count)[index-1]
+	}
+	inShard := make(map[*gauge.Scenario]bool)
+	for _, s := range scenarios {
+		inShard[s] = true
+	}
+	filteredSpecs := make([]*gauge.Specification, 0)
+	for _, spec := range specs {
+		s, _ := spec.Filter(&scenarioFilterBasedOnShard{inShard})
+		if len(s.Scenarios) != 0 {
+			filteredSpecs = append(filteredSpecs, s)
+		}
+	}
+	return filteredSpecs
+}
+
+func (filter *scenarioFilterBasedOnShard) Filter(item gauge.Item) bool {
+	if item.Kind() != gauge.ScenarioKind {
+		return false
+	}
+	return !filter.scenarios[item.(*gauge.Scenario)]
+}
+
+func shardScenarios(specs []*gauge.Specification) []shardScenario {
+	var scenarios []shardScenario
+	for _, spec := range specs {
+		for _, s := range spec.Scenarios {
+			scenarios = append(scenarios, shardScenario{key: ScenarioKey(spec.FileName, s.Heading.Value), scenario: s})
+		}
+	}
+	return scenarios
+}
+
+// DistributeScenariosByHash assigns every scenario to one of the distributions by hashing its ScenarioKey.
+// The assignment of a scenario does not depend on the other scenarios, so it is the same on every machine.
+func DistributeScenariosByHash(specs []*gauge.Specification, distributions int) [][]*gauge.Scenario {
+	d := make([][]*gauge.Scenario, distributions)
+	for _, s := range shardScenarios(specs) {
+		h := fnv.New32a()
+		h.Write([]byte(s.key))
+		i := h.Sum32() % uint32(distributions)
+		d[i] = append(d[i], s.scenario)
+	}
+	return d
+}
+
+// DistributeScenariosByExecutionTime distributes the scenarios such that the expected execution time of every distribution
+// is as even as possible. Expected time of a scenario is looked up in execTimes by its ScenarioKey, scenarios without a
+// recorded time are expected to take the average of the recorded times. Ties are broken by the key and line number of the
+// scenario, so the distribution is the same on every machine given the same execTimes.
+func DistributeScenariosByExecutionTime(specs []*gauge.Specification, distributions int, execTimes map[string]int64) [][]*gauge.Scenario {
+	d := make([][]*gauge.Scenario, distributions)
+	var total int64
+	for _, t := range execTimes {
+		total += t
+	}
+	var average int64
+	if len(execTimes) > 0 {
+		average = total / int64(len(execTimes))
+	}
+	scenarios := shardScenarios(specs)
+	estimate := func(s shardScenario) int64 {
+		if t, ok := execTimes[s.key]; ok {
+			return t
+		}
+		return average
+	}
+	sort.SliceStable(scenarios, func(i, j int) bool {
+		ti, tj := estimate(scenarios[i]), estimate(scenarios[j])
+		if ti != tj {
+			return ti > tj
+		}
+		if scenarios[i].key != scenarios[j].key {
+			return scenarios[i].key < scenarios[j].key
+		}
+		return scenarios[i].scenario.Heading.LineNo < scenarios[j].scenario.Heading.LineNo
+	})
+	load := make([]int64, distributions)
+	for _, s := range scenarios {
+		min := 0
+		for i := 1; i < distributions; i++ {
+			if load[i] < load[min] || (load[i] == load[min] && len(d[i]) < len(d[min])) {
+				min = i
+			}
+		}
+		load[min] += estimate(s)
+		d[min] = append(d[min], s.scenario)
+	}
+	return d
+}
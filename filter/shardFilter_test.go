@@ -0,0 +1,128 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package filter
+
+import (
+	"fmt"
+
+	"github.com/getgauge/gauge/gauge"
+	. "gopkg.in/check.v1"
+)
+
+func createSpecsWithScenarios(specs, scenariosPerSpec int) []*gauge.Specification {
+	var specList []*gauge.Specification
+	for i := 0; i < specs; i++ {
+		spec := &gauge.Specification{FileName: fmt.Sprintf("spec%d", i)}
+		for j := 0; j < scenariosPerSpec; j++ {
+			s := &gauge.Scenario{Heading: &gauge.Heading{Value: fmt.Sprintf("scenario%d", j), LineNo: j + 1}}
+			spec.Scenarios = append(spec.Scenarios, s)
+			spec.Items = append(spec.Items, s)
+		}
+		specList = append(specList, spec)
+	}
+	return specList
+}
+
+func scenarioKeys(specs []*gauge.Specification) []string {
+	var keys []string
+	for _, spec := range specs {
+		for _, s := range spec.Scenarios {
+			keys = append(keys, ScenarioKey(spec.FileName, s.Heading.Value))
+		}
+	}
+	return keys
+}
+
+func (s *MySuite) TestParseShard(c *C) {
+	index, count, err := ParseShard("2/3")
+	c.Assert(err, IsNil)
+	c.Assert(index, Equals, 2)
+	c.Assert(count, Equals, 3)
+
+	for _, shard := range []string{"3", "0/3", "4/3", "a/3", "1/b", "1/0"} {
+		_, _, err = ParseShard(shard)
+		c.Assert(err, NotNil, Commentf("shard %s", shard))
+	}
+}
+
+func (s *MySuite) TestShardFilterPartitionsAllScenarios(c *C) {
+	seen := make(map[string]int)
+	for i := 1; i <= 3; i++ {
+		specs := (&shardFilter{shard: fmt.Sprintf("%d/3", i)}).filter(createSpecsWithScenarios(5, 4))
+		for _, key := range scenarioKeys(specs) {
+			seen[key]++
+		}
+		for _, spec := range specs {
+			c.Assert(len(spec.Scenarios), Not(Equals), 0)
+		}
+	}
+
+	c.Assert(len(seen), Equals, 20)
+	for key, n := range seen {
+		c.Assert(n, Equals, 1, Commentf("scenario %s", key))
+	}
+}
+
+func (s *MySuite) TestShardFilterIsDeterministic(c *C) {
+	first := scenarioKeys((&shardFilter{shard: "2/4"}).filter(createSpecsWithScenarios(5, 4)))
+	second := scenarioKeys((&shardFilter{shard: "2/4"}).filter(createSpecsWithScenarios(5, 4)))
+
+	c.Assert(second, DeepEquals, first)
+}
+
+func (s *MySuite) TestShardOfScenarioDoesNotDependOnOtherScenarios(c *C) {
+	all := DistributeScenariosByHash(createSpecsWithScenarios(5, 4), 3)
+	some := DistributeScenariosByHash(createSpecsWithScenarios(2, 4), 3)
+
+	for i := range some {
+		for _, s := range some[i] {
+			found := false
+			for _, o := range all[i] {
+				found = found || o.Heading.Value == s.Heading.Value
+			}
+			c.Assert(found, Equals, true)
+		}
+	}
+}
+
+func (s *MySuite) TestDistributionOfScenariosByExecutionTime(c *C) {
+	specs := createSpecsWithScenarios(1, 5)
+	execTimes := map[string]int64{"spec0:scenario0": 100, "spec0:scenario1": 10, "spec0:scenario2": 10, "spec0:scenario3": 60, "spec0:scenario4": 20}
+
+	d := DistributeScenariosByExecutionTime(specs, 2, execTimes)
+
+	headings := func(scenarios []*gauge.Scenario) []string {
+		var h []string
+		for _, s := range scenarios {
+			h = append(h, s.Heading.Value)
+		}
+		return h
+	}
+	c.Assert(headings(d[0]), DeepEquals, []string{"scenario0"})
+	c.Assert(headings(d[1]), DeepEquals, []string{"scenario3", "scenario4", "scenario1", "scenario2"})
+}
+
+func (s *MySuite) TestDistributionOfScenariosByExecutionTimeUsesAverageForUnknownScenarios(c *C) {
+	specs := createSpecsWithScenarios(1, 3)
+	execTimes := map[string]int64{"spec0:scenario0": 100, "spec0:scenario1": 20}
+
+	d := DistributeScenariosByExecutionTime(specs, 2, execTimes)
+
+	c.Assert(len(d[0]), Equals, 1)
+	c.Assert(d[0][0].Heading.Value, Equals, "scenario0")
+	c.Assert(len(d[1]), Equals, 2)
+}
@@ -18,16 +18,8 @@
 package filter
 
 import (
-	"errors"
-	"go/constant"
-	"go/token"
-	"go/types"
-	"regexp"
-	"strconv"
 	"strings"
 
-	"fmt"
-
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
 )
@@ -37,7 +29,7 @@ type scenarioFilterBasedOnSpan struct {
 }
 type ScenarioFilterBasedOnTags struct {
 	specTags      []string
-	tagExpression string
+	tagExpression *TagExpression
 }
 
 type scenarioFilterBasedOnName struct {
@@ -60,7 +52,7 @@ func (filter *scenarioFilterBasedOnSpan) Filter(item gauge.Item) bool {
 	return true
 }
 
-func NewScenarioFilterBasedOnTags(specTags []string, tagExp string) *ScenarioFilterBasedOnTags {
+func NewScenarioFilterBasedOnTags(specTags []string, tagExp *TagExpression) *ScenarioFilterBasedOnTags {
 	return &ScenarioFilterBasedOnTags{specTags, tagExp}
 }
 
@@ -86,128 +78,11 @@ func (filter *scenarioFilterBasedOnName) Filter(item gauge.Item) bool {
 	return !item.(*gauge.Scenario).HasAnyHeading(filter.scenariosName)
 }
 
-func sanitize(tag string) string {
-	if _, err := strconv.ParseBool(tag); err == nil {
-		return fmt.Sprintf("{%s}", tag)
-	}
-	return tag
-}
-
 func (filter *ScenarioFilterBasedOnTags) filterTags(stags []string) bool {
-	tagsMap := make(map[string]bool, 0)
-	for _, tag := range stags {
-		tag = sanitize(tag)
-		tagsMap[strings.Replace(tag, " ", "", -1)] = true
-	}
-	filter.replaceSpecialChar()
-	value, _ := filter.formatAndEvaluateExpression(tagsMap, filter.isTagPresent)
-	return value
-}
-
-func (filter *ScenarioFilterBasedOnTags) replaceSpecialChar() {
-	filter.tagExpression = strings.Replace(strings.Replace(strings.Replace(strings.Replace(filter.tagExpression, " ", "", -1), ",", "&", -1), "&&", "&", -1), "||", "|", -1)
-}
-
-func (filter *ScenarioFilterBasedOnTags) formatAndEvaluateExpression(tagsMap map[string]bool, isTagQualified func(tagsMap map[string]bool, tagName string) bool) (bool, error) {
-	tagExpressionParts, tags := filter.parseTagExpression()
-	for _, tag := range tags {
-		for i, txp := range tagExpressionParts {
-			if strings.TrimSpace(txp) == strings.TrimSpace(tag) {
-				tagExpressionParts[i] = strconv.FormatBool(isTagQualified(tagsMap, strings.TrimSpace(tag)))
-			}
-		}
-	}
-	return filter.evaluateExp(filter.handleNegation(strings.Join(tagExpressionParts, "")))
-}
-
-func (filter *ScenarioFilterBasedOnTags) handleNegation(tagExpression string) string {
-	tagExpression = strings.Replace(strings.Replace(tagExpression, "!true", "false", -1), "!false", "true", -1)
-	for strings.Contains(tagExpression, "!(") {
-		tagExpression = filter.evaluateBrackets(tagExpression)
-	}
-	return tagExpression
-}
-
-func (filter *ScenarioFilterBasedOnTags) evaluateBrackets(tagExpression string) string {
-	if strings.Contains(tagExpression, "!(") {
-		innerText := filter.resolveBracketExpression(tagExpression)
-		return strings.Replace(tagExpression, "!("+innerText+")", filter.evaluateBrackets(innerText), -1)
-	}
-	value, _ := filter.evaluateExp(tagExpression)
-	return strconv.FormatBool(!value)
-}
-
-func (filter *ScenarioFilterBasedOnTags) resolveBracketExpression(tagExpression string) string {
-	indexOfOpenBracket := strings.Index(tagExpression, "!(") + 1
-	bracketStack := make([]string, 0)
-	i := indexOfOpenBracket
-	for ; i < len(tagExpression); i++ {
-		if tagExpression[i] == '(' {
-			bracketStack = append(bracketStack, "(")
-		} else if tagExpression[i] == ')' {
-			bracketStack = append(bracketStack[:len(bracketStack)-1])
-		}
-		if len(bracketStack) == 0 {
-			break
-		}
-	}
-	return tagExpression[indexOfOpenBracket+1 : i]
-}
-
-func (filter *ScenarioFilterBasedOnTags) evaluateExp(tagExpression string) (bool, error) {
-	tre := regexp.MustCompile("true")
-	fre := regexp.MustCompile("false")
-
-	s := fre.ReplaceAllString(tre.ReplaceAllString(tagExpression, "1"), "0")
-
-	val, err := types.Eval(token.NewFileSet(), nil, 0, s)
-	if err != nil {
-		return false, errors.New("Invalid Expression.\n" + err.Error())
-	}
-	res, _ := constant.Uint64Val(val.Value)
-
-	var final bool
-	if res == 1 {
-		final = true
-	} else {
-		final = false
-	}
-
-	return final, nil
-}
-
-func (filter *ScenarioFilterBasedOnTags) isTagPresent(tagsMap map[string]bool, tagName string) bool {
-	_, ok := tagsMap[tagName]
-	return ok
-}
-
-func (filter *ScenarioFilterBasedOnTags) parseTagExpression() (tagExpressionParts []string, tags []string) {
-	isValidOperator := func(r rune) bool { return r == '&' || r == '|' || r == '(' || r == ')' || r == '!' }
-	var word string
-	var wordValue = func() string {
-		return sanitize(strings.TrimSpace(word))
-	}
-	for _, c := range filter.tagExpression {
-		c1, _ := strconv.Unquote(strconv.QuoteRuneToASCII(c))
-		if isValidOperator(c) {
-			if word != "" {
-				tagExpressionParts = append(tagExpressionParts, wordValue())
-				tags = append(tags, wordValue())
-			}
-			tagExpressionParts = append(tagExpressionParts, c1)
-			word = ""
-		} else {
-			word += c1
-		}
-	}
-	if word != "" {
-		tagExpressionParts = append(tagExpressionParts, wordValue())
-		tags = append(tags, wordValue())
-	}
-	return
+	return filter.tagExpression.evaluate(stags)
 }
 
-func filterSpecsByTags(specs []*gauge.Specification, tagExpression string) ([]*gauge.Specification, []*gauge.Specification) {
+func filterSpecsByTags(specs []*gauge.Specification, tagExpression *TagExpression) ([]*gauge.Specification, []*gauge.Specification) {
 	filteredSpecs := make([]*gauge.Specification, 0)
 	otherSpecs := make([]*gauge.Specification, 0)
 	for _, spec := range specs {
@@ -226,13 +101,12 @@ func filterSpecsByTags(specs []*gauge.Specification, tagExpression string) ([]*g
 	return filteredSpecs, otherSpecs
 }
 
-func validateTagExpression(tagExpression string) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: tagExpression}
-	filter.replaceSpecialChar()
-	_, err := filter.formatAndEvaluateExpression(make(map[string]bool, 0), func(a map[string]bool, b string) bool { return true })
+func parseTagExpressionOrExit(tagExpression string, specs []*gauge.Specification) *TagExpression {
+	exp, err := ParseTagExpression(tagExpression, specs)
 	if err != nil {
 		logger.Fatalf(true, err.Error())
 	}
+	return exp
 }
 
 func filterSpecsByScenarioName(specs []*gauge.Specification, scenariosName []string) []*gauge.Specification {
@@ -100,93 +100,78 @@ func (specBuilder *specBuilder) text(comment string) *specBuilder {
 	return specBuilder
 }
 func (s *MySuite) TestToEvaluateTagExpressionWithTwoTags(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "tag1 & tag3"}
+	filter := tagFilter(c, "tag1 & tag3")
 	c.Assert(filter.filterTags([]string{"tag1", "tag2"}), Equals, false)
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionWithComplexTagExpression(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "tag1 & ((tag3 | tag2) & (tag5 | tag4 | tag3) & tag7) | tag6"}
+	filter := tagFilter(c, "tag1 & ((tag3 | tag2) & (tag5 | tag4 | tag3) & tag7) | tag6")
 	c.Assert(filter.filterTags([]string{"tag1", "tag2", "tag7", "tag4"}), Equals, true)
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionWithFailingTagExpression(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "tag1 & ((tag3 | tag2) & (tag5 | tag4 | tag3) & tag7) & tag6"}
+	filter := tagFilter(c, "tag1 & ((tag3 | tag2) & (tag5 | tag4 | tag3) & tag7) & tag6")
 	c.Assert(filter.filterTags([]string{"tag1", "tag2", "tag7", "tag4"}), Equals, false)
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionWithWrongTagExpression(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "tag1 & ((((tag3 | tag2) & (tag5 | tag4 | tag3) & tag7) & tag6"}
-	c.Assert(filter.filterTags([]string{"tag1", "tag2", "tag7", "tag4"}), Equals, false)
+	_, err := ParseTagExpression("tag1 & ((((tag3 | tag2) & (tag5 | tag4 | tag3) & tag7) & tag6", nil)
+	c.Assert(err, NotNil)
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionConsistingOfSpaces(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "tag 1 & tag3"}
+	filter := tagFilter(c, "tag 1 & tag3")
 	c.Assert(filter.filterTags([]string{"tag 1", "tag3"}), Equals, true)
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionConsistingLogicalNotOperator(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "!tag 1 & tag3"}
+	filter := tagFilter(c, "!tag 1 & tag3")
 	c.Assert(filter.filterTags([]string{"tag2", "tag3"}), Equals, true)
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionConsistingManyLogicalNotOperator(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "!(!(tag 1 | !(tag6 | !(tag5))) & tag2)"}
+	filter := tagFilter(c, "!(!(tag 1 | !(tag6 | !(tag5))) & tag2)")
 	value := filter.filterTags([]string{"tag2", "tag4"})
-	c.Assert(value, Equals, true)
+	c.Assert(value, Equals, false)
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionConsistingParallelLogicalNotOperator(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "!(tag1) & ! (tag3 & ! (tag3))"}
+	filter := tagFilter(c, "!(tag1) & ! (tag3 & ! (tag3))")
 	value := filter.filterTags([]string{"tag2", "tag4"})
 	c.Assert(value, Equals, true)
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionConsistingComma(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "tag 1 , tag3"}
+	filter := tagFilter(c, "tag 1 , tag3")
 	c.Assert(filter.filterTags([]string{"tag2", "tag3"}), Equals, false)
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionConsistingCommaGivesTrue(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "tag 1 , tag3"}
+	filter := tagFilter(c, "tag 1 , tag3")
 	c.Assert(filter.filterTags([]string{"tag1", "tag3"}), Equals, true)
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionConsistingTrueAndFalseAsTagNames(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "true , false"}
+	filter := tagFilter(c, "true , false")
 	c.Assert(filter.filterTags([]string{"true", "false"}), Equals, true)
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionConsistingTrueAndFalseAsTagNamesWithNegation(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "!true"}
+	filter := tagFilter(c, "!true")
 	c.Assert(filter.filterTags(nil), Equals, true)
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionConsistingSpecialCharacters(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "a && b || c | b & b"}
+	filter := tagFilter(c, "a && b || c | b & b")
 	c.Assert(filter.filterTags([]string{"a", "b"}), Equals, true)
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionWhenTagIsSubsetOfTrueOrFalse(c *C) {
 	// https://github.com/getgauge/gauge/issues/667
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "b || c | b & b && a"}
+	filter := tagFilter(c, "b || c | b & b && a")
 	c.Assert(filter.filterTags([]string{"a", "b"}), Equals, true)
 }
 
-func (s *MySuite) TestParseTagExpression(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "b || c | b & b && a"}
-	txps, tags := filter.parseTagExpression()
-
-	expectedTxps := []string{"b", "|", "|", "c", "|", "b", "&", "b", "&", "&", "a"}
-	expectedTags := []string{"b", "c", "b", "b", "a"}
-
-	for i, t := range txps {
-		c.Assert(expectedTxps[i], Equals, t)
-	}
-	for i, t := range tags {
-		c.Assert(expectedTags[i], Equals, t)
-	}
-}
-
 func (s *MySuite) TestScenarioSpanFilter(c *C) {
 	scenario1 := &gauge.Scenario{
 		Heading: &gauge.Heading{Value: "First Scenario"},
@@ -383,7 +368,7 @@ func (s *MySuite) TestToFilterSpecsByTagExpOfTwoTags(c *C) {
 	c.Assert(specs[0].Tags.Values()[0], Equals, myTags[0])
 	c.Assert(specs[0].Tags.Values()[1], Equals, myTags[1])
 
-	filteredSpecs, otherSpecs := filterSpecsByTags(specs, "tag1 & tag2")
+	filteredSpecs, otherSpecs := filterSpecsByTags(specs, parseTags(c, "tag1 & tag2", specs))
 	c.Assert(len(filteredSpecs), Equals, 1)
 
 	c.Assert(len(otherSpecs), Equals, 1)
@@ -427,7 +412,7 @@ func (s *MySuite) TestToEvaluateTagExpression(c *C) {
 	specs = append(specs, spec1)
 	specs = append(specs, spec2)
 
-	filteredSpecs, otherSpecs := filterSpecsByTags(specs, "tag1 & !(tag1 & tag4) & (tag2 | tag3)")
+	filteredSpecs, otherSpecs := filterSpecsByTags(specs, parseTags(c, "tag1 & !(tag1 & tag4) & (tag2 | tag3)", specs))
 	c.Assert(len(filteredSpecs), Equals, 1)
 	c.Assert(len(filteredSpecs[0].Scenarios), Equals, 2)
 	c.Assert(filteredSpecs[0].Scenarios[0], Equals, scenario3)
@@ -467,10 +452,8 @@ func (s *MySuite) TestToFilterSpecsByWrongTagExpression(c *C) {
 	c.Assert(specs[0].Tags.Values()[0], Equals, myTags[0])
 	c.Assert(specs[0].Tags.Values()[1], Equals, myTags[1])
 
-	filteredSpecs, otherSpecs := filterSpecsByTags(specs, "(tag1 & tag2")
-	c.Assert(len(filteredSpecs), Equals, 0)
-
-	c.Assert(len(otherSpecs), Equals, 2)
+	_, err := ParseTagExpression("(tag1 & tag2", specs)
+	c.Assert(err, NotNil)
 
 }
 
@@ -511,7 +494,7 @@ func (s *MySuite) TestToFilterMultipleScenariosByMultipleTags(c *C) {
 	c.Assert(len(specs[0].Scenarios[2].Tags.Values()), Equals, 2)
 	c.Assert(len(specs[0].Scenarios[3].Tags.Values()), Equals, 4)
 
-	filteredSpecs, otherSpecs := filterSpecsByTags(specs, "tag1 & tag2")
+	filteredSpecs, otherSpecs := filterSpecsByTags(specs, parseTags(c, "tag1 & tag2", specs))
 	c.Assert(len(filteredSpecs[0].Scenarios), Equals, 3)
 	c.Assert(filteredSpecs[0].Scenarios[0], Equals, scenario2)
 	c.Assert(filteredSpecs[0].Scenarios[1], Equals, scenario3)
@@ -551,7 +534,7 @@ func (s *MySuite) TestToFilterScenariosByTagsAtSpecLevel(c *C) {
 	c.Assert(len(specs[0].Scenarios), Equals, 3)
 	c.Assert(len(specs[0].Tags.Values()), Equals, 2)
 
-	filteredSpecs, otherSpecs := filterSpecsByTags(specs, "tag1 & tag2")
+	filteredSpecs, otherSpecs := filterSpecsByTags(specs, parseTags(c, "tag1 & tag2", specs))
 	c.Assert(len(filteredSpecs[0].Scenarios), Equals, 3)
 	c.Assert(filteredSpecs[0].Scenarios[0], Equals, scenario1)
 	c.Assert(filteredSpecs[0].Scenarios[1], Equals, scenario2)
@@ -591,7 +574,7 @@ func (s *MySuite) TestToFilterScenariosByTagExpWithDuplicateTagNames(c *C) {
 
 	c.Assert(len(specs[0].Scenarios), Equals, 3)
 
-	filteredSpecs, otherSpecs := filterSpecsByTags(specs, "tag1 & tag12")
+	filteredSpecs, otherSpecs := filterSpecsByTags(specs, parseTags(c, "tag1 & tag12", specs))
 	c.Assert(len(filteredSpecs[0].Scenarios), Equals, 1)
 	c.Assert(filteredSpecs[0].Scenarios[0], Equals, scenario1)
 
@@ -602,14 +585,14 @@ func (s *MySuite) TestToFilterScenariosByTagExpWithDuplicateTagNames(c *C) {
 
 func (s *MySuite) TestFilterTags(c *C) {
 	specTags := []string{"abcd", "foo", "bar", "foo bar"}
-	tagFilter := NewScenarioFilterBasedOnTags(specTags, "abcd & foo bar")
+	tagFilter := NewScenarioFilterBasedOnTags(specTags, parseTags(c, "abcd & foo bar", nil))
 	evaluateTrue := tagFilter.filterTags(specTags)
 	c.Assert(evaluateTrue, Equals, true)
 }
 
 func (s *MySuite) TestSanitizeTags(c *C) {
 	specTags := []string{"abcd", "foo", "bar", "foo bar"}
-	tagFilter := NewScenarioFilterBasedOnTags(specTags, "abcd & foo bar | true")
+	tagFilter := NewScenarioFilterBasedOnTags(specTags, parseTags(c, "abcd & foo bar | true", nil))
 	evaluateTrue := tagFilter.filterTags(specTags)
 	c.Assert(evaluateTrue, Equals, true)
 }
@@ -649,7 +632,7 @@ func (s *MySuite) TestToFilterSpecsByTags(c *C) {
 	specs = append(specs, spec2)
 	specs = append(specs, spec3)
 
-	filteredSpecs, otherSpecs := filterSpecsByTags(specs, "tag1 & tag2")
+	filteredSpecs, otherSpecs := filterSpecsByTags(specs, parseTags(c, "tag1 & tag2", specs))
 	c.Assert(len(filteredSpecs), Equals, 2)
 	c.Assert(len(filteredSpecs[0].Scenarios), Equals, 1)
 	c.Assert(len(filteredSpecs[1].Scenarios), Equals, 1)
@@ -693,7 +676,7 @@ func (s *MySuite) TestToFilterScenariosByTag(c *C) {
 	var specs []*gauge.Specification
 	specs = append(specs, spec1)
 
-	filteredSpecs, otherSpecs := filterSpecsByTags(specs, "tag1 & tag2")
+	filteredSpecs, otherSpecs := filterSpecsByTags(specs, parseTags(c, "tag1 & tag2", specs))
 	c.Assert(len(filteredSpecs[0].Scenarios), Equals, 1)
 	c.Assert(filteredSpecs[0].Scenarios[0], Equals, scenario2)
 
@@ -731,7 +714,7 @@ func (s *MySuite) TestToFilterMultipleScenariosByTags(c *C) {
 	var specs []*gauge.Specification
 	specs = append(specs, spec1)
 
-	filteredSpecs, otherSpecs := filterSpecsByTags(specs, "tag1 & tag2")
+	filteredSpecs, otherSpecs := filterSpecsByTags(specs, parseTags(c, "tag1 & tag2", specs))
 
 	c.Assert(len(filteredSpecs[0].Scenarios), Equals, 2)
 	c.Assert(filteredSpecs[0].Scenarios[0], Equals, scenario2)
@@ -767,7 +750,7 @@ func (s *MySuite) TestToFilterScenariosByUnavailableTags(c *C) {
 	var specs []*gauge.Specification
 	specs = append(specs, spec1)
 
-	filteredSpecs, otherSpecs := filterSpecsByTags(specs, "tag3")
+	filteredSpecs, otherSpecs := filterSpecsByTags(specs, parseTags(c, "tag3", specs))
 
 	c.Assert(len(filteredSpecs), Equals, 0)
 
@@ -18,6 +18,8 @@
 package filter
 
 import (
+	"sort"
+
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
 )
@@ -45,16 +47,14 @@ type scenariosFilter struct {
 
 func (tf *tagFilterForParallelRun) filter(specs []*gauge.Specification) ([]*gauge.Specification, []*gauge.Specification) {
 	if tf.tagExp != "" {
-		validateTagExpression(tf.tagExp)
-		return filterSpecsByTags(specs, tf.tagExp)
+		return filterSpecsByTags(specs, parseTagExpressionOrExit(tf.tagExp, specs))
 	}
 	return specs, specs
 }
 
 func (tagsFilter *tagsFilter) filter(specs []*gauge.Specification) []*gauge.Specification {
 	if tagsFilter.tagExp != "" {
-		validateTagExpression(tagsFilter.tagExp)
-		specs, _ = filterSpecsByTags(specs, tagsFilter.tagExp)
+		specs, _ = filterSpecsByTags(specs, parseTagExpressionOrExit(tagsFilter.tagExp, specs))
 	}
 	return specs
 }
@@ -92,3 +92,61 @@ func DistributeSpecs(specifications []*gauge.Specification, distributions int) [
 	}
 	return s
 }
+
+// DistributeSpecsByExecutionTime distributes the specs such that the expected execution time of every
+// distribution is as even as possible. Expected time of a spec is looked up in execTimes by its file name,
+// specs without a recorded time are expected to take the average of the recorded times.
+func DistributeSpecsByExecutionTime(specifications []*gauge.Specification, distributions int, execTimes map[string]int64) []*gauge.SpecCollection {
+	s := make([]*gauge.SpecCollection, distributions)
+	if distributions < 1 {
+		return s
+	}
+	for i := range s {
+		s[i] = gauge.NewSpecCollection(make([]*gauge.Specification, 0), false)
+	}
+	estimates := estimateExecutionTimes(specifications, execTimes)
+	indexes := make([]int, len(specifications))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return estimates[indexes[i]] > estimates[indexes[j]]
+	})
+	load := make([]int64, distributions)
+	for _, i := range indexes {
+		min := 0
+		for d := 1; d < distributions; d++ {
+			if load[d] < load[min] || (load[d] == load[min] && s[d].Size() < s[min].Size()) {
+				min = d
+			}
+		}
+		load[min] += estimates[i]
+		s[min].Add(specifications[i])
+	}
+	return s
+}
+
+func estimateExecutionTimes(specifications []*gauge.Specification, execTimes map[string]int64) []int64 {
+	var total int64
+	for _, t := range execTimes {
+		total += t
+	}
+	var average int64
+	if len(execTimes) > 0 {
+		average = total / int64(len(execTimes))
+	}
+	// data table specs are split per row, the recorded time is for the whole file.
+	specsPerFile := make(map[string]int64)
+	for _, spec := range specifications {
+		specsPerFile[spec.FileName]++
+	}
+	estimates := make([]int64, len(specifications))
+	for i, spec := range specifications {
+		t, ok := execTimes[spec.FileName]
+		if !ok {
+			t = average
+		}
+		estimates[i] = t / specsPerFile[spec.FileName]
+	}
+	return estimates
+}
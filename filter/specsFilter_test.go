@@ -49,6 +49,37 @@ func (s *MySuite) TestDistributionOfSpecs(c *C) {
 	c.Assert(len(specCollections), Equals, 0)
 }
 
+func (s *MySuite) TestDistributionOfSpecsByExecutionTime(c *C) {
+	specs := createSpecsList(5)
+	execTimes := map[string]int64{"spec0": 100, "spec1": 10, "spec2": 10, "spec3": 60, "spec4": 20}
+
+	specCollections := DistributeSpecsByExecutionTime(specs, 2, execTimes)
+
+	c.Assert(len(specCollections), Equals, 2)
+	c.Assert(specCollections[0].SpecNames(), DeepEquals, []string{"spec0"})
+	c.Assert(specCollections[1].SpecNames(), DeepEquals, []string{"spec3", "spec4", "spec1", "spec2"})
+}
+
+func (s *MySuite) TestDistributionOfSpecsByExecutionTimeUsesAverageForUnknownSpecs(c *C) {
+	specs := createSpecsList(4)
+	execTimes := map[string]int64{"spec0": 90, "spec1": 10}
+
+	specCollections := DistributeSpecsByExecutionTime(specs, 2, execTimes)
+
+	c.Assert(specCollections[0].SpecNames(), DeepEquals, []string{"spec0", "spec1"})
+	c.Assert(specCollections[1].SpecNames(), DeepEquals, []string{"spec2", "spec3"})
+}
+
+func (s *MySuite) TestDistributionOfSpecsByExecutionTimeWithoutHistory(c *C) {
+	specs := createSpecsList(5)
+
+	specCollections := DistributeSpecsByExecutionTime(specs, 3, map[string]int64{})
+
+	c.Assert(len(specCollections), Equals, 3)
+	verifySpecCollectionsForSize(c, 2, specCollections[:2]...)
+	verifySpecCollectionsForSize(c, 1, specCollections[2])
+}
+
 func verifySpecCollectionsForSize(c *C, size int, specCollections ...*gauge.SpecCollection) {
 	for _, collection := range specCollections {
 		c.Assert(len(collection.Specs()), Equals, size)
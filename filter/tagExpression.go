@@ -0,0 +1,434 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package filter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+type tokenKind int
+
+const (
+	tagToken tokenKind = iota
+	andToken
+	orToken
+	notToken
+	openToken
+	closeToken
+	endToken
+)
+
+type token struct {
+	kind   tokenKind
+	value  string
+	quoted bool
+	// column is the 1 based position of the token in the expression
+	column int
+}
+
+func (t token) String() string {
+	if t.kind == endToken {
+		return "end of expression"
+	}
+	return fmt.Sprintf("'%s'", t.value)
+}
+
+// TagExpressionError describes an invalid tag expression and the column at which it is invalid.
+type TagExpressionError struct {
+	Expression string
+	Column     int
+	Message    string
+}
+
+func (e *TagExpressionError) Error() string {
+	return fmt.Sprintf("Invalid tag expression: %s at column %d.\n%s\n%s^", e.Message, e.Column, e.Expression, strings.Repeat(" ", e.Column-1))
+}
+
+// tagExpression is a parsed tag expression which can be evaluated against the tags of a scenario.
+type tagExpression interface {
+	evaluate(tags []string) bool
+}
+
+type tagNode struct {
+	name    string
+	pattern *regexp.Regexp
+	quoted  bool
+}
+
+type notNode struct {
+	exp tagExpression
+}
+
+type andNode struct {
+	left, right tagExpression
+}
+
+type orNode struct {
+	left, right tagExpression
+}
+
+// evaluate returns true if any of the tags matches. Unquoted tag names match tags ignoring spaces
+// and can use * as a wildcard, quoted tag names match tags as is.
+func (n *tagNode) evaluate(tags []string) bool {
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if n.quoted {
+			if tag == n.name {
+				return true
+			}
+			continue
+		}
+		tag = strings.Replace(tag, " ", "", -1)
+		if n.pattern != nil && n.pattern.MatchString(tag) || n.pattern == nil && tag == n.name {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *notNode) evaluate(tags []string) bool {
+	return !n.exp.evaluate(tags)
+}
+
+func (n *andNode) evaluate(tags []string) bool {
+	return n.left.evaluate(tags) && n.right.evaluate(tags)
+}
+
+func (n *orNode) evaluate(tags []string) bool {
+	return n.left.evaluate(tags) || n.right.evaluate(tags)
+}
+
+// TagExpression is a tag expression parsed once, to filter the scenarios of all specs by.
+type TagExpression struct {
+	exp tagExpression
+}
+
+// ParseTagExpression parses the tag expression. The words `and`, `or` and `not` are read as a part of a tag if
+// they form one of the tags of the given specs along with the words around them, like `search and replace`.
+func ParseTagExpression(expression string, specs []*gauge.Specification) (*TagExpression, error) {
+	exp, err := parseTagExpression(expression, knownTags(specs))
+	if err != nil {
+		return nil, err
+	}
+	return &TagExpression{exp: exp}, nil
+}
+
+func (e *TagExpression) evaluate(tags []string) bool {
+	return e.exp.evaluate(tags)
+}
+
+// ValidateTagExpression returns an error pointing at the offending column if the tag expression is invalid.
+func ValidateTagExpression(expression string) error {
+	_, err := parseTagExpression(expression, nil)
+	return err
+}
+
+// knownTags gives the tags of the specs and their scenarios, with spaces removed like in unquoted tags.
+func knownTags(specs []*gauge.Specification) map[string]bool {
+	tags := make(map[string]bool)
+	add := func(t *gauge.Tags) {
+		if t == nil {
+			return
+		}
+		for _, tag := range t.Values() {
+			tags[strings.Replace(strings.TrimSpace(tag), " ", "", -1)] = true
+		}
+	}
+	for _, spec := range specs {
+		add(spec.Tags)
+		for _, scn := range spec.Scenarios {
+			add(scn.Tags)
+		}
+	}
+	return tags
+}
+
+// parseTagExpression parses tag expressions like `smoke & !(slow | "in progress")`.
+// `&`, `&&`, `,` and `and` combine tags with logical and, `|`, `||` and `or` with logical or,
+// `!` and `not` negate. Not binds tighter than and, which binds tighter than or.
+func parseTagExpression(expression string, knownTags map[string]bool) (tagExpression, error) {
+	tokens, err := tokenize(expression, knownTags)
+	if err != nil {
+		return nil, err
+	}
+	p := &tagExpressionParser{expression: expression, tokens: tokens}
+	exp, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != endToken {
+		return nil, p.errorAt(t, "unexpected %s", t)
+	}
+	return exp, nil
+}
+
+type tagExpressionParser struct {
+	expression string
+	tokens     []token
+	pos        int
+}
+
+func (p *tagExpressionParser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *tagExpressionParser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != endToken {
+		p.pos++
+	}
+	return t
+}
+
+func (p *tagExpressionParser) errorAt(t token, format string, args ...interface{}) error {
+	return &TagExpressionError{Expression: p.expression, Column: t.column, Message: fmt.Sprintf(format, args...)}
+}
+
+func (p *tagExpressionParser) parseOr() (tagExpression, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == orToken {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *tagExpressionParser) parseAnd() (tagExpression, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == andToken {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *tagExpressionParser) parseNot() (tagExpression, error) {
+	if p.peek().kind == notToken {
+		p.next()
+		exp, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{exp: exp}, nil
+	}
+	return p.parseOperand()
+}
+
+func (p *tagExpressionParser) parseOperand() (tagExpression, error) {
+	t := p.next()
+	switch t.kind {
+	case tagToken:
+		return newTagNode(t), nil
+	case openToken:
+		exp, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if c := p.next(); c.kind != closeToken {
+			if c.kind == endToken {
+				return nil, p.errorAt(t, "missing ')' for '('")
+			}
+			return nil, p.errorAt(c, "expected ')' but found %s", c)
+		}
+		return exp, nil
+	}
+	return nil, p.errorAt(t, "expected a tag or '(' but found %s", t)
+}
+
+func newTagNode(t token) *tagNode {
+	n := &tagNode{name: t.value, quoted: t.quoted}
+	if !t.quoted && strings.Contains(t.value, "*") {
+		n.pattern = regexp.MustCompile("^" + strings.Replace(regexp.QuoteMeta(t.value), `\*`, ".*", -1) + "$")
+	}
+	return n
+}
+
+var keywords = map[string]tokenKind{"and": andToken, "or": orToken, "not": notToken}
+
+func isOperatorChar(r rune) bool {
+	return strings.ContainsRune("&|,!()\"", r)
+}
+
+// operator reads the operator at i, if any.
+func operator(runes []rune, i int) (token, bool) {
+	r := runes[i]
+	switch r {
+	case '&', '|':
+		kind := andToken
+		if r == '|' {
+			kind = orToken
+		}
+		value := string(r)
+		if i+1 < len(runes) && runes[i+1] == r {
+			value += string(r)
+		}
+		return token{kind: kind, value: value, column: i + 1}, true
+	case ',':
+		return token{kind: andToken, value: ",", column: i + 1}, true
+	case '!':
+		return token{kind: notToken, value: "!", column: i + 1}, true
+	case '(':
+		return token{kind: openToken, value: "(", column: i + 1}, true
+	case ')':
+		return token{kind: closeToken, value: ")", column: i + 1}, true
+	}
+	return token{}, false
+}
+
+// tokenize splits the expression into tokens. A tag is quoted with " or with a ' at its start, a ' inside an
+// unquoted tag like `don't` is a part of it.
+func tokenize(expression string, knownTags map[string]bool) ([]token, error) {
+	var tokens, words []token
+	flushWords := func() {
+		tokens = append(tokens, wordTokens(words, knownTags)...)
+		words = nil
+	}
+	runes := []rune(expression)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		column := i + 1
+		if unicode.IsSpace(r) {
+			i++
+			continue
+		}
+		if r == '"' || r == '\'' {
+			value, end, ok := readQuoted(runes, i)
+			if ok || r == '"' {
+				if !ok {
+					return nil, &TagExpressionError{Expression: expression, Column: column, Message: fmt.Sprintf("missing closing %c for quoted tag", r)}
+				}
+				if value == "" {
+					return nil, &TagExpressionError{Expression: expression, Column: column, Message: "empty quoted tag"}
+				}
+				flushWords()
+				tokens = append(tokens, token{kind: tagToken, value: value, quoted: true, column: column})
+				i = end
+				continue
+			}
+		}
+		if t, ok := operator(runes, i); ok {
+			flushWords()
+			tokens = append(tokens, t)
+			i += len(t.value)
+			continue
+		}
+		start := i
+		for i < len(runes) && !unicode.IsSpace(runes[i]) && !isOperatorChar(runes[i]) {
+			i++
+		}
+		words = append(words, token{kind: tagToken, value: string(runes[start:i]), column: column})
+	}
+	flushWords()
+	return append(tokens, token{kind: endToken, column: len(runes) + 1}), nil
+}
+
+// wordTokens turns consecutive unquoted words into tokens. Words which are not keywords form a single tag,
+// with the spaces between them removed. Keywords are read as a part of a tag only if they form a known tag
+// along with the words around them.
+func wordTokens(words []token, knownTags map[string]bool) []token {
+	var tokens []token
+	lastWasWord := false
+	for i := 0; i < len(words); {
+		if end := knownTagWithKeyword(words, i, knownTags); end > i {
+			tokens = append(tokens, token{kind: tagToken, value: joinWords(words[i:end]), column: words[i].column})
+			i = end
+			lastWasWord = false
+			continue
+		}
+		w := words[i]
+		i++
+		if kind, ok := keywords[strings.ToLower(w.value)]; ok {
+			tokens = append(tokens, token{kind: kind, value: w.value, column: w.column})
+			lastWasWord = false
+			continue
+		}
+		if last := len(tokens) - 1; lastWasWord && last >= 0 {
+			tokens[last].value += w.value
+		} else {
+			tokens = append(tokens, w)
+		}
+		lastWasWord = true
+	}
+	return tokens
+}
+
+// knownTagWithKeyword returns the end of the longest run of words starting at i which contains a keyword and
+// forms a known tag, or i if there is none.
+func knownTagWithKeyword(words []token, i int, knownTags map[string]bool) int {
+	for end := len(words); end > i; end-- {
+		if hasKeyword(words[i:end]) && knownTags[joinWords(words[i:end])] {
+			return end
+		}
+	}
+	return i
+}
+
+func hasKeyword(words []token) bool {
+	for _, w := range words {
+		if _, ok := keywords[strings.ToLower(w.value)]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func joinWords(words []token) string {
+	var value string
+	for _, w := range words {
+		value += w.value
+	}
+	return value
+}
+
+// readQuoted reads the quoted tag starting at i, returning its value and the index after the closing quote.
+// A backslash escapes the quote character and the backslash itself.
+func readQuoted(runes []rune, i int) (string, int, bool) {
+	quote := runes[i]
+	var value []rune
+	for j := i + 1; j < len(runes); j++ {
+		switch {
+		case runes[j] == '\\' && j+1 < len(runes) && (runes[j+1] == quote || runes[j+1] == '\\'):
+			value = append(value, runes[j+1])
+			j++
+		case runes[j] == quote:
+			return strings.TrimSpace(string(value)), j + 1, true
+		default:
+			value = append(value, runes[j])
+		}
+	}
+	return "", 0, false
+}
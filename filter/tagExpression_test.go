@@ -0,0 +1,140 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package filter
+
+import (
+	"fmt"
+
+	"github.com/getgauge/gauge/gauge"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestTokenizeTagExpression(c *C) {
+	tokens, err := tokenize("b || c | b & b && a", nil)
+
+	c.Assert(err, IsNil)
+	var values []string
+	for _, t := range tokens[:len(tokens)-1] {
+		values = append(values, t.value)
+	}
+	c.Assert(values, DeepEquals, []string{"b", "||", "c", "|", "b", "&", "b", "&&", "a"})
+}
+
+func (s *MySuite) TestTokenizeJoinsWordsOfUnquotedTag(c *C) {
+	tokens, err := tokenize(`tag 1 and "tag 2"`, nil)
+
+	c.Assert(err, IsNil)
+	c.Assert(tokens, DeepEquals, []token{
+		{kind: tagToken, value: "tag1", column: 1},
+		{kind: andToken, value: "and", column: 7},
+		{kind: tagToken, value: "tag 2", quoted: true, column: 11},
+		{kind: endToken, column: 18},
+	})
+}
+
+func (s *MySuite) TestToEvaluateTagExpressionWithKeywords(c *C) {
+	filter := tagFilter(c, "smoke and not (slow or flaky)")
+	c.Assert(filter.filterTags([]string{"smoke", "fast"}), Equals, true)
+	c.Assert(filter.filterTags([]string{"smoke", "flaky"}), Equals, false)
+}
+
+func (s *MySuite) TestToEvaluateTagExpressionWithPrecedence(c *C) {
+	filter := tagFilter(c, "a | b & !c")
+	c.Assert(filter.filterTags([]string{"a", "c"}), Equals, true)
+	c.Assert(filter.filterTags([]string{"b", "c"}), Equals, false)
+	c.Assert(filter.filterTags([]string{"b"}), Equals, true)
+}
+
+func (s *MySuite) TestToEvaluateTagExpressionWithQuotedTags(c *C) {
+	filter := tagFilter(c, `"work in progress" | 'a&b' | "say \"hi\""`)
+	c.Assert(filter.filterTags([]string{"work in progress"}), Equals, true)
+	c.Assert(filter.filterTags([]string{"a&b"}), Equals, true)
+	c.Assert(filter.filterTags([]string{`say "hi"`}), Equals, true)
+	c.Assert(filter.filterTags([]string{"workinprogress", "a", "b"}), Equals, false)
+}
+
+func (s *MySuite) TestToEvaluateTagExpressionWithWildcard(c *C) {
+	filter := tagFilter(c, "api-* & !*-slow")
+	c.Assert(filter.filterTags([]string{"api-users"}), Equals, true)
+	c.Assert(filter.filterTags([]string{"api-users", "api-slow"}), Equals, false)
+	c.Assert(filter.filterTags([]string{"apix"}), Equals, false)
+}
+
+func (s *MySuite) TestToEvaluateQuotedTagWithWildcardCharacter(c *C) {
+	filter := tagFilter(c, `"api-*"`)
+	c.Assert(filter.filterTags([]string{"api-users"}), Equals, false)
+	c.Assert(filter.filterTags([]string{"api-*"}), Equals, true)
+}
+
+func (s *MySuite) TestValidateTagExpressionPointsAtOffendingColumn(c *C) {
+	err := ValidateTagExpression("tag1 & (tag2 | )")
+
+	c.Assert(err, DeepEquals, &TagExpressionError{Expression: "tag1 & (tag2 | )", Column: 16, Message: "expected a tag or '(' but found ')'"})
+	c.Assert(err.Error(), Equals, "Invalid tag expression: expected a tag or '(' but found ')' at column 16.\ntag1 & (tag2 | )\n               ^")
+}
+
+func (s *MySuite) TestValidateTagExpressionWithErrors(c *C) {
+	for exp, message := range map[string]string{
+		"":                      "expected a tag or '(' but found end of expression at column 1",
+		"tag1 & ((tag2 | tag3)": "missing ')' for '(' at column 8",
+		"tag1 & tag2)":          "unexpected ')' at column 12",
+		"tag1 tag2 (tag3)":      "unexpected '(' at column 11",
+		`tag1 | "tag2`:          "missing closing \" for quoted tag at column 8",
+		"tag1 and":              "expected a tag or '(' but found end of expression at column 9",
+		"|":                     "expected a tag or '(' but found '|' at column 1",
+	} {
+		err, ok := ValidateTagExpression(exp).(*TagExpressionError)
+		c.Assert(ok, Equals, true, Commentf(exp))
+		c.Assert(fmt.Sprintf("%s at column %d", err.Message, err.Column), Equals, message, Commentf(exp))
+	}
+}
+
+func tagFilter(c *C, expression string) *ScenarioFilterBasedOnTags {
+	return &ScenarioFilterBasedOnTags{tagExpression: parseTags(c, expression, nil)}
+}
+
+func parseTags(c *C, expression string, specs []*gauge.Specification) *TagExpression {
+	exp, err := ParseTagExpression(expression, specs)
+	c.Assert(err, IsNil)
+	return exp
+}
+
+func (s *MySuite) TestToEvaluateTagWithApostrophe(c *C) {
+	filter := tagFilter(c, "don't & 'work in progress' | it's")
+	c.Assert(filter.filterTags([]string{"don't", "work in progress"}), Equals, true)
+	c.Assert(filter.filterTags([]string{"it's"}), Equals, true)
+	c.Assert(filter.filterTags([]string{"don't"}), Equals, false)
+}
+
+func (s *MySuite) TestToEvaluateUnclosedApostropheAsPartOfTag(c *C) {
+	filter := tagFilter(c, "'tis & smoke")
+	c.Assert(filter.filterTags([]string{"'tis", "smoke"}), Equals, true)
+}
+
+func (s *MySuite) TestKeywordsAreReadAsPartOfKnownTags(c *C) {
+	specs := []*gauge.Specification{{
+		Tags:      &gauge.Tags{RawValues: [][]string{{"search and replace"}}},
+		Scenarios: []*gauge.Scenario{{Tags: &gauge.Tags{RawValues: [][]string{{"not ready"}}}}},
+	}}
+	filter := &ScenarioFilterBasedOnTags{tagExpression: parseTags(c, "search and replace and not not ready", specs)}
+	c.Assert(filter.filterTags([]string{"search and replace"}), Equals, true)
+	c.Assert(filter.filterTags([]string{"search and replace", "not ready"}), Equals, false)
+
+	filter = &ScenarioFilterBasedOnTags{tagExpression: parseTags(c, "search and smoke", specs)}
+	c.Assert(filter.filterTags([]string{"search", "smoke"}), Equals, true)
+}
@@ -68,7 +68,7 @@ func getParseResult(results []*parser.ParseResult) map[string]*parser.ParseResul
 }
 
 func FormatStep(step *gauge.Step) string {
-	text := step.Value
+	text := escapeReservedChars(step.Value)
 	paramCount := strings.Count(text, gauge.ParameterPlaceholder)
 	for i := 0; i < paramCount; i++ {
 		argument := step.Args[i]
@@ -76,6 +76,8 @@ func FormatStep(step *gauge.Step) string {
 		if argument.ArgType == gauge.TableArg {
 			formattedTable := FormatTable(&argument.Table)
 			formattedArg = fmt.Sprintf("\n%s", formattedTable)
+		} else if argument.ArgType == gauge.Dynamic && argument.Default != nil {
+			formattedArg = fmt.Sprintf("<%s = \"%s\">", parser.GetUnescapedString(argument.Name), strings.Replace(parser.GetUnescapedString(*argument.Default), ">", `\>`, -1))
 		} else if argument.ArgType == gauge.Dynamic {
 			formattedArg = fmt.Sprintf("<%s>", parser.GetUnescapedString(argument.Name))
 		} else if argument.ArgType == gauge.SpecialString || argument.ArgType == gauge.SpecialTable {
@@ -94,6 +96,15 @@ func FormatStep(step *gauge.Step) string {
 	return stepText
 }
 
+// escapeReservedChars escapes the braces in the step text which are not parameter placeholders.
+func escapeReservedChars(value string) string {
+	parts := strings.Split(value, gauge.ParameterPlaceholder)
+	for i, part := range parts {
+		parts[i] = strings.NewReplacer("{", `\{`, "}", `\}`).Replace(part)
+	}
+	return strings.Join(parts, gauge.ParameterPlaceholder)
+}
+
 func FormatStepWithResolvedArgs(step *gauge.Step) string {
 	text := step.Value
 	paramCount := strings.Count(text, gauge.ParameterPlaceholder)
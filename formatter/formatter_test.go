@@ -446,3 +446,22 @@ func (s *MySuite) TestFormatShouldNotAddExtraNewLinesBeforeDataTable(c *C) {
    |Rhythm|0          |
 `)
 }
+
+func (s *MySuite) TestFormatStepEscapesReservedChars(c *C) {
+	step := &gauge.Step{
+		Value: "add {} to {cart}",
+		Args:  []*gauge.StepArg{&gauge.StepArg{Value: "item", ArgType: gauge.Static}},
+	}
+
+	c.Assert(FormatStep(step), Equals, "* add \"item\" to \\{cart\\}\n")
+}
+
+func (s *MySuite) TestFormatConceptHeadingWithDefaultValues(c *C) {
+	defaultValue := "a > b"
+	step := &gauge.Step{Value: "compare {} with {}", Args: []*gauge.StepArg{
+		{Name: "left", Value: "left", ArgType: gauge.Dynamic},
+		{Name: "right", Value: "right", ArgType: gauge.Dynamic, Default: &defaultValue},
+	}}
+
+	c.Assert(FormatStep(step), Equals, "* compare <left> with <right = \"a \\> b\">\n")
+}
@@ -130,6 +130,8 @@ type StepArg struct {
 	Value   string
 	ArgType ArgType
 	Table   Table
+	// Default is the value of a concept parameter, given in the concept heading as <name = "value">, used when a call leaves it out.
+	Default *string
 }
 
 func (stepArg *StepArg) String() string {
@@ -17,9 +17,12 @@
 
 package gauge
 
+import "strings"
+
 type ConceptDictionary struct {
 	ConceptsMap     map[string]*Concept
 	constructionMap map[string][]*Step
+	shortFormsMap   map[string]*Concept
 }
 
 type Concept struct {
@@ -31,10 +34,83 @@ func NewConceptDictionary() *ConceptDictionary {
 	return &ConceptDictionary{ConceptsMap: make(map[string]*Concept, 0), constructionMap: make(map[string][]*Step, 0)}
 }
 
+// ShortForms returns the step values with which the concept can be called by leaving out trailing parameters that have a default value.
+func (concept *Concept) ShortForms() []string {
+	args := concept.ConceptStep.Args
+	value := concept.ConceptStep.Value
+	parts := strings.SplitAfter(value, ParameterPlaceholder)
+	var forms []string
+	for i := len(args) - 1; i >= 0 && args[i].Default != nil; i-- {
+		form := strings.Join(parts[:i], "")
+		if i == 0 {
+			form = strings.Split(value, ParameterPlaceholder)[0]
+		}
+		if form = strings.TrimSpace(form); form != "" {
+			forms = append(forms, form)
+		}
+	}
+	return forms
+}
+
+// ArgsWithDefaults returns the args of a call to the concept followed by the default values of the parameters it leaves out.
+func (concept *Concept) ArgsWithDefaults(args []*StepArg) []*StepArg {
+	params := concept.ConceptStep.Args
+	if len(args) >= len(params) {
+		return args
+	}
+	allArgs := append([]*StepArg{}, args...)
+	for _, param := range params[len(args):] {
+		allArgs = append(allArgs, &StepArg{ArgType: Static, Value: *param.Default})
+	}
+	return allArgs
+}
+
+// Search returns the concept with the given step value, including the concepts which can be called with it by leaving out parameters that have a default value.
 func (dict *ConceptDictionary) Search(stepValue string) *Concept {
 	if concept, ok := dict.ConceptsMap[stepValue]; ok {
 		return concept
 	}
+	if concept, ok := dict.shortFormsMap[stepValue]; ok {
+		return concept
+	}
+	return nil
+}
+
+// Ambiguities returns the concepts, other than the given one and its duplicates, which a call could resolve to as well as to the given concept.
+func (dict *ConceptDictionary) Ambiguities(concept *Concept) []*Concept {
+	var ambiguous []*Concept
+	add := func(c *Concept) {
+		if c == nil || c.ConceptStep.Value == concept.ConceptStep.Value {
+			return
+		}
+		for _, a := range ambiguous {
+			if a == c {
+				return
+			}
+		}
+		ambiguous = append(ambiguous, c)
+	}
+	add(dict.shortFormsMap[concept.ConceptStep.Value])
+	for _, form := range concept.ShortForms() {
+		add(dict.Search(form))
+	}
+	return ambiguous
+}
+
+// AddShortForms lets Search find the concept by its short forms, which must be updated in steps that were parsed before the concept.
+func (dict *ConceptDictionary) AddShortForms(concept *Concept) error {
+	if dict.shortFormsMap == nil {
+		dict.shortFormsMap = make(map[string]*Concept)
+	}
+	for _, form := range concept.ShortForms() {
+		if _, ok := dict.shortFormsMap[form]; ok {
+			continue
+		}
+		dict.shortFormsMap[form] = concept
+		if err := dict.updateStepsWithValue(form, concept.ConceptStep); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -64,10 +140,17 @@ func (dict *ConceptDictionary) ReplaceNestedConceptSteps(conceptStep *Step) erro
 
 //mutates the step with concept steps so that anyone who is referencing the step will now refer a concept
 func (dict *ConceptDictionary) updateStep(step *Step) error {
-	dict.constructionMap[step.Value] = append(dict.constructionMap[step.Value], step)
-	if !dict.constructionMap[step.Value][0].IsConcept {
-		dict.constructionMap[step.Value] = append(dict.constructionMap[step.Value], step)
-		for _, allSteps := range dict.constructionMap[step.Value] {
+	return dict.updateStepsWithValue(step.Value, step)
+}
+
+func (dict *ConceptDictionary) updateStepsWithValue(value string, step *Step) error {
+	if dict.constructionMap == nil {
+		dict.constructionMap = make(map[string][]*Step)
+	}
+	dict.constructionMap[value] = append(dict.constructionMap[value], step)
+	if !dict.constructionMap[value][0].IsConcept {
+		dict.constructionMap[value] = append(dict.constructionMap[value], step)
+		for _, allSteps := range dict.constructionMap[value] {
 			allSteps.IsConcept = step.IsConcept
 			allSteps.ConceptSteps = step.ConceptSteps
 			lookupCopy, err := step.Lookup.GetCopy()
@@ -85,8 +168,9 @@ func (dict *ConceptDictionary) UpdateLookupForNestedConcepts() error {
 		for _, stepInsideConcept := range concept.ConceptStep.ConceptSteps {
 			stepInsideConcept.Parent = concept.ConceptStep
 			if nestedConcept := dict.Search(stepInsideConcept.Value); nestedConcept != nil {
+				args := nestedConcept.ArgsWithDefaults(stepInsideConcept.Args)
 				for i, arg := range nestedConcept.ConceptStep.Args {
-					stepArg := StepArg{ArgType: stepInsideConcept.Args[i].ArgType, Value: stepInsideConcept.Args[i].Value}
+					stepArg := StepArg{ArgType: args[i].ArgType, Value: args[i].Value}
 					if err := stepInsideConcept.Lookup.AddArgValue(arg.Value, &stepArg); err != nil {
 						return err
 					}
@@ -98,6 +182,14 @@ func (dict *ConceptDictionary) UpdateLookupForNestedConcepts() error {
 }
 
 func (dict *ConceptDictionary) Remove(stepValue string) {
+	if concept := dict.Search(stepValue); concept != nil {
+		for _, form := range concept.ShortForms() {
+			if dict.shortFormsMap[form] == concept {
+				delete(dict.shortFormsMap, form)
+			}
+		}
+		delete(dict.ConceptsMap, concept.ConceptStep.Value)
+	}
 	delete(dict.ConceptsMap, stepValue)
 	delete(dict.constructionMap, stepValue)
 }
@@ -18,6 +18,9 @@
 package gauge
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/getgauge/gauge/execution/result"
 	"github.com/getgauge/gauge/gauge_messages"
 )
@@ -194,9 +197,48 @@ func ConvertToProtoSuiteResult(suiteResult *result.SuiteResult) *gauge_messages.
 		PreHookScreenshots:  suiteResult.PreHookScreenshots,
 		PostHookScreenshots: suiteResult.PostHookScreenshots,
 	}
+	if suiteResult.SuiteSetup != nil {
+		protoSuiteResult.SuiteSetup = suiteResult.SuiteSetup.ProtoScenario
+		if protoSuiteResult.PreHookFailure == nil {
+			protoSuiteResult.PreHookFailure = suiteStepsFailure(suiteResult.SuiteSetup.ProtoScenario)
+		}
+	}
+	if suiteResult.SuiteTeardown != nil {
+		protoSuiteResult.SuiteTeardown = suiteResult.SuiteTeardown.ProtoScenario
+		if protoSuiteResult.PostHookFailure == nil {
+			protoSuiteResult.PostHookFailure = suiteStepsFailure(suiteResult.SuiteTeardown.ProtoScenario)
+		}
+	}
 	return protoSuiteResult
 }
 
+// suiteStepsFailure gives the failure of the contexts or the teardown steps of the suite spec as a hook failure, so that
+// the reports which do not show the suite spec tell why the suite failed. It is nil if the steps passed.
+func suiteStepsFailure(steps *gauge_messages.ProtoScenario) *gauge_messages.ProtoHookFailure {
+	if steps.GetSkipped() {
+		return &gauge_messages.ProtoHookFailure{
+			ErrorMessage: fmt.Sprintf("%s was skipped: %s", steps.GetScenarioHeading(), strings.Join(steps.GetSkipErrors(), ", ")),
+		}
+	}
+	if !steps.GetFailed() {
+		return nil
+	}
+	for _, item := range steps.GetScenarioItems() {
+		res := item.GetStep().GetStepExecutionResult().GetExecutionResult()
+		if item.GetItemType() == gauge_messages.ProtoItem_Concept {
+			res = item.GetConcept().GetConceptExecutionResult().GetExecutionResult()
+		}
+		if res.GetFailed() {
+			return &gauge_messages.ProtoHookFailure{
+				ErrorMessage:      fmt.Sprintf("%s failed: %s", steps.GetScenarioHeading(), res.GetErrorMessage()),
+				StackTrace:        res.GetStackTrace(),
+				FailureScreenshot: res.GetFailureScreenshot(),
+			}
+		}
+	}
+	return &gauge_messages.ProtoHookFailure{ErrorMessage: fmt.Sprintf("%s failed", steps.GetScenarioHeading())}
+}
+
 func getSuccessRate(totalSpecs int, failedSpecs int) float32 {
 	if totalSpecs == 0 {
 		return 0
@@ -33,6 +33,9 @@ type Scenario struct {
 	ScenarioDataTableRow      Table
 	ScenarioDataTableRowIndex int
 	Span                      *Span
+	// SelectedDataTableRows are the indices of the rows of the scenario data table to execute, all rows are executed
+	// if it is empty
+	SelectedDataTableRows []int
 }
 
 // Span represents scope of Scenario based on line number
@@ -81,6 +84,17 @@ func (scenario *Scenario) InSpan(lineNumber int) bool {
 	return scenario.Span.isInRange(lineNumber)
 }
 
+// DataTableRowAt gives the index of the row of the scenario data table in the given line, or -1 if the line does not
+// have a row of it.
+func (scenario *Scenario) DataTableRowAt(lineNumber int) int {
+	for i, l := range scenario.DataTable.RowLineNos {
+		if l == lineNumber {
+			return i
+		}
+	}
+	return -1
+}
+
 func (scenario *Scenario) renameSteps(oldStep Step, newStep Step, orderMap map[int]int) ([]*StepDiff, bool) {
 	isRefactored := false
 	diffs := []*StepDiff{}
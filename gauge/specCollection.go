@@ -38,6 +38,11 @@ func NewSpecCollection(s []*Specification, groupDataTableSpecs bool) *SpecCollec
 	return &SpecCollection{specs: combineDataTableSpecs(s)}
 }
 
+// NewSpecCollectionOfGroups creates a collection which gives out every group of specs as a whole.
+func NewSpecCollectionOfGroups(groups [][]*Specification) *SpecCollection {
+	return &SpecCollection{specs: groups}
+}
+
 func combineDataTableSpecs(s []*Specification) (specs [][]*Specification) {
 	combinedSpecs := make(map[string][]*Specification)
 	for _, spec := range s {
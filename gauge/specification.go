@@ -96,19 +96,25 @@ func (spec *Specification) ProcessConceptStepsFrom(conceptDictionary *ConceptDic
 
 func (spec *Specification) processConceptStep(step *Step, conceptDictionary *ConceptDictionary) error {
 	if conceptFromDictionary := conceptDictionary.Search(step.Value); conceptFromDictionary != nil {
-		return spec.createConceptStep(conceptFromDictionary.ConceptStep, step)
+		return spec.createConceptStep(conceptFromDictionary, step)
 	}
 	return nil
 }
 
-func (spec *Specification) createConceptStep(concept *Step, originalStep *Step) error {
+func (spec *Specification) createConceptStep(conceptFromDictionary *Concept, originalStep *Step) error {
+	concept := conceptFromDictionary.ConceptStep
 	stepCopy, err := concept.GetCopy()
 	if err != nil {
 		return err
 	}
 	originalArgs := originalStep.Args
+	originalValue := originalStep.Value
 	originalStep.CopyFrom(stepCopy)
 	originalStep.Args = originalArgs
+	// a call leaving out parameters with a default value keeps its own text, so that it is formatted as written
+	if len(originalArgs) < len(concept.Args) {
+		originalStep.Value = originalValue
+	}
 
 	// set parent of all concept steps to be the current concept (referred as originalStep here)
 	// this is used to fetch from parent's lookup when nested
@@ -116,7 +122,7 @@ func (spec *Specification) createConceptStep(concept *Step, originalStep *Step)
 		conceptStep.Parent = originalStep
 	}
 
-	return spec.PopulateConceptLookup(&originalStep.Lookup, concept.Args, originalStep.Args)
+	return spec.PopulateConceptLookup(&originalStep.Lookup, concept.Args, conceptFromDictionary.ArgsWithDefaults(originalStep.Args))
 }
 
 func (spec *Specification) AddItem(itemToAdd Item) {
@@ -31,6 +31,8 @@ type DataTable struct {
 	Value      string
 	LineNo     int
 	IsExternal bool
+	// RowLineNos are the line numbers of the rows of a table written in the spec, it is empty for external tables
+	RowLineNos []int
 }
 
 type TableCell struct {
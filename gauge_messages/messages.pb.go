@@ -122,6 +122,8 @@ const (
 	Message_ImplementationFileGlobPatternResponse Message_MessageType = 32
 	Message_SuiteExecutionResultItem              Message_MessageType = 33
 	Message_KeepAlive                             Message_MessageType = 34
+	Message_SpecialParamPrefixesRequest           Message_MessageType = 90
+	Message_SpecialParamPrefixesResponse          Message_MessageType = 91
 )
 
 var Message_MessageType_name = map[int32]string{
@@ -160,6 +162,8 @@ var Message_MessageType_name = map[int32]string{
 	32: "ImplementationFileGlobPatternResponse",
 	33: "SuiteExecutionResultItem",
 	34: "KeepAlive",
+	90: "SpecialParamPrefixesRequest",
+	91: "SpecialParamPrefixesResponse",
 }
 
 var Message_MessageType_value = map[string]int32{
@@ -198,6 +202,8 @@ var Message_MessageType_value = map[string]int32{
 	"ImplementationFileGlobPatternResponse": 32,
 	"SuiteExecutionResultItem":              33,
 	"KeepAlive":                             34,
+	"SpecialParamPrefixesRequest":           90,
+	"SpecialParamPrefixesResponse":          91,
 }
 
 func (x Message_MessageType) String() string {
@@ -205,7 +211,7 @@ func (x Message_MessageType) String() string {
 }
 
 func (Message_MessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4dc296cbfe5ffcd5, []int{42, 0}
+	return fileDescriptor_4dc296cbfe5ffcd5, []int{44, 0}
 }
 
 // / Default request. Tells the runner to shutdown.
@@ -2262,6 +2268,79 @@ func (m *KeepAlive) GetPluginId() string {
 	return ""
 }
 
+// / Request for the prefixes of the special parameters the runner resolves, e.g. "file", "table".
+type SpecialParamPrefixesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpecialParamPrefixesRequest) Reset()         { *m = SpecialParamPrefixesRequest{} }
+func (m *SpecialParamPrefixesRequest) String() string { return proto.CompactTextString(m) }
+func (*SpecialParamPrefixesRequest) ProtoMessage()    {}
+func (*SpecialParamPrefixesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dc296cbfe5ffcd5, []int{42}
+}
+
+func (m *SpecialParamPrefixesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpecialParamPrefixesRequest.Unmarshal(m, b)
+}
+func (m *SpecialParamPrefixesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpecialParamPrefixesRequest.Marshal(b, m, deterministic)
+}
+func (m *SpecialParamPrefixesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecialParamPrefixesRequest.Merge(m, src)
+}
+func (m *SpecialParamPrefixesRequest) XXX_Size() int {
+	return xxx_messageInfo_SpecialParamPrefixesRequest.Size(m)
+}
+func (m *SpecialParamPrefixesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecialParamPrefixesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpecialParamPrefixesRequest proto.InternalMessageInfo
+
+// / Response of the special parameter prefixes
+type SpecialParamPrefixesResponse struct {
+	// / Prefixes of the special parameters the runner resolves
+	Prefixes             []string `protobuf:"bytes,1,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpecialParamPrefixesResponse) Reset()         { *m = SpecialParamPrefixesResponse{} }
+func (m *SpecialParamPrefixesResponse) String() string { return proto.CompactTextString(m) }
+func (*SpecialParamPrefixesResponse) ProtoMessage()    {}
+func (*SpecialParamPrefixesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dc296cbfe5ffcd5, []int{43}
+}
+
+func (m *SpecialParamPrefixesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpecialParamPrefixesResponse.Unmarshal(m, b)
+}
+func (m *SpecialParamPrefixesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpecialParamPrefixesResponse.Marshal(b, m, deterministic)
+}
+func (m *SpecialParamPrefixesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecialParamPrefixesResponse.Merge(m, src)
+}
+func (m *SpecialParamPrefixesResponse) XXX_Size() int {
+	return xxx_messageInfo_SpecialParamPrefixesResponse.Size(m)
+}
+func (m *SpecialParamPrefixesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecialParamPrefixesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpecialParamPrefixesResponse proto.InternalMessageInfo
+
+func (m *SpecialParamPrefixesResponse) GetPrefixes() []string {
+	if m != nil {
+		return m.Prefixes
+	}
+	return nil
+}
+
 // / This is the message which gets transferred all the time
 // / with proper message type set
 // / One of the Request/Response fields will have value, depending on the MessageType set.
@@ -2339,17 +2418,21 @@ type Message struct {
 	// / [SuiteExecutionResult ](#gauge.messages.SuiteExecutionResult )
 	SuiteExecutionResultItem *SuiteExecutionResultItem `protobuf:"bytes,36,opt,name=suiteExecutionResultItem,proto3" json:"suiteExecutionResultItem,omitempty"`
 	// / [KeepAlive ](#gauge.messages.KeepAlive )
-	KeepAlive            *KeepAlive `protobuf:"bytes,37,opt,name=keepAlive,proto3" json:"keepAlive,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	KeepAlive *KeepAlive `protobuf:"bytes,37,opt,name=keepAlive,proto3" json:"keepAlive,omitempty"`
+	// / [SpecialParamPrefixesRequest](#gauge.messages.SpecialParamPrefixesRequest)
+	SpecialParamPrefixesRequest *SpecialParamPrefixesRequest `protobuf:"bytes,90,opt,name=specialParamPrefixesRequest,proto3" json:"specialParamPrefixesRequest,omitempty"`
+	// / [SpecialParamPrefixesResponse](#gauge.messages.SpecialParamPrefixesResponse)
+	SpecialParamPrefixesResponse *SpecialParamPrefixesResponse `protobuf:"bytes,91,opt,name=specialParamPrefixesResponse,proto3" json:"specialParamPrefixesResponse,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}                      `json:"-"`
+	XXX_unrecognized             []byte                        `json:"-"`
+	XXX_sizecache                int32                         `json:"-"`
 }
 
 func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dc296cbfe5ffcd5, []int{42}
+	return fileDescriptor_4dc296cbfe5ffcd5, []int{44}
 }
 
 func (m *Message) XXX_Unmarshal(b []byte) error {
@@ -2629,6 +2712,20 @@ func (m *Message) GetKeepAlive() *KeepAlive {
 	return nil
 }
 
+func (m *Message) GetSpecialParamPrefixesRequest() *SpecialParamPrefixesRequest {
+	if m != nil {
+		return m.SpecialParamPrefixesRequest
+	}
+	return nil
+}
+
+func (m *Message) GetSpecialParamPrefixesResponse() *SpecialParamPrefixesResponse {
+	if m != nil {
+		return m.SpecialParamPrefixesResponse
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("gauge.messages.StepValidateResponse_ErrorType", StepValidateResponse_ErrorType_name, StepValidateResponse_ErrorType_value)
 	proto.RegisterEnum("gauge.messages.CacheFileRequest_FileStatus", CacheFileRequest_FileStatus_name, CacheFileRequest_FileStatus_value)
@@ -2676,158 +2773,164 @@ func init() {
 	proto.RegisterType((*TextDiff)(nil), "gauge.messages.TextDiff")
 	proto.RegisterType((*FileDiff)(nil), "gauge.messages.FileDiff")
 	proto.RegisterType((*KeepAlive)(nil), "gauge.messages.KeepAlive")
+	proto.RegisterType((*SpecialParamPrefixesRequest)(nil), "gauge.messages.SpecialParamPrefixesRequest")
+	proto.RegisterType((*SpecialParamPrefixesResponse)(nil), "gauge.messages.SpecialParamPrefixesResponse")
 	proto.RegisterType((*Message)(nil), "gauge.messages.Message")
 }
 
 func init() { proto.RegisterFile("messages.proto", fileDescriptor_4dc296cbfe5ffcd5) }
 
 var fileDescriptor_4dc296cbfe5ffcd5 = []byte{
-	// 2340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xcd, 0x6e, 0x1b, 0xc9,
-	0x11, 0xde, 0xa1, 0x24, 0x8b, 0x2a, 0xea, 0xa7, 0xd5, 0xa2, 0xa4, 0x16, 0x2d, 0xc9, 0xd2, 0x58,
-	0xf6, 0xca, 0xf9, 0x61, 0x02, 0x65, 0xe3, 0xfc, 0x20, 0x39, 0xc8, 0x12, 0xed, 0x10, 0x96, 0x25,
-	0x6e, 0x4b, 0xde, 0x2c, 0x36, 0xc0, 0x1a, 0x63, 0xb2, 0x45, 0xcd, 0x9a, 0x9c, 0xe1, 0x4e, 0x0f,
-	0xed, 0x0d, 0x10, 0x20, 0xb7, 0x20, 0x40, 0xce, 0x39, 0x07, 0x58, 0x20, 0x97, 0x3c, 0x40, 0xde,
-	0x21, 0x4f, 0x91, 0x47, 0xc8, 0x25, 0xc8, 0x39, 0xe8, 0x9e, 0xee, 0xe1, 0xfc, 0xf4, 0x0c, 0xb5,
-	0x07, 0xfb, 0x64, 0x75, 0x4d, 0xd5, 0x57, 0xd5, 0x55, 0xd5, 0xd5, 0x55, 0x4d, 0xc3, 0xf2, 0x90,
-	0x71, 0xee, 0xf4, 0x19, 0x6f, 0x8e, 0x02, 0x3f, 0xf4, 0xf1, 0x72, 0xdf, 0x19, 0xf7, 0x59, 0x53,
-	0x53, 0x1b, 0xc0, 0x47, 0xac, 0x1b, 0x7d, 0xb3, 0xeb, 0x80, 0x9f, 0xbb, 0x83, 0x41, 0x27, 0xf0,
-	0xbb, 0x8c, 0x73, 0xca, 0xbe, 0x1e, 0x33, 0x1e, 0xda, 0x2e, 0x6c, 0xb6, 0xbe, 0x61, 0xdd, 0x71,
-	0xe8, 0xfa, 0xde, 0x65, 0xe8, 0x84, 0x63, 0x4e, 0x19, 0x1f, 0xf9, 0x1e, 0x67, 0xf8, 0x1c, 0x56,
-	0x98, 0xfe, 0x44, 0x19, 0x1f, 0x0f, 0x42, 0x62, 0xed, 0x59, 0x87, 0xb5, 0xa3, 0x83, 0x66, 0x5a,
-	0x4d, 0xb3, 0x23, 0x14, 0xb4, 0xd2, 0xbc, 0x34, 0x2b, 0x6c, 0x0f, 0x81, 0x24, 0x55, 0x05, 0xa1,
-	0xeb, 0xf5, 0x95, 0x19, 0xf8, 0x53, 0xa8, 0x77, 0xc7, 0x41, 0xc0, 0xbc, 0x30, 0x66, 0x69, 0x7b,
-	0xd7, 0xbe, 0x52, 0xb8, 0x93, 0x55, 0x98, 0x62, 0xa2, 0x46, 0x51, 0xfb, 0x0d, 0x6c, 0xc4, 0x84,
-	0x96, 0xd7, 0x7b, 0xbf, 0xca, 0xbe, 0x86, 0xed, 0xcb, 0x11, 0xeb, 0x7e, 0xc8, 0xfd, 0xf9, 0xd0,
-	0x48, 0xa9, 0x7c, 0xef, 0x7b, 0x1c, 0xc3, 0xde, 0x65, 0x97, 0x79, 0x4e, 0xe0, 0xfa, 0x1f, 0x72,
-	0x9f, 0x1c, 0x76, 0x73, 0x6a, 0x3f, 0x48, 0x3c, 0x43, 0x36, 0xfa, 0xd0, 0xf1, 0x4c, 0xaa, 0x7c,
-	0xef, 0x7b, 0xfc, 0x8f, 0x05, 0x4b, 0x29, 0x0a, 0xfe, 0x25, 0xd4, 0x14, 0xa7, 0xc8, 0x2c, 0x85,
-	0x4d, 0xb2, 0xd8, 0xe2, 0x9b, 0x84, 0x4d, 0x32, 0xe3, 0xa7, 0xb0, 0xa2, 0x97, 0x2a, 0x5a, 0xa4,
-	0x22, 0xe5, 0xb7, 0x73, 0xf2, 0xea, 0xbb, 0xc4, 0xc8, 0x0a, 0x25, 0x6d, 0x08, 0xd9, 0x88, 0xcc,
-	0x14, 0xd8, 0x10, 0xb2, 0x51, 0xda, 0x86, 0x90, 0x8d, 0xf0, 0x2e, 0x00, 0x0f, 0x9d, 0xee, 0x9b,
-	0x30, 0x70, 0xba, 0x8c, 0xcc, 0xee, 0x59, 0x87, 0x0b, 0x34, 0x41, 0xb1, 0xbf, 0x82, 0xaa, 0x36,
-	0x1e, 0x63, 0x98, 0xf5, 0x9c, 0x21, 0x93, 0x9b, 0x5c, 0xa0, 0xf2, 0x6f, 0xdc, 0x80, 0xea, 0xb5,
-	0x3b, 0x60, 0xe7, 0x82, 0x5e, 0x91, 0xf4, 0x78, 0x2d, 0xbe, 0xb9, 0xfc, 0xa9, 0xe3, 0x0e, 0x58,
-	0x4f, 0x1a, 0x55, 0xa5, 0xf1, 0x5a, 0x60, 0x85, 0x4e, 0x9f, 0x93, 0xd9, 0xbd, 0x19, 0x81, 0x25,
-	0xfe, 0xb6, 0x29, 0x2c, 0x26, 0x37, 0x5a, 0xa4, 0x2f, 0xc6, 0xac, 0x14, 0x60, 0xce, 0x24, 0x30,
-	0xbf, 0xb5, 0xa0, 0xaa, 0x77, 0x8e, 0x1f, 0xc3, 0x2c, 0x17, 0x1e, 0x8a, 0xa2, 0x64, 0x9b, 0x33,
-	0x80, 0x09, 0x76, 0x95, 0x43, 0x54, 0xf2, 0x97, 0x2a, 0xd5, 0x0e, 0xbc, 0x92, 0x0e, 0x9c, 0x49,
-	0x38, 0x50, 0x52, 0xb0, 0x0d, 0x8b, 0x2c, 0x08, 0xfc, 0xe0, 0x45, 0xa4, 0x45, 0xb9, 0x38, 0x45,
-	0xb3, 0xff, 0x65, 0x01, 0xce, 0x2b, 0xc7, 0x0f, 0x61, 0xd9, 0xe9, 0x86, 0x63, 0x67, 0x20, 0x88,
-	0x57, 0xec, 0x9b, 0x50, 0x79, 0x22, 0x43, 0x15, 0x7c, 0x23, 0x27, 0xe0, 0xac, 0x17, 0xf3, 0x45,
-	0x91, 0xc8, 0x50, 0xf1, 0x21, 0xac, 0x70, 0xe5, 0x5f, 0x61, 0xbc, 0xeb, 0xf5, 0x55, 0x58, 0xb2,
-	0x64, 0xfc, 0x0b, 0x80, 0x91, 0x13, 0x38, 0x43, 0x16, 0xb2, 0x20, 0x8a, 0x51, 0xed, 0x68, 0x2b,
-	0x77, 0x85, 0x69, 0x0e, 0x9a, 0x60, 0xb6, 0xff, 0x66, 0xc1, 0x9a, 0xd0, 0xf8, 0x99, 0x33, 0x70,
-	0x7b, 0x4e, 0xc8, 0xf4, 0x66, 0x1a, 0x50, 0xe5, 0xe9, 0x6d, 0xc4, 0x6b, 0xdc, 0x04, 0xec, 0x8d,
-	0x87, 0xaf, 0x59, 0x70, 0x71, 0xdd, 0x99, 0xa8, 0x15, 0x9b, 0x98, 0xa3, 0x86, 0x2f, 0xf8, 0x57,
-	0xb0, 0xc0, 0x23, 0x15, 0x63, 0xa6, 0xd2, 0x7d, 0xd7, 0x78, 0xc1, 0x5e, 0x6a, 0x2e, 0x3a, 0x11,
-	0xb0, 0xff, 0x5a, 0x81, 0x7a, 0xda, 0x42, 0x75, 0x7b, 0x13, 0x98, 0x77, 0xb9, 0xa4, 0x4a, 0x0b,
-	0xab, 0x54, 0x2f, 0x73, 0x41, 0xac, 0xe4, 0x83, 0x88, 0xcf, 0x60, 0x41, 0xae, 0xaf, 0x7e, 0x3f,
-	0x8a, 0x8c, 0x5a, 0x3e, 0x6a, 0x9a, 0xce, 0x60, 0x56, 0x6d, 0xb3, 0xa5, 0xa5, 0xe8, 0x04, 0x40,
-	0xa6, 0xd5, 0xb8, 0xdf, 0x67, 0x5c, 0x54, 0x9a, 0xf8, 0x5c, 0xc6, 0x14, 0xfb, 0x53, 0x58, 0x88,
-	0xe5, 0xf0, 0x3e, 0xec, 0x5c, 0x5e, 0xb5, 0x3a, 0xaf, 0xda, 0x2f, 0x3a, 0x67, 0xad, 0x17, 0xad,
-	0xf3, 0xab, 0xe3, 0xab, 0xf6, 0xc5, 0xf9, 0xab, 0xf3, 0x8b, 0xab, 0x57, 0x4f, 0x2f, 0x5e, 0x9e,
-	0x9f, 0xa2, 0x8f, 0x04, 0xcb, 0xe9, 0xcb, 0xce, 0x59, 0xfb, 0xe4, 0xf8, 0xaa, 0xf5, 0xca, 0xc0,
-	0x8c, 0x2c, 0xfb, 0x0b, 0xa8, 0x5f, 0x8e, 0xdd, 0x90, 0x65, 0xba, 0x12, 0xfc, 0x04, 0x6a, 0x5c,
-	0xd0, 0x53, 0x0d, 0xcd, 0x9e, 0xd9, 0xdf, 0x13, 0x3e, 0x9a, 0x14, 0xb2, 0x5f, 0x02, 0x31, 0x61,
-	0xb7, 0x43, 0x36, 0x14, 0xc9, 0x16, 0xc4, 0x2b, 0x05, 0xbf, 0x65, 0x84, 0x17, 0x0c, 0x34, 0xc1,
-	0x6c, 0x63, 0x40, 0xc2, 0xa5, 0xa2, 0xda, 0xc4, 0xed, 0xd9, 0x23, 0x58, 0x4d, 0xd0, 0x54, 0x68,
-	0xeb, 0x30, 0x27, 0x12, 0x80, 0x13, 0x4b, 0xd6, 0x86, 0x68, 0x61, 0xef, 0xc2, 0xb6, 0x2e, 0x38,
-	0xa7, 0x4e, 0xe8, 0x5c, 0x86, 0x7e, 0xc0, 0xda, 0x9e, 0x1b, 0x6a, 0xa8, 0x06, 0x10, 0x51, 0xfc,
-	0x8c, 0xdf, 0xee, 0xc2, 0x96, 0xdc, 0x91, 0xf1, 0xe3, 0x6f, 0x61, 0x35, 0x4e, 0xd7, 0x8e, 0xcf,
-	0x5d, 0xb1, 0x63, 0xbc, 0x07, 0x35, 0x7f, 0xd0, 0xd3, 0x4b, 0xb9, 0xd1, 0x39, 0x9a, 0x24, 0x09,
-	0x0e, 0x8f, 0xbd, 0x8b, 0x39, 0xa2, 0x03, 0x90, 0x24, 0xd9, 0x7f, 0xaa, 0xc0, 0x0a, 0x65, 0xd7,
-	0x4e, 0x37, 0xf4, 0x03, 0x7d, 0xb2, 0x9e, 0xc0, 0xa2, 0x3f, 0xe8, 0xc5, 0xa9, 0xae, 0x3c, 0x38,
-	0xed, 0x40, 0xa4, 0x64, 0x04, 0x86, 0xc7, 0xde, 0x4d, 0x30, 0x2a, 0xb7, 0xc3, 0x48, 0xca, 0xe0,
-	0xb6, 0x2c, 0x43, 0xce, 0x50, 0x1b, 0x1b, 0x15, 0xe2, 0xda, 0xd1, 0x7e, 0x61, 0xe1, 0xd0, 0x9c,
-	0x34, 0x23, 0x28, 0x1c, 0xc1, 0x9d, 0xb7, 0xec, 0xe4, 0xc6, 0xf1, 0xfa, 0x8c, 0xcb, 0xf4, 0xaf,
-	0xd2, 0x24, 0xc9, 0xfe, 0x23, 0xd4, 0x9e, 0xba, 0x03, 0xbd, 0x4c, 0x5d, 0x43, 0x56, 0xe6, 0x1a,
-	0x3a, 0x80, 0x9a, 0xf8, 0xfb, 0xc4, 0xf7, 0x42, 0xe6, 0xa9, 0xda, 0xf8, 0xa4, 0x42, 0x2c, 0x9a,
-	0x24, 0xe3, 0x26, 0xcc, 0xf5, 0xdc, 0xeb, 0x6b, 0x6d, 0x74, 0xee, 0xfa, 0x14, 0x85, 0xea, 0xd4,
-	0xbd, 0xbe, 0xa6, 0x11, 0x9b, 0xfd, 0x77, 0x0b, 0xd0, 0x24, 0x12, 0x93, 0x0a, 0xc2, 0xc7, 0x5d,
-	0x31, 0x2c, 0xe8, 0x0a, 0xa2, 0x96, 0x22, 0x01, 0xe5, 0xe1, 0x56, 0xa5, 0x23, 0x5a, 0x88, 0xba,
-	0x22, 0x6c, 0xe0, 0xd1, 0x36, 0x7a, 0xea, 0xe6, 0x4a, 0xd1, 0xf0, 0xaf, 0x95, 0xf9, 0xb1, 0x2f,
-	0x84, 0x79, 0x77, 0xb3, 0xe6, 0x25, 0x9c, 0x41, 0x93, 0xfc, 0xf6, 0x8f, 0x60, 0x45, 0x1f, 0x07,
-	0x9d, 0x30, 0xdb, 0xc9, 0xf2, 0x19, 0x79, 0x2b, 0x51, 0x1e, 0xff, 0x69, 0x4d, 0x0e, 0x55, 0xbc,
-	0xb1, 0x03, 0x58, 0x72, 0xb9, 0xa0, 0x76, 0x02, 0xc6, 0x85, 0x17, 0xa3, 0xed, 0xa5, 0x89, 0xba,
-	0xc6, 0xab, 0x66, 0x60, 0x46, 0xd7, 0x78, 0xdd, 0x0c, 0xdc, 0x38, 0xfc, 0x78, 0xe0, 0x3a, 0x5c,
-	0x37, 0x03, 0x7a, 0x9d, 0x8a, 0xde, 0x6c, 0x26, 0x7a, 0x87, 0x30, 0xcb, 0x47, 0x8e, 0x47, 0xe6,
-	0x64, 0x46, 0xd6, 0xf3, 0x9d, 0x95, 0xe3, 0x51, 0xc9, 0x61, 0x3f, 0x86, 0xc6, 0x4b, 0x8f, 0x8f,
-	0x47, 0x23, 0x3f, 0x08, 0x59, 0x4f, 0x95, 0xe5, 0x64, 0x68, 0x94, 0x90, 0xda, 0xb2, 0x5e, 0xda,
-	0xff, 0xb3, 0x00, 0x9d, 0x38, 0xdd, 0x1b, 0x26, 0x7c, 0xa8, 0x7d, 0x44, 0x60, 0xbe, 0xab, 0x12,
-	0x46, 0xb1, 0xab, 0xa5, 0x36, 0xb6, 0xe3, 0x84, 0x37, 0xc9, 0x8e, 0x47, 0xac, 0xa3, 0x46, 0xe1,
-	0x64, 0xe0, 0xf3, 0x64, 0xc7, 0x13, 0xad, 0xf1, 0x09, 0xdc, 0xe1, 0x72, 0x5a, 0x94, 0x5b, 0x5c,
-	0x3e, 0xfa, 0x7e, 0x76, 0x2b, 0x59, 0x1b, 0x64, 0x4c, 0xd5, 0x80, 0xa9, 0x44, 0xed, 0xe7, 0x00,
-	0x13, 0x2a, 0xae, 0xc1, 0xfc, 0xc9, 0x6f, 0x8e, 0xcf, 0x9f, 0xb5, 0x44, 0x85, 0x07, 0xb8, 0x73,
-	0x72, 0x76, 0x71, 0xd9, 0x3a, 0x45, 0x96, 0xfc, 0x40, 0x5b, 0xc7, 0x57, 0xad, 0x53, 0x54, 0x11,
-	0x8b, 0xd3, 0xd6, 0x59, 0x4b, 0x2c, 0x66, 0x04, 0xd7, 0x45, 0xa7, 0x75, 0xde, 0x3a, 0x45, 0xb3,
-	0xf6, 0x51, 0x74, 0x0f, 0xc6, 0xc7, 0x2e, 0x71, 0x55, 0xc7, 0x3b, 0xb4, 0xd2, 0x3b, 0xb4, 0xff,
-	0x6d, 0xc1, 0x7a, 0x46, 0x48, 0x39, 0xf8, 0x73, 0x58, 0xe2, 0xc9, 0x0f, 0xb2, 0xd4, 0xd6, 0x8e,
-	0x8e, 0x4c, 0x77, 0x60, 0x4e, 0x3a, 0x45, 0xa5, 0x69, 0x20, 0xf3, 0xd9, 0x69, 0x7c, 0x06, 0x8b,
-	0x49, 0xa1, 0xf2, 0xac, 0x8e, 0xd3, 0xa8, 0x32, 0x35, 0x8d, 0x1e, 0xc2, 0x41, 0x7b, 0x38, 0x1a,
-	0xb0, 0x21, 0xf3, 0x42, 0x47, 0x20, 0x0b, 0x87, 0x3f, 0x1b, 0xf8, 0xaf, 0x3b, 0x4e, 0x18, 0xb2,
-	0xc0, 0xd3, 0x35, 0xfe, 0x39, 0x3c, 0x98, 0xc2, 0xa7, 0x1c, 0x63, 0xc3, 0x62, 0x7f, 0x42, 0xd6,
-	0x57, 0x50, 0x8a, 0x66, 0xdf, 0x83, 0x9d, 0x3c, 0xd8, 0x99, 0xcb, 0xe3, 0x1b, 0xe5, 0x0b, 0xd8,
-	0x2d, 0x62, 0x50, 0x6a, 0x7e, 0x0e, 0x9b, 0x6e, 0x8e, 0x43, 0xc4, 0x4c, 0x6b, 0x2c, 0xfa, 0x6c,
-	0x0f, 0x61, 0xe7, 0x32, 0x1c, 0xbf, 0x4e, 0xe3, 0x9f, 0xf8, 0xbd, 0xf8, 0x30, 0x3c, 0x86, 0x0d,
-	0xb3, 0xac, 0xf2, 0x73, 0xc1, 0x57, 0x11, 0xb8, 0xae, 0xdf, 0x63, 0x5c, 0x15, 0x83, 0x68, 0x61,
-	0x9f, 0x43, 0x55, 0x17, 0xd3, 0x38, 0x2c, 0xd6, 0xb4, 0xb0, 0x24, 0x0f, 0x64, 0x25, 0x75, 0x20,
-	0xed, 0x2f, 0xa1, 0x2a, 0x34, 0x4a, 0xbc, 0x92, 0xd4, 0xc5, 0x8f, 0x61, 0x21, 0x54, 0x7a, 0x23,
-	0x8b, 0xca, 0xaa, 0xfc, 0x84, 0xd5, 0xfe, 0x18, 0x16, 0x9e, 0x33, 0x36, 0x3a, 0x1e, 0xb8, 0x6f,
-	0x65, 0x19, 0x1b, 0x0d, 0xc6, 0x7d, 0xd7, 0x6b, 0xf7, 0xb4, 0x02, 0xbd, 0xb6, 0xff, 0x6b, 0xc3,
-	0xbc, 0xee, 0x06, 0x5b, 0x50, 0x53, 0xa0, 0xb2, 0x1f, 0xb4, 0xe4, 0x91, 0xbf, 0x9f, 0x55, 0xa7,
-	0xb8, 0xf5, 0xbf, 0xb2, 0x09, 0x4c, 0xca, 0x89, 0xa4, 0x56, 0xcb, 0x76, 0x34, 0x7a, 0xcc, 0xd0,
-	0x09, 0x01, 0xf7, 0x80, 0xb0, 0x82, 0x71, 0x5b, 0xb5, 0xc5, 0x87, 0x85, 0x53, 0x6e, 0x86, 0x9f,
-	0x16, 0x22, 0xe1, 0x11, 0x6c, 0xf3, 0x92, 0x87, 0x1a, 0x59, 0xce, 0x6a, 0x47, 0x3f, 0x30, 0xcd,
-	0xbc, 0x85, 0xda, 0x4a, 0x11, 0xf1, 0x57, 0xd0, 0xe0, 0x85, 0xef, 0x34, 0xea, 0x26, 0xf8, 0x5e,
-	0xa9, 0xbe, 0x94, 0x04, 0x2d, 0x41, 0xc3, 0x7f, 0x80, 0x3d, 0x3e, 0xe5, 0x89, 0x86, 0xdc, 0x91,
-	0x1a, 0x7f, 0x5c, 0x34, 0x95, 0x17, 0xee, 0x72, 0x2a, 0x32, 0x7e, 0x0b, 0xbb, 0xbc, 0xf4, 0xa5,
-	0x86, 0xcc, 0x4b, 0xdd, 0xcd, 0xa9, 0xba, 0xd3, 0x3b, 0x9e, 0x82, 0x2a, 0x63, 0x5a, 0xf2, 0x58,
-	0x43, 0xaa, 0x05, 0x31, 0x2d, 0x91, 0xa1, 0xa5, 0x88, 0x32, 0xa6, 0x85, 0x6f, 0x35, 0x64, 0xa1,
-	0x20, 0xa6, 0x85, 0x12, 0xb4, 0x04, 0x0d, 0x53, 0xc0, 0x2c, 0x37, 0x4e, 0x13, 0xb8, 0xf5, 0xd4,
-	0x6f, 0x90, 0xc6, 0x5f, 0xc2, 0x06, 0x33, 0xdb, 0x5e, 0x93, 0xb8, 0x0f, 0x0b, 0x4f, 0x5a, 0xda,
-	0xee, 0x02, 0x14, 0xfc, 0x12, 0xd6, 0x78, 0x7e, 0x6c, 0x26, 0x8b, 0x12, 0xfc, 0x7e, 0xf9, 0x20,
-	0x19, 0x21, 0x9b, 0xe4, 0xf1, 0xe7, 0x50, 0xe7, 0x86, 0xa1, 0x93, 0x2c, 0x99, 0x9f, 0xa5, 0x4d,
-	0x03, 0x2a, 0x35, 0x22, 0x60, 0x07, 0x36, 0x99, 0xf9, 0x19, 0x9c, 0x2c, 0x4b, 0xf0, 0x8f, 0xcb,
-	0x6a, 0x4f, 0x82, 0x9d, 0x16, 0xe1, 0xe0, 0x33, 0x40, 0x3c, 0x33, 0xde, 0x91, 0x15, 0xf3, 0xf8,
-	0x99, 0x1d, 0x03, 0x69, 0x4e, 0x12, 0x5f, 0xc0, 0x2a, 0xcf, 0x0e, 0x86, 0x04, 0x49, 0xb8, 0xfd,
-	0x12, 0x38, 0x65, 0x64, 0x5e, 0x56, 0xfa, 0xd6, 0x30, 0xd4, 0x92, 0xd5, 0x02, 0xdf, 0x1a, 0x78,
-	0xa9, 0x11, 0x41, 0x24, 0xf0, 0x9b, 0xdc, 0x0f, 0x0f, 0x04, 0x9b, 0x13, 0x38, 0xff, 0x13, 0x05,
-	0x35, 0x48, 0xcb, 0x23, 0x5f, 0x32, 0xec, 0x92, 0xb5, 0x82, 0x23, 0x5f, 0x22, 0x43, 0x4b, 0x11,
-	0xc5, 0xf5, 0xc4, 0x0b, 0xc6, 0x67, 0x52, 0x37, 0x5f, 0x4f, 0x45, 0xe3, 0x36, 0x2d, 0x44, 0xc2,
-	0x7d, 0xd8, 0xe2, 0x45, 0x83, 0x38, 0x59, 0x97, 0x6a, 0x1e, 0x19, 0x43, 0x61, 0xd4, 0x53, 0x8c,
-	0x85, 0xdb, 0xb0, 0xc2, 0xd3, 0x93, 0x14, 0xd9, 0x90, 0xf0, 0xf7, 0x8a, 0xb2, 0x47, 0x83, 0x66,
-	0xe5, 0x92, 0x89, 0x1d, 0x67, 0xe2, 0x66, 0x79, 0x62, 0xc7, 0x89, 0x98, 0x93, 0x14, 0x86, 0x05,
-	0xe9, 0x37, 0x01, 0x42, 0xcc, 0x86, 0x65, 0x9e, 0x0e, 0x68, 0x56, 0x4e, 0x18, 0x16, 0x64, 0x86,
-	0x5a, 0xb2, 0x65, 0x36, 0x2c, 0x3b, 0xfc, 0xd2, 0x9c, 0xa4, 0xa8, 0xf9, 0xe3, 0xc2, 0x89, 0x8c,
-	0x34, 0xcc, 0x35, 0xbf, 0x78, 0x86, 0xa3, 0x25, 0x68, 0xc2, 0xf2, 0x6e, 0x66, 0x80, 0x22, 0x77,
-	0xcd, 0x96, 0x67, 0x07, 0x2d, 0x9a, 0x93, 0xd4, 0x65, 0x33, 0x3b, 0x1a, 0x91, 0xed, 0xe2, 0xb2,
-	0x99, 0xe5, 0xa5, 0x46, 0x04, 0xfc, 0x3b, 0x58, 0xe7, 0xa6, 0x09, 0x88, 0xec, 0x48, 0xe8, 0x07,
-	0xb7, 0x1a, 0x97, 0xa8, 0x19, 0x03, 0x73, 0xd8, 0x71, 0xcb, 0xc6, 0x08, 0xb2, 0x2b, 0x95, 0xfc,
-	0x30, 0xab, 0xa4, 0x74, 0xf6, 0xa0, 0xe5, 0x98, 0xa2, 0x87, 0x71, 0x4b, 0x47, 0x13, 0x72, 0xcf,
-	0xdc, 0xc3, 0x94, 0x0f, 0x34, 0x74, 0x0a, 0xaa, 0xd8, 0x2c, 0x2f, 0x1b, 0x5b, 0xc8, 0x9e, 0x79,
-	0xb3, 0xa5, 0xb3, 0x0e, 0x2d, 0xc7, 0xc4, 0x9f, 0x44, 0x03, 0x86, 0x98, 0x0c, 0xc8, 0xbe, 0xf9,
-	0x87, 0x16, 0x3d, 0x8c, 0xd0, 0x98, 0x13, 0xff, 0xd9, 0x82, 0x03, 0xf7, 0x16, 0x43, 0x25, 0xb1,
-	0x25, 0xe4, 0x27, 0xd3, 0x3d, 0x95, 0x97, 0xa5, 0xb7, 0xd2, 0x80, 0xff, 0x62, 0xc1, 0x03, 0xf7,
-	0x36, 0x73, 0x2b, 0xb9, 0x2f, 0x6d, 0xf9, 0xe9, 0x77, 0xb4, 0x45, 0x05, 0xef, 0x76, 0x3a, 0xe4,
-	0x15, 0x51, 0xf0, 0x2e, 0x4c, 0x0e, 0x0a, 0xae, 0x88, 0x02, 0x7e, 0x5a, 0x88, 0x84, 0x7f, 0x06,
-	0x0b, 0x6f, 0xf4, 0x04, 0x47, 0x1e, 0x98, 0x1f, 0x98, 0xe3, 0x11, 0x8f, 0x4e, 0x78, 0xed, 0x6f,
-	0xe7, 0xa1, 0x96, 0x98, 0xcd, 0xf0, 0x3a, 0xac, 0xe6, 0x1a, 0x5c, 0xf4, 0x11, 0xde, 0x82, 0x75,
-	0xe3, 0xb4, 0x83, 0x2c, 0xbc, 0x09, 0x6b, 0x86, 0xc1, 0x04, 0x55, 0xf0, 0x0e, 0x6c, 0x15, 0xce,
-	0x0f, 0x68, 0x06, 0xdf, 0x85, 0xcd, 0x82, 0x16, 0x1f, 0xcd, 0x4a, 0x7d, 0xa6, 0x5e, 0x1b, 0xcd,
-	0x49, 0x7d, 0xf9, 0xc6, 0x18, 0xdd, 0xc1, 0x2b, 0x50, 0x4b, 0x74, 0xba, 0x68, 0x1e, 0xaf, 0xc1,
-	0x4a, 0x96, 0xab, 0xaa, 0xc5, 0x33, 0x5d, 0x24, 0x5a, 0xc0, 0xc4, 0xfc, 0x9b, 0x09, 0x02, 0x61,
-	0x69, 0x41, 0x63, 0x87, 0x6a, 0xb8, 0x9e, 0x7f, 0xa0, 0x47, 0x8b, 0xc2, 0x8d, 0xb9, 0x06, 0x0b,
-	0x2d, 0xe1, 0x0d, 0xd3, 0x7f, 0xb7, 0x40, 0xcb, 0x52, 0xb7, 0x21, 0xb4, 0x68, 0x45, 0x3a, 0xc2,
-	0xd4, 0x81, 0x20, 0x24, 0x75, 0x64, 0x5b, 0x06, 0xb4, 0x2a, 0x74, 0xe4, 0x2f, 0x7f, 0x84, 0x85,
-	0x37, 0x32, 0xb7, 0x36, 0x5a, 0x4b, 0x5a, 0x1f, 0x9b, 0x59, 0x17, 0xac, 0x99, 0x7b, 0x14, 0xad,
-	0x0b, 0xd6, 0xec, 0x85, 0x88, 0x36, 0xf0, 0x6e, 0xd9, 0x93, 0x24, 0xda, 0x14, 0x52, 0xd9, 0xcb,
-	0x08, 0x11, 0xed, 0xeb, 0xec, 0xd5, 0x81, 0xb6, 0x74, 0xe0, 0x73, 0x85, 0x1f, 0x35, 0xf0, 0xfe,
-	0x94, 0x17, 0x24, 0x74, 0x17, 0xdb, 0xd3, 0xde, 0x90, 0xd0, 0xb6, 0xfc, 0x29, 0xa9, 0xac, 0x00,
-	0xa2, 0x1d, 0xbc, 0x38, 0x79, 0x6f, 0x41, 0xbb, 0xf8, 0xf0, 0x76, 0xcf, 0x65, 0xe8, 0x1e, 0x7e,
-	0x74, 0xcb, 0x07, 0x33, 0xb4, 0x87, 0xb7, 0x8b, 0x7f, 0x2e, 0x42, 0xfb, 0x78, 0x29, 0xf1, 0x20,
-	0x83, 0xec, 0x27, 0x8f, 0x60, 0xa3, 0xeb, 0x0f, 0x9b, 0xe1, 0x8d, 0x3f, 0xee, 0xdf, 0x84, 0xef,
-	0xfc, 0xe0, 0x0d, 0x8f, 0x0e, 0xf7, 0x3f, 0x2a, 0xcb, 0xcf, 0xe4, 0x21, 0x57, 0x8e, 0xe7, 0xaf,
-	0xef, 0xc8, 0xff, 0xd7, 0xf3, 0x93, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x45, 0xa9, 0x32, 0xc2,
-	0x05, 0x24, 0x00, 0x00,
+	// 2415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x4b, 0x6f, 0x1c, 0xb9,
+	0x11, 0xde, 0x1e, 0x49, 0x96, 0x54, 0xa3, 0x07, 0x45, 0xbd, 0xa8, 0xa7, 0xe5, 0xb6, 0xec, 0x95,
+	0xb3, 0x89, 0x12, 0x28, 0x1b, 0x27, 0x59, 0x24, 0x07, 0x59, 0x1a, 0x3b, 0x03, 0xcb, 0xd2, 0x2c,
+	0x25, 0x6f, 0x16, 0x36, 0xb0, 0x46, 0x7b, 0x86, 0x1a, 0xf5, 0x7a, 0xa6, 0x7b, 0xb6, 0xd9, 0x63,
+	0x3b, 0x40, 0x80, 0xdc, 0x82, 0x00, 0x39, 0x07, 0xc8, 0x2d, 0x40, 0x80, 0x5c, 0xf2, 0x03, 0xf2,
+	0x1f, 0xf2, 0x2b, 0x72, 0xca, 0x39, 0xb7, 0x9c, 0x03, 0xb2, 0xc9, 0x9e, 0x7e, 0x90, 0x2d, 0xe5,
+	0x60, 0xdf, 0xc4, 0xea, 0xaa, 0xaf, 0x8a, 0x2c, 0xb2, 0x58, 0x1f, 0x47, 0x30, 0xd7, 0x67, 0x9c,
+	0x7b, 0x5d, 0xc6, 0xf7, 0x07, 0x51, 0x18, 0x87, 0x78, 0xae, 0xeb, 0x0d, 0xbb, 0x6c, 0x5f, 0x4b,
+	0xd7, 0x81, 0x0f, 0x58, 0x3b, 0xf9, 0xe6, 0x2e, 0x01, 0x7e, 0xea, 0xf7, 0x7a, 0xad, 0x28, 0x6c,
+	0x33, 0xce, 0x29, 0xfb, 0x6e, 0xc8, 0x78, 0xec, 0xfa, 0xb0, 0xda, 0x78, 0xcf, 0xda, 0xc3, 0xd8,
+	0x0f, 0x83, 0xf3, 0xd8, 0x8b, 0x87, 0x9c, 0x32, 0x3e, 0x08, 0x03, 0xce, 0xf0, 0x29, 0xcc, 0x33,
+	0xfd, 0x89, 0x32, 0x3e, 0xec, 0xc5, 0xc4, 0xd9, 0x71, 0xf6, 0xea, 0x07, 0xbb, 0xfb, 0x79, 0x37,
+	0xfb, 0x2d, 0xe1, 0xa0, 0x91, 0xd7, 0xa5, 0x45, 0x63, 0xb7, 0x0f, 0x24, 0xeb, 0x2a, 0x8a, 0xfd,
+	0xa0, 0xab, 0xc2, 0xc0, 0x5f, 0xc2, 0x52, 0x7b, 0x18, 0x45, 0x2c, 0x88, 0x53, 0x95, 0x66, 0x70,
+	0x19, 0x2a, 0x87, 0x5b, 0x45, 0x87, 0x39, 0x25, 0x6a, 0x34, 0x75, 0xdf, 0xc0, 0x4a, 0x2a, 0x68,
+	0x04, 0x9d, 0x0f, 0xeb, 0xec, 0x3b, 0xd8, 0x3c, 0x1f, 0xb0, 0xf6, 0xc7, 0x9c, 0x5f, 0x08, 0xeb,
+	0x39, 0x97, 0x1f, 0x7c, 0x8e, 0x43, 0xd8, 0x39, 0x6f, 0xb3, 0xc0, 0x8b, 0xfc, 0xf0, 0x63, 0xce,
+	0x93, 0xc3, 0x76, 0xc9, 0xed, 0x47, 0xc9, 0x67, 0xcc, 0x06, 0x1f, 0x3b, 0x9f, 0x59, 0x97, 0x1f,
+	0x7c, 0x8e, 0xff, 0x71, 0x60, 0x36, 0x27, 0xc1, 0x5f, 0x40, 0x5d, 0x69, 0x8a, 0x9d, 0xa5, 0xb0,
+	0x49, 0x11, 0x5b, 0x7c, 0x93, 0xb0, 0x59, 0x65, 0xfc, 0x18, 0xe6, 0xf5, 0x50, 0x65, 0x8b, 0xd4,
+	0xa4, 0xfd, 0x66, 0xc9, 0x5e, 0x7d, 0x97, 0x18, 0x45, 0xa3, 0x6c, 0x0c, 0x31, 0x1b, 0x90, 0x31,
+	0x4b, 0x0c, 0x31, 0x1b, 0xe4, 0x63, 0x88, 0xd9, 0x00, 0x6f, 0x03, 0xf0, 0xd8, 0x6b, 0xbf, 0x89,
+	0x23, 0xaf, 0xcd, 0xc8, 0xf8, 0x8e, 0xb3, 0x37, 0x4d, 0x33, 0x12, 0xf7, 0x5b, 0x98, 0xd2, 0xc1,
+	0x63, 0x0c, 0xe3, 0x81, 0xd7, 0x67, 0x72, 0x92, 0xd3, 0x54, 0xfe, 0x8d, 0xd7, 0x61, 0xea, 0xd2,
+	0xef, 0xb1, 0x53, 0x21, 0xaf, 0x49, 0x79, 0x3a, 0x16, 0xdf, 0x7c, 0xfe, 0xd8, 0xf3, 0x7b, 0xac,
+	0x23, 0x83, 0x9a, 0xa2, 0xe9, 0x58, 0x60, 0xc5, 0x5e, 0x97, 0x93, 0xf1, 0x9d, 0x31, 0x81, 0x25,
+	0xfe, 0x76, 0x29, 0xcc, 0x64, 0x27, 0x6a, 0xf3, 0x97, 0x62, 0xd6, 0x2c, 0x98, 0x63, 0x19, 0xcc,
+	0xbf, 0x3a, 0x30, 0xa5, 0x67, 0x8e, 0x1f, 0xc2, 0x38, 0x17, 0x2b, 0x94, 0x64, 0xc9, 0x35, 0xef,
+	0x00, 0x26, 0xd4, 0xd5, 0x1e, 0xa2, 0x52, 0xbf, 0xd2, 0xa9, 0x5e, 0xc0, 0x0b, 0xb9, 0x80, 0x63,
+	0x99, 0x05, 0x94, 0x12, 0xec, 0xc2, 0x0c, 0x8b, 0xa2, 0x30, 0x7a, 0x96, 0x78, 0x51, 0x4b, 0x9c,
+	0x93, 0xb9, 0xff, 0x74, 0x00, 0x97, 0x9d, 0xe3, 0xfb, 0x30, 0xe7, 0xb5, 0xe3, 0xa1, 0xd7, 0x13,
+	0xc2, 0x0b, 0xf6, 0x3e, 0x56, 0x2b, 0x51, 0x90, 0x0a, 0xbd, 0x81, 0x17, 0x71, 0xd6, 0x49, 0xf5,
+	0x92, 0x4c, 0x14, 0xa4, 0x78, 0x0f, 0xe6, 0xb9, 0x5a, 0x5f, 0x11, 0xbc, 0x1f, 0x74, 0x55, 0x5a,
+	0x8a, 0x62, 0xfc, 0x73, 0x80, 0x81, 0x17, 0x79, 0x7d, 0x16, 0xb3, 0x28, 0xc9, 0x51, 0xfd, 0x60,
+	0xad, 0x74, 0x85, 0x69, 0x0d, 0x9a, 0x51, 0x76, 0xff, 0xe2, 0xc0, 0xa2, 0xf0, 0xf8, 0x95, 0xd7,
+	0xf3, 0x3b, 0x5e, 0xcc, 0xf4, 0x64, 0xd6, 0x61, 0x8a, 0xe7, 0xa7, 0x91, 0x8e, 0xf1, 0x3e, 0xe0,
+	0x60, 0xd8, 0x7f, 0xcd, 0xa2, 0xb3, 0xcb, 0xd6, 0xc8, 0xad, 0x98, 0xc4, 0x04, 0x35, 0x7c, 0xc1,
+	0xbf, 0x80, 0x69, 0x9e, 0xb8, 0x18, 0x32, 0xb5, 0xdd, 0xb7, 0x8d, 0x17, 0xec, 0xb9, 0xd6, 0xa2,
+	0x23, 0x03, 0xf7, 0x4f, 0x35, 0x58, 0xca, 0x47, 0xa8, 0x6e, 0x6f, 0x02, 0x93, 0x3e, 0x97, 0x52,
+	0x19, 0xe1, 0x14, 0xd5, 0xc3, 0x52, 0x12, 0x6b, 0xe5, 0x24, 0xe2, 0x13, 0x98, 0x96, 0xe3, 0x8b,
+	0xdf, 0x0c, 0x92, 0xa0, 0xe6, 0x0e, 0xf6, 0x4d, 0x67, 0xb0, 0xe8, 0x76, 0xbf, 0xa1, 0xad, 0xe8,
+	0x08, 0x40, 0x6e, 0xab, 0x61, 0xb7, 0xcb, 0xb8, 0xa8, 0x34, 0xe9, 0xb9, 0x4c, 0x25, 0xee, 0x97,
+	0x30, 0x9d, 0xda, 0xe1, 0x3b, 0xb0, 0x75, 0x7e, 0xd1, 0x68, 0xbd, 0x6a, 0x3e, 0x6b, 0x9d, 0x34,
+	0x9e, 0x35, 0x4e, 0x2f, 0x0e, 0x2f, 0x9a, 0x67, 0xa7, 0xaf, 0x4e, 0xcf, 0x2e, 0x5e, 0x3d, 0x3e,
+	0x7b, 0x7e, 0x7a, 0x8c, 0x3e, 0x11, 0x2a, 0xc7, 0xcf, 0x5b, 0x27, 0xcd, 0xa3, 0xc3, 0x8b, 0xc6,
+	0x2b, 0x83, 0x32, 0x72, 0xdc, 0x17, 0xb0, 0x74, 0x3e, 0xf4, 0x63, 0x56, 0xe8, 0x4a, 0xf0, 0x23,
+	0xa8, 0x73, 0x21, 0xcf, 0x35, 0x34, 0x3b, 0xe6, 0xf5, 0x1e, 0xe9, 0xd1, 0xac, 0x91, 0xfb, 0x1c,
+	0x88, 0x09, 0xbb, 0x19, 0xb3, 0xbe, 0xd8, 0x6c, 0x51, 0x3a, 0x52, 0xf0, 0x6b, 0x46, 0x78, 0xa1,
+	0x40, 0x33, 0xca, 0x2e, 0x06, 0x24, 0x96, 0x54, 0x54, 0x9b, 0xb4, 0x3d, 0x7b, 0x00, 0x0b, 0x19,
+	0x99, 0x4a, 0xed, 0x12, 0x4c, 0x88, 0x0d, 0xc0, 0x89, 0x23, 0x6b, 0x43, 0x32, 0x70, 0xb7, 0x61,
+	0x53, 0x17, 0x9c, 0x63, 0x2f, 0xf6, 0xce, 0xe3, 0x30, 0x62, 0xcd, 0xc0, 0x8f, 0x35, 0xd4, 0x3a,
+	0x10, 0x51, 0xfc, 0x8c, 0xdf, 0x36, 0x60, 0x4d, 0xce, 0xc8, 0xf8, 0xf1, 0xd7, 0xb0, 0x90, 0x6e,
+	0xd7, 0x56, 0xc8, 0x7d, 0x31, 0x63, 0xbc, 0x03, 0xf5, 0xb0, 0xd7, 0xd1, 0x43, 0x39, 0xd1, 0x09,
+	0x9a, 0x15, 0x09, 0x8d, 0x80, 0xbd, 0x4b, 0x35, 0x92, 0x03, 0x90, 0x15, 0xb9, 0xbf, 0xaf, 0xc1,
+	0x3c, 0x65, 0x97, 0x5e, 0x3b, 0x0e, 0x23, 0x7d, 0xb2, 0x1e, 0xc1, 0x4c, 0xd8, 0xeb, 0xa4, 0x5b,
+	0x9d, 0x38, 0x37, 0x3a, 0x10, 0x39, 0x1b, 0x81, 0x11, 0xb0, 0x77, 0x23, 0x8c, 0xda, 0xcd, 0x30,
+	0xb2, 0x36, 0xb8, 0x29, 0xcb, 0x90, 0xd7, 0xd7, 0xc1, 0x26, 0x85, 0xb8, 0x7e, 0x70, 0xc7, 0x5a,
+	0x38, 0xb4, 0x26, 0x2d, 0x18, 0x8a, 0x85, 0xe0, 0xde, 0x5b, 0x76, 0x74, 0xe5, 0x05, 0x5d, 0xc6,
+	0xe5, 0xf6, 0x9f, 0xa2, 0x59, 0x91, 0xfb, 0x3b, 0xa8, 0x3f, 0xf6, 0x7b, 0x7a, 0x98, 0xbb, 0x86,
+	0x9c, 0xc2, 0x35, 0xb4, 0x0b, 0x75, 0xf1, 0xf7, 0x51, 0x18, 0xc4, 0x2c, 0x50, 0xb5, 0xf1, 0x51,
+	0x8d, 0x38, 0x34, 0x2b, 0xc6, 0xfb, 0x30, 0xd1, 0xf1, 0x2f, 0x2f, 0x75, 0xd0, 0xa5, 0xeb, 0x53,
+	0x14, 0xaa, 0x63, 0xff, 0xf2, 0x92, 0x26, 0x6a, 0xee, 0xdf, 0x1c, 0x40, 0xa3, 0x4c, 0x8c, 0x2a,
+	0x08, 0x1f, 0xb6, 0xdb, 0x8c, 0x73, 0x5d, 0x41, 0xd4, 0x50, 0x6c, 0x40, 0x79, 0xb8, 0x55, 0xe9,
+	0x48, 0x06, 0xa2, 0xae, 0x88, 0x18, 0x78, 0x32, 0x8d, 0x8e, 0xba, 0xb9, 0x72, 0x32, 0xfc, 0x4b,
+	0x15, 0x7e, 0xba, 0x16, 0x22, 0xbc, 0x8d, 0x62, 0x78, 0x99, 0xc5, 0xa0, 0x59, 0x7d, 0xf7, 0x87,
+	0x30, 0xaf, 0x8f, 0x83, 0xde, 0x30, 0x9b, 0xd9, 0xf2, 0x99, 0xac, 0xd6, 0x48, 0xe0, 0xfe, 0xc3,
+	0x19, 0x1d, 0xaa, 0x74, 0x62, 0xbb, 0x30, 0xeb, 0x73, 0x21, 0x6d, 0x45, 0x8c, 0x8b, 0x55, 0x4c,
+	0xa6, 0x97, 0x17, 0xea, 0x1a, 0xaf, 0x9a, 0x81, 0x31, 0x5d, 0xe3, 0x75, 0x33, 0x70, 0xe5, 0xf1,
+	0xc3, 0x9e, 0xef, 0x71, 0xdd, 0x0c, 0xe8, 0x71, 0x2e, 0x7b, 0xe3, 0x85, 0xec, 0xed, 0xc1, 0x38,
+	0x1f, 0x78, 0x01, 0x99, 0x90, 0x3b, 0x72, 0xa9, 0xdc, 0x59, 0x79, 0x01, 0x95, 0x1a, 0xee, 0x43,
+	0x58, 0x7f, 0x1e, 0xf0, 0xe1, 0x60, 0x10, 0x46, 0x31, 0xeb, 0xa8, 0xb2, 0x9c, 0x4d, 0x8d, 0x32,
+	0x52, 0x53, 0xd6, 0x43, 0xf7, 0xbf, 0x0e, 0xa0, 0x23, 0xaf, 0x7d, 0xc5, 0xc4, 0x1a, 0xea, 0x35,
+	0x22, 0x30, 0xd9, 0x56, 0x1b, 0x46, 0xa9, 0xab, 0xa1, 0x0e, 0xb6, 0xe5, 0xc5, 0x57, 0xd9, 0x8e,
+	0x47, 0x8c, 0x93, 0x46, 0xe1, 0xa8, 0x17, 0xf2, 0x6c, 0xc7, 0x93, 0x8c, 0xf1, 0x11, 0xdc, 0xe2,
+	0x92, 0x2d, 0xca, 0x29, 0xce, 0x1d, 0x7c, 0x56, 0x9c, 0x4a, 0x31, 0x06, 0x99, 0x53, 0x45, 0x30,
+	0x95, 0xa9, 0xfb, 0x14, 0x60, 0x24, 0xc5, 0x75, 0x98, 0x3c, 0xfa, 0xd5, 0xe1, 0xe9, 0x93, 0x86,
+	0xa8, 0xf0, 0x00, 0xb7, 0x8e, 0x4e, 0xce, 0xce, 0x1b, 0xc7, 0xc8, 0x91, 0x1f, 0x68, 0xe3, 0xf0,
+	0xa2, 0x71, 0x8c, 0x6a, 0x62, 0x70, 0xdc, 0x38, 0x69, 0x88, 0xc1, 0x98, 0xd0, 0x3a, 0x6b, 0x35,
+	0x4e, 0x1b, 0xc7, 0x68, 0xdc, 0x3d, 0x48, 0xee, 0xc1, 0xf4, 0xd8, 0x65, 0xae, 0xea, 0x74, 0x86,
+	0x4e, 0x7e, 0x86, 0xee, 0xbf, 0x1c, 0x58, 0x2e, 0x18, 0xa9, 0x05, 0xfe, 0x1a, 0x66, 0x79, 0xf6,
+	0x83, 0x2c, 0xb5, 0xf5, 0x83, 0x03, 0xd3, 0x1d, 0x58, 0xb2, 0xce, 0x49, 0x69, 0x1e, 0xc8, 0x7c,
+	0x76, 0xd6, 0xbf, 0x82, 0x99, 0xac, 0x51, 0xf5, 0xae, 0x4e, 0xb7, 0x51, 0xed, 0xda, 0x6d, 0x74,
+	0x1f, 0x76, 0x9b, 0xfd, 0x41, 0x8f, 0xf5, 0x59, 0x10, 0x7b, 0x02, 0x59, 0x2c, 0xf8, 0x93, 0x5e,
+	0xf8, 0xba, 0xe5, 0xc5, 0x31, 0x8b, 0x02, 0x5d, 0xe3, 0x9f, 0xc2, 0xbd, 0x6b, 0xf4, 0xd4, 0xc2,
+	0xb8, 0x30, 0xd3, 0x1d, 0x89, 0xf5, 0x15, 0x94, 0x93, 0xb9, 0xb7, 0x61, 0xab, 0x0c, 0x76, 0xe2,
+	0xf3, 0xf4, 0x46, 0x79, 0x01, 0xdb, 0x36, 0x05, 0xe5, 0xe6, 0x67, 0xb0, 0xea, 0x97, 0x34, 0x44,
+	0xce, 0xb4, 0x47, 0xdb, 0x67, 0xb7, 0x0f, 0x5b, 0xe7, 0xf1, 0xf0, 0x75, 0x1e, 0xff, 0x28, 0xec,
+	0xa4, 0x87, 0xe1, 0x21, 0xac, 0x98, 0x6d, 0xd5, 0x3a, 0x5b, 0xbe, 0x8a, 0xc4, 0xb5, 0xc3, 0x0e,
+	0xe3, 0xaa, 0x18, 0x24, 0x03, 0xf7, 0x14, 0xa6, 0x74, 0x31, 0x4d, 0xd3, 0xe2, 0x5c, 0x97, 0x96,
+	0xec, 0x81, 0xac, 0xe5, 0x0e, 0xa4, 0xfb, 0x0d, 0x4c, 0x09, 0x8f, 0x12, 0xaf, 0x62, 0xeb, 0xe2,
+	0x87, 0x30, 0x1d, 0x2b, 0xbf, 0x49, 0x44, 0x55, 0x55, 0x7e, 0xa4, 0xea, 0x7e, 0x0a, 0xd3, 0x4f,
+	0x19, 0x1b, 0x1c, 0xf6, 0xfc, 0xb7, 0xb2, 0x8c, 0x0d, 0x7a, 0xc3, 0xae, 0x1f, 0x34, 0x3b, 0xda,
+	0x81, 0x1e, 0xbb, 0x5b, 0xb0, 0x21, 0xda, 0x05, 0xdf, 0xeb, 0xc9, 0x1b, 0xae, 0x15, 0xb1, 0x4b,
+	0xff, 0xfd, 0xa8, 0x31, 0xf9, 0x02, 0x36, 0xcd, 0x9f, 0x55, 0x02, 0x05, 0xb4, 0x92, 0xa9, 0x8c,
+	0xa5, 0x63, 0xf7, 0xcf, 0xf7, 0x60, 0x52, 0x37, 0x9a, 0x0d, 0xa8, 0xab, 0x78, 0x65, 0xab, 0xe9,
+	0xc8, 0x6a, 0x72, 0xb7, 0x38, 0x13, 0xa5, 0xbd, 0xff, 0x6c, 0xa4, 0x4a, 0xb3, 0x76, 0xe2, 0xbc,
+	0xa8, 0x61, 0x33, 0x61, 0x35, 0x63, 0x74, 0x24, 0xc0, 0x1d, 0x20, 0xcc, 0xc2, 0xe4, 0x55, 0xc7,
+	0xbd, 0x67, 0x25, 0xd0, 0x05, 0x7d, 0x6a, 0x45, 0xc2, 0x03, 0xd8, 0xe4, 0x15, 0x6f, 0x40, 0xb2,
+	0x52, 0xd6, 0x0f, 0xbe, 0x6f, 0xa2, 0xd3, 0x56, 0x6f, 0x95, 0x88, 0xf8, 0x5b, 0x58, 0xe7, 0xd6,
+	0x27, 0x20, 0x75, 0xc9, 0x7c, 0xaf, 0xd2, 0x5f, 0xce, 0x82, 0x56, 0xa0, 0xe1, 0xdf, 0xc2, 0x0e,
+	0xbf, 0xe6, 0xf5, 0x87, 0xdc, 0x92, 0x1e, 0x7f, 0x64, 0x23, 0xfc, 0xd6, 0x59, 0x5e, 0x8b, 0x8c,
+	0xdf, 0xc2, 0x36, 0xaf, 0x7c, 0x04, 0x22, 0x93, 0xd2, 0xf7, 0xfe, 0xb5, 0xbe, 0xf3, 0x33, 0xbe,
+	0x06, 0x55, 0xe6, 0xb4, 0xe2, 0x1d, 0x88, 0x4c, 0x59, 0x72, 0x5a, 0x61, 0x43, 0x2b, 0x11, 0x65,
+	0x4e, 0xad, 0xcf, 0x40, 0x64, 0xda, 0x92, 0x53, 0xab, 0x05, 0xad, 0x40, 0xc3, 0x14, 0x30, 0x2b,
+	0x31, 0x75, 0x02, 0x37, 0x7e, 0x50, 0x30, 0x58, 0xe3, 0x6f, 0x60, 0x85, 0x99, 0x63, 0xaf, 0x4b,
+	0xdc, 0xfb, 0xd6, 0x93, 0x96, 0x8f, 0xdb, 0x82, 0x82, 0x9f, 0xc3, 0x22, 0x2f, 0x33, 0x72, 0x32,
+	0x23, 0xc1, 0xef, 0x56, 0x73, 0xd4, 0x04, 0xd9, 0x64, 0x8f, 0xbf, 0x86, 0x25, 0x6e, 0xe0, 0xb3,
+	0x64, 0xd6, 0xfc, 0xe2, 0x6d, 0xe2, 0xbe, 0xd4, 0x88, 0x80, 0x3d, 0x58, 0x65, 0xe6, 0x17, 0x76,
+	0x32, 0x27, 0xc1, 0x3f, 0xad, 0xaa, 0x3d, 0x19, 0x75, 0x6a, 0xc3, 0xc1, 0x27, 0x80, 0x78, 0x81,
+	0x39, 0x92, 0x79, 0x33, 0xb3, 0x2d, 0x32, 0x4c, 0x5a, 0xb2, 0xc4, 0x67, 0xb0, 0xc0, 0x8b, 0x9c,
+	0x93, 0xa0, 0x1d, 0xc7, 0xc4, 0x7e, 0x4a, 0xe4, 0x94, 0x96, 0x6d, 0xe5, 0xda, 0x1a, 0xf8, 0x32,
+	0x59, 0xb0, 0xac, 0xad, 0x41, 0x97, 0x1a, 0x11, 0xc4, 0x06, 0x7e, 0x53, 0xfa, 0x4d, 0x83, 0x60,
+	0xf3, 0x06, 0x2e, 0xff, 0xfa, 0x41, 0x0d, 0xd6, 0xf2, 0xc8, 0x57, 0xf0, 0x68, 0xb2, 0x68, 0x39,
+	0xf2, 0x15, 0x36, 0xb4, 0x12, 0x51, 0x5c, 0x4f, 0xdc, 0xc2, 0xcc, 0xc9, 0x92, 0xf9, 0x7a, 0xb2,
+	0x31, 0x79, 0x6a, 0x45, 0xc2, 0x5d, 0x58, 0xe3, 0x36, 0x8e, 0x4f, 0x96, 0xa5, 0x9b, 0x07, 0xc6,
+	0x54, 0x18, 0xfd, 0xd8, 0xb1, 0x70, 0x13, 0xe6, 0x79, 0x9e, 0xa4, 0x91, 0x15, 0x09, 0x7f, 0xdb,
+	0xb6, 0x7b, 0x34, 0x68, 0xd1, 0x2e, 0xbb, 0xb1, 0xd3, 0x9d, 0xb8, 0x5a, 0xbd, 0xb1, 0xd3, 0x8d,
+	0x58, 0xb2, 0x14, 0x81, 0x45, 0xf9, 0xe7, 0x06, 0x42, 0xcc, 0x81, 0x15, 0x5e, 0x25, 0x68, 0xd1,
+	0x4e, 0x04, 0x16, 0x15, 0xf8, 0x32, 0x59, 0x33, 0x07, 0x56, 0xe4, 0xd5, 0xb4, 0x64, 0x29, 0x6a,
+	0xfe, 0xd0, 0x4a, 0xf6, 0xc8, 0xba, 0xb9, 0xe6, 0xdb, 0xe9, 0x21, 0xad, 0x40, 0x13, 0x91, 0xb7,
+	0x0b, 0xdc, 0x8c, 0x6c, 0x98, 0x23, 0x2f, 0x72, 0x38, 0x5a, 0xb2, 0xd4, 0x65, 0xb3, 0xc8, 0xba,
+	0xc8, 0xa6, 0xbd, 0x6c, 0x16, 0x75, 0xa9, 0x11, 0x01, 0xbf, 0x84, 0x65, 0x6e, 0x22, 0x57, 0x64,
+	0x4b, 0x42, 0xdf, 0xbb, 0x11, 0x13, 0xa3, 0x66, 0x0c, 0xcc, 0x61, 0xcb, 0xaf, 0x62, 0x28, 0x64,
+	0x5b, 0x3a, 0xf9, 0x41, 0xd1, 0x49, 0x25, 0xad, 0xa1, 0xd5, 0x98, 0xa2, 0x87, 0xf1, 0x2b, 0x59,
+	0x0f, 0xb9, 0x6d, 0xee, 0x61, 0xaa, 0xb9, 0x12, 0xbd, 0x06, 0x55, 0x4c, 0x96, 0x57, 0x31, 0x22,
+	0xb2, 0x63, 0x9e, 0x6c, 0x25, 0x8d, 0xa2, 0xd5, 0x98, 0xf8, 0xf3, 0x84, 0xbb, 0x08, 0xd2, 0x41,
+	0xee, 0x98, 0x7f, 0xc3, 0xd1, 0x3c, 0x87, 0xa6, 0x9a, 0xf8, 0x0f, 0x0e, 0xec, 0xfa, 0x37, 0xe0,
+	0xab, 0xc4, 0x95, 0x90, 0x9f, 0x5f, 0xbf, 0x52, 0x65, 0x5b, 0x7a, 0x23, 0x0f, 0xf8, 0x8f, 0x0e,
+	0xdc, 0xf3, 0x6f, 0x42, 0x89, 0xc9, 0x5d, 0x19, 0xcb, 0x4f, 0xfe, 0xcf, 0x58, 0x54, 0xf2, 0x6e,
+	0xe6, 0x43, 0x5e, 0x11, 0x96, 0x27, 0x67, 0xb2, 0x6b, 0xb9, 0x22, 0x2c, 0xfa, 0xd4, 0x8a, 0x84,
+	0x7f, 0x0a, 0xd3, 0x6f, 0x34, 0x39, 0x24, 0xf7, 0xcc, 0x6f, 0xd7, 0x29, 0x7b, 0xa4, 0x23, 0x5d,
+	0xdc, 0x87, 0x0d, 0x6e, 0x27, 0x8b, 0xe4, 0x85, 0x84, 0xfa, 0xcc, 0x74, 0x89, 0x59, 0x4c, 0x68,
+	0x15, 0x9e, 0x66, 0x5a, 0x36, 0xf2, 0x49, 0x5e, 0xda, 0x99, 0x96, 0xcd, 0x86, 0x56, 0x22, 0xba,
+	0xff, 0x9e, 0x84, 0x7a, 0x86, 0x7c, 0xe2, 0x65, 0x58, 0x28, 0x75, 0xf0, 0xe8, 0x13, 0xbc, 0x06,
+	0xcb, 0x46, 0x3a, 0x87, 0x1c, 0xbc, 0x0a, 0x8b, 0x06, 0xe6, 0x85, 0x6a, 0x78, 0x0b, 0xd6, 0xac,
+	0x04, 0x09, 0x8d, 0xe1, 0x0d, 0x58, 0xb5, 0x70, 0x18, 0x34, 0x2e, 0xfd, 0x99, 0xc8, 0x04, 0x9a,
+	0x90, 0xfe, 0xca, 0x9d, 0x3f, 0xba, 0x85, 0xe7, 0xa1, 0x9e, 0x69, 0xe5, 0xd1, 0x24, 0x5e, 0x84,
+	0xf9, 0xa2, 0xd6, 0x94, 0x36, 0x2f, 0xb4, 0xc9, 0x68, 0x1a, 0x13, 0xf3, 0xef, 0x4d, 0x08, 0x44,
+	0xa4, 0x96, 0xce, 0x15, 0xd5, 0xf1, 0x52, 0xf9, 0xc7, 0x0d, 0x34, 0x23, 0x96, 0xb1, 0xd4, 0x41,
+	0xa2, 0x59, 0xbc, 0x62, 0xfa, 0x57, 0x15, 0x34, 0x27, 0x7d, 0x1b, 0xf6, 0x2e, 0x9a, 0x97, 0x0b,
+	0x61, 0x6a, 0xb1, 0x10, 0x92, 0x3e, 0x8a, 0x3d, 0x11, 0x5a, 0x10, 0x3e, 0xca, 0xdd, 0x0d, 0xc2,
+	0x62, 0x35, 0x0a, 0x6d, 0x09, 0x5a, 0xcc, 0x46, 0x9f, 0x86, 0xb9, 0x24, 0x54, 0x0b, 0x8d, 0x02,
+	0x5a, 0x16, 0xaa, 0xc5, 0x1b, 0x1f, 0xad, 0xe0, 0xed, 0xaa, 0xe7, 0x5c, 0xb4, 0x2a, 0xac, 0x8a,
+	0xb7, 0x2d, 0x22, 0x7a, 0xad, 0x8b, 0x77, 0x23, 0x5a, 0xd3, 0x89, 0x2f, 0xdd, 0x6c, 0x68, 0x5d,
+	0xfc, 0x38, 0x56, 0x79, 0x4d, 0xa1, 0x0d, 0xec, 0x5e, 0xf7, 0xfe, 0x86, 0x36, 0x05, 0x4c, 0xe5,
+	0x05, 0x80, 0xb6, 0xf0, 0xcc, 0xe8, 0xad, 0x0a, 0x6d, 0xe3, 0xbd, 0x9b, 0x3d, 0x35, 0xa2, 0xdb,
+	0xf8, 0xc1, 0x0d, 0x1f, 0x1b, 0xd1, 0x0e, 0xde, 0xb4, 0xff, 0xd4, 0x86, 0xee, 0xe0, 0xd9, 0xcc,
+	0x63, 0x16, 0x72, 0xf1, 0xed, 0xca, 0x27, 0x2b, 0xf4, 0x02, 0xef, 0x54, 0x3f, 0x5a, 0xa1, 0x97,
+	0x8f, 0x1e, 0xc0, 0x4a, 0x3b, 0xec, 0xef, 0xc7, 0x57, 0xe1, 0xb0, 0x7b, 0x15, 0xbf, 0x0b, 0xa3,
+	0x37, 0x3c, 0xa9, 0x22, 0x7f, 0xaf, 0xcd, 0x3d, 0xf1, 0x86, 0xa3, 0x27, 0x28, 0xfe, 0xfa, 0x96,
+	0xfc, 0xb7, 0xaa, 0x1f, 0xff, 0x6f, 0x00, 0xa0, 0xd8, 0x39, 0xad, 0x84, 0x25, 0x00, 0x00,
 }
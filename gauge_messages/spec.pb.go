@@ -520,7 +520,9 @@ type ProtoScenario struct {
 	// / Capture Screenshot at pre hook exec time to be available on reports
 	PreHookScreenshots [][]byte `protobuf:"bytes,19,rep,name=preHookScreenshots,proto3" json:"preHookScreenshots,omitempty"`
 	// / Capture Screenshot at post hook exec time to be available on reports
-	PostHookScreenshots  [][]byte `protobuf:"bytes,20,rep,name=postHookScreenshots,proto3" json:"postHookScreenshots,omitempty"`
+	PostHookScreenshots [][]byte `protobuf:"bytes,20,rep,name=postHookScreenshots,proto3" json:"postHookScreenshots,omitempty"`
+	// / Flag to indicate that the scenario is executed for a data table row not selected by --table-rows, it is skipped and not counted
+	ExcludedTableRow     bool     `protobuf:"varint,90,opt,name=excludedTableRow,proto3" json:"excludedTableRow,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -695,6 +697,13 @@ func (m *ProtoScenario) GetPostHookScreenshots() [][]byte {
 	return nil
 }
 
+func (m *ProtoScenario) GetExcludedTableRow() bool {
+	if m != nil {
+		return m.ExcludedTableRow
+	}
+	return false
+}
+
 // / A proto object representing a Span of content
 type Span struct {
 	Start                int64    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
@@ -1628,10 +1637,14 @@ type ProtoSuiteResult struct {
 	// Indicates if the result is sent in chunks
 	Chunked bool `protobuf:"varint,19,opt,name=chunked,proto3" json:"chunked,omitempty"`
 	// Indicates the number of chunks to expect after this
-	ChunkSize            int64    `protobuf:"varint,20,opt,name=chunkSize,proto3" json:"chunkSize,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ChunkSize int64 `protobuf:"varint,20,opt,name=chunkSize,proto3" json:"chunkSize,omitempty"`
+	// / Result of the contexts of the suite spec, executed once before the specs
+	SuiteSetup *ProtoScenario `protobuf:"bytes,90,opt,name=suiteSetup,proto3" json:"suiteSetup,omitempty"`
+	// / Result of the teardown steps of the suite spec, executed once after the specs
+	SuiteTeardown        *ProtoScenario `protobuf:"bytes,91,opt,name=suiteTeardown,proto3" json:"suiteTeardown,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *ProtoSuiteResult) Reset()         { *m = ProtoSuiteResult{} }
@@ -1801,6 +1814,20 @@ func (m *ProtoSuiteResult) GetChunkSize() int64 {
 	return 0
 }
 
+func (m *ProtoSuiteResult) GetSuiteSetup() *ProtoScenario {
+	if m != nil {
+		return m.SuiteSetup
+	}
+	return nil
+}
+
+func (m *ProtoSuiteResult) GetSuiteTeardown() *ProtoScenario {
+	if m != nil {
+		return m.SuiteTeardown
+	}
+	return nil
+}
+
 // / A proto object representing the result of Spec execution.
 type ProtoSpecResult struct {
 	// / Represents the corresponding Specification
@@ -2082,133 +2109,136 @@ func init() {
 func init() { proto.RegisterFile("spec.proto", fileDescriptor_423806180556987f) }
 
 var fileDescriptor_423806180556987f = []byte{
-	// 2043 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdd, 0x6e, 0x23, 0x49,
-	0xf5, 0x4f, 0xbb, 0xed, 0xd8, 0x3e, 0xfe, 0x48, 0xa7, 0x92, 0x9d, 0x7f, 0xff, 0x47, 0xc3, 0x8c,
-	0xd5, 0xca, 0x6a, 0xcd, 0x68, 0x36, 0x0c, 0x59, 0x98, 0x15, 0x42, 0x02, 0x65, 0x63, 0x67, 0xc7,
-	0x30, 0x9b, 0x89, 0xca, 0x66, 0x84, 0xf6, 0x66, 0xe9, 0xe9, 0x54, 0x92, 0xde, 0xd8, 0xdd, 0x56,
-	0x77, 0x79, 0x26, 0xbb, 0x0f, 0xc0, 0x03, 0x70, 0xc3, 0x3b, 0x70, 0xcb, 0x0b, 0x20, 0x21, 0x71,
-	0x83, 0xb4, 0x8f, 0x00, 0x57, 0xdc, 0xf0, 0x10, 0x08, 0xd5, 0xa9, 0xaa, 0xfe, 0x72, 0x3b, 0xb1,
-	0x11, 0x17, 0xdc, 0x55, 0x9d, 0x8f, 0xaa, 0x3a, 0x55, 0xe7, 0xe3, 0x77, 0x0a, 0x20, 0x9e, 0x33,
-	0xef, 0x70, 0x1e, 0x85, 0x3c, 0x24, 0xdd, 0x2b, 0x77, 0x71, 0xc5, 0x0e, 0x67, 0x2c, 0x8e, 0xdd,
-	0x2b, 0x16, 0x3b, 0xff, 0xaa, 0x42, 0xf3, 0x5c, 0x70, 0xc6, 0x73, 0xe6, 0x91, 0x1e, 0xb4, 0x84,
-	0xec, 0x4b, 0xe6, 0x5e, 0xf8, 0xc1, 0x95, 0x6d, 0xf4, 0x8c, 0x7e, 0x93, 0x66, 0x49, 0xe4, 0x07,
-	0x50, 0xf3, 0x39, 0x9b, 0xc5, 0x76, 0xa5, 0x67, 0xf6, 0x5b, 0x47, 0xff, 0x7f, 0x98, 0x5f, 0xef,
-	0x10, 0xd7, 0x1a, 0x71, 0x36, 0xa3, 0x52, 0x8e, 0x1c, 0x40, 0xc7, 0x8f, 0x27, 0xee, 0xdb, 0x29,
-	0x1b, 0x44, 0xfe, 0x3b, 0x16, 0xd8, 0x66, 0xcf, 0xe8, 0x37, 0x68, 0x9e, 0x48, 0x7e, 0x01, 0x3b,
-	0xf3, 0x88, 0xbd, 0x0c, 0xc3, 0x9b, 0x53, 0xd7, 0x9f, 0x2e, 0x22, 0x16, 0xdb, 0x55, 0xdc, 0xa0,
-	0x57, 0xba, 0x41, 0x46, 0x90, 0x16, 0x15, 0xc9, 0x2b, 0xb0, 0xe6, 0x61, 0xcc, 0x73, 0x8b, 0xd5,
-	0xd6, 0x5c, 0x6c, 0x49, 0x93, 0x3c, 0x84, 0xc6, 0xa5, 0x3f, 0x65, 0x67, 0xee, 0x8c, 0xd9, 0xdb,
-	0x78, 0x1f, 0xc9, 0x9c, 0x10, 0xa8, 0x72, 0xf7, 0x2a, 0xb6, 0xeb, 0x3d, 0xb3, 0xdf, 0xa4, 0x38,
-	0x26, 0xfd, 0xc4, 0x92, 0x2f, 0xd4, 0x2e, 0x76, 0x03, 0xd9, 0x45, 0x32, 0x79, 0x9a, 0x9e, 0x33,
-	0x11, 0x6d, 0xa2, 0xe8, 0x12, 0x9d, 0x3c, 0x85, 0x6e, 0x5e, 0xdd, 0x06, 0x21, 0xf9, 0x59, 0xc5,
-	0x36, 0x68, 0x81, 0x43, 0x9e, 0xc1, 0x4e, 0x41, 0xdf, 0x6e, 0x25, 0xc2, 0x45, 0x16, 0x39, 0x04,
-	0xa2, 0xf4, 0xc7, 0x5e, 0xc4, 0x58, 0x10, 0x5f, 0x87, 0x3c, 0xb6, 0xdb, 0x3d, 0xb3, 0xdf, 0xa6,
-	0x25, 0x1c, 0xf2, 0x1c, 0xf6, 0xf4, 0x12, 0x59, 0x85, 0x0e, 0x2a, 0x94, 0xb1, 0xc8, 0x23, 0x68,
-	0x0a, 0x57, 0x38, 0x09, 0x17, 0x01, 0xb7, 0xbb, 0x3d, 0xa3, 0x6f, 0xd2, 0x94, 0xe0, 0xfc, 0x53,
-	0x3b, 0xa0, 0x70, 0x1a, 0xf2, 0x33, 0x68, 0x08, 0xd6, 0xe4, 0x9b, 0x39, 0x43, 0xef, 0xeb, 0x1e,
-	0x39, 0x2b, 0x3d, 0xec, 0x70, 0xa4, 0x24, 0x69, 0xa2, 0x43, 0x3e, 0x86, 0x6a, 0xcc, 0xd9, 0xdc,
-	0xae, 0xf4, 0x8c, 0x95, 0xde, 0x39, 0xe6, 0x6c, 0x4e, 0x51, 0x8c, 0xbc, 0x80, 0xba, 0x17, 0x06,
-	0x1e, 0x9b, 0x73, 0x74, 0xcb, 0xd6, 0xd1, 0xa3, 0x52, 0x8d, 0x13, 0x29, 0x43, 0xb5, 0x30, 0xf9,
-	0x09, 0x34, 0x62, 0x8f, 0x05, 0x6e, 0xe4, 0x87, 0x76, 0x15, 0x15, 0xbf, 0x57, 0xbe, 0x95, 0x12,
-	0xa2, 0x89, 0x38, 0xf9, 0x12, 0xf6, 0x78, 0xea, 0xf8, 0x5a, 0xc0, 0xae, 0xe1, 0x2a, 0xfd, 0xd2,
-	0x55, 0x26, 0xcb, 0xf2, 0xb4, 0x6c, 0x11, 0x69, 0xce, 0x6c, 0xc6, 0x02, 0x8e, 0xae, 0xba, 0xda,
-	0x1c, 0x94, 0xa1, 0x5a, 0x98, 0x3c, 0x87, 0x1a, 0x2e, 0x67, 0xd7, 0x51, 0xeb, 0xe1, 0xea, 0x53,
-	0x50, 0x29, 0x28, 0xee, 0x19, 0x3d, 0xbf, 0x71, 0xc7, 0x3d, 0x4f, 0xdc, 0xab, 0x58, 0x05, 0x45,
-	0x36, 0x88, 0x9a, 0xf9, 0x20, 0x72, 0xbe, 0x86, 0x86, 0x7e, 0x48, 0xd2, 0x80, 0xaa, 0x78, 0x1d,
-	0x6b, 0x8b, 0xb4, 0xa0, 0xae, 0x8e, 0x69, 0x19, 0x72, 0x82, 0x37, 0x6f, 0x55, 0x48, 0x1b, 0x1a,
-	0xda, 0x60, 0xcb, 0x24, 0xff, 0x07, 0x7b, 0x25, 0xd7, 0x63, 0x55, 0x49, 0x13, 0x6a, 0xc8, 0xb0,
-	0x6a, 0x62, 0x55, 0x71, 0x16, 0x6b, 0xdb, 0xf9, 0x63, 0x1d, 0x3a, 0xb9, 0x87, 0x11, 0xe1, 0xaa,
-	0x9f, 0x26, 0x9f, 0xf5, 0x8a, 0x64, 0xf2, 0x10, 0xb6, 0x2f, 0x5d, 0x7f, 0xca, 0x2e, 0xd0, 0xb9,
-	0x1a, 0x18, 0x4d, 0x8a, 0x42, 0x7e, 0x0c, 0x0d, 0x2f, 0x0c, 0x38, 0xbb, 0xe5, 0xb1, 0x6d, 0xde,
-	0x97, 0x18, 0x13, 0x51, 0xf2, 0x73, 0xe8, 0xe8, 0x5d, 0x46, 0x98, 0x54, 0xab, 0xf7, 0xe9, 0xe6,
-	0xe5, 0xc9, 0xcb, 0x24, 0x2d, 0xa8, 0x7c, 0xa5, 0xfc, 0xe8, 0xfe, 0x44, 0x57, 0xd0, 0xc3, 0x04,
-	0x9c, 0x4f, 0x7d, 0xca, 0x85, 0xd6, 0x49, 0xc0, 0x79, 0xc5, 0xd2, 0xb4, 0x78, 0x00, 0x1d, 0x76,
-	0xcb, 0xbc, 0x05, 0xf7, 0xc3, 0x60, 0xe2, 0xcf, 0x18, 0x7a, 0x8e, 0x49, 0xf3, 0x44, 0xf2, 0x08,
-	0xea, 0xf1, 0x8d, 0x3f, 0x9f, 0xb3, 0x0b, 0x74, 0x13, 0x79, 0xc9, 0x9a, 0x44, 0x1e, 0x03, 0x88,
-	0xe1, 0x30, 0x8a, 0xc2, 0x28, 0x96, 0x09, 0x90, 0x66, 0x28, 0xa4, 0x0b, 0x95, 0xd1, 0xc0, 0x6e,
-	0xe1, 0xf3, 0x55, 0x46, 0x03, 0x71, 0xbd, 0x9c, 0xb9, 0xd1, 0x20, 0x7c, 0x1f, 0x08, 0xaf, 0x92,
-	0x59, 0xed, 0xee, 0xeb, 0xcd, 0xc9, 0x93, 0x3e, 0x54, 0xe3, 0xb9, 0x1b, 0xd8, 0x1d, 0xbc, 0x89,
-	0xfd, 0xa2, 0xde, 0x78, 0xee, 0x06, 0x14, 0x25, 0xc8, 0x08, 0x76, 0x12, 0x4b, 0xc6, 0xdc, 0xe5,
-	0x8b, 0x18, 0x33, 0x5d, 0xf7, 0xe8, 0x49, 0x51, 0x69, 0x98, 0x17, 0xa3, 0x45, 0xbd, 0xb2, 0x02,
-	0xb2, 0xb3, 0x7e, 0x01, 0xb1, 0xd6, 0x2e, 0x20, 0xbb, 0x9b, 0x14, 0x10, 0xb2, 0x69, 0x01, 0xd9,
-	0xdb, 0xb4, 0x80, 0xec, 0xaf, 0x2c, 0x20, 0xce, 0x25, 0x54, 0xc5, 0x55, 0x93, 0x7d, 0xa8, 0xc5,
-	0xdc, 0x8d, 0x38, 0x46, 0xa8, 0x49, 0xe5, 0x84, 0x58, 0x60, 0xb2, 0x40, 0x06, 0xa5, 0x49, 0xc5,
-	0x50, 0x14, 0x1c, 0x64, 0x9d, 0x5c, 0xbb, 0x11, 0xe6, 0x75, 0x93, 0xa6, 0x04, 0x62, 0x43, 0x9d,
-	0x05, 0x17, 0xc8, 0xab, 0x22, 0x4f, 0x4f, 0x9d, 0xbf, 0x57, 0xc0, 0x5e, 0x95, 0x70, 0x73, 0x29,
-	0xdf, 0xd8, 0x2c, 0xe5, 0x1f, 0x40, 0x07, 0xb3, 0x26, 0x0d, 0xdf, 0x8f, 0x82, 0x0b, 0x76, 0x8b,
-	0x67, 0xad, 0xd1, 0x3c, 0x91, 0xfc, 0x08, 0x3e, 0xd0, 0x1a, 0x93, 0x9c, 0xb4, 0x89, 0xd2, 0xe5,
-	0x4c, 0xf2, 0x0c, 0x76, 0xfd, 0x58, 0x60, 0xb7, 0x2c, 0xc4, 0xaa, 0x22, 0xc4, 0x5a, 0x66, 0x88,
-	0x3d, 0xfc, 0x78, 0x9c, 0x5d, 0x48, 0x69, 0xd4, 0x50, 0xa3, 0x9c, 0x49, 0x5e, 0xc2, 0xae, 0xde,
-	0x7c, 0xe0, 0x72, 0x17, 0x59, 0x2a, 0x3b, 0xdc, 0x55, 0x2a, 0x96, 0x95, 0x9c, 0xdf, 0x9b, 0x1a,
-	0x6d, 0x8a, 0xea, 0xfb, 0x18, 0xc0, 0xf5, 0xf8, 0xc2, 0x9d, 0x4e, 0xd8, 0x2d, 0x57, 0x69, 0x37,
-	0x43, 0x11, 0xfc, 0xb9, 0x1b, 0xc5, 0xec, 0x02, 0xf9, 0x15, 0xc9, 0x4f, 0x29, 0xe4, 0x05, 0x34,
-	0x2f, 0x23, 0xf7, 0x4a, 0x14, 0x09, 0x9d, 0x76, 0xed, 0xe2, 0x79, 0x4e, 0x95, 0x00, 0x4d, 0x45,
-	0x45, 0x09, 0x16, 0xd5, 0x3f, 0x89, 0x44, 0xca, 0xe2, 0xc5, 0x94, 0xab, 0x42, 0xde, 0x5f, 0x89,
-	0x19, 0x0a, 0xf2, 0xb4, 0x6c, 0x91, 0xb2, 0xe8, 0xad, 0xad, 0x1f, 0xbd, 0xdb, 0x2b, 0xa2, 0xb7,
-	0x3c, 0xc6, 0xea, 0x9b, 0xc6, 0x58, 0x63, 0x75, 0x8c, 0xfd, 0xcd, 0x80, 0x76, 0x16, 0xeb, 0x90,
-	0x9f, 0x42, 0x4b, 0xa1, 0x1d, 0x61, 0xbb, 0x72, 0xf9, 0x3b, 0x00, 0x55, 0x56, 0x5a, 0x74, 0x09,
-	0x31, 0x66, 0xdc, 0xfb, 0xbb, 0x04, 0x94, 0x23, 0xbf, 0x81, 0x07, 0x4a, 0xbf, 0xf8, 0x2a, 0xe6,
-	0x86, 0xaf, 0xb2, 0x62, 0x1d, 0xe7, 0x89, 0xf2, 0x3c, 0x81, 0x04, 0x92, 0x0a, 0x65, 0xa4, 0x15,
-	0xca, 0xf9, 0xab, 0x01, 0x0d, 0xed, 0x2d, 0x64, 0x04, 0x6d, 0xed, 0x2f, 0x19, 0x2c, 0xfa, 0xe1,
-	0x2a, 0xef, 0x4a, 0x06, 0x08, 0x47, 0x73, 0xaa, 0xb8, 0x57, 0xea, 0xbf, 0x38, 0x26, 0x9f, 0x42,
-	0x73, 0xee, 0x46, 0xee, 0x8c, 0x71, 0x16, 0x29, 0x0b, 0x97, 0xef, 0x48, 0x0b, 0xd0, 0x54, 0xd6,
-	0xf9, 0x08, 0xda, 0xd9, 0xad, 0x10, 0xda, 0xb0, 0x5b, 0x6e, 0x6d, 0x91, 0x0e, 0x34, 0x13, 0x0d,
-	0xcb, 0x70, 0x7e, 0x57, 0xc9, 0xcc, 0xc9, 0x17, 0xd0, 0x49, 0xd6, 0xc8, 0xd8, 0xf3, 0xd1, 0xca,
-	0x3d, 0xd3, 0x11, 0x5a, 0x94, 0xd7, 0x16, 0x89, 0xf8, 0x9d, 0x3b, 0x5d, 0x30, 0x65, 0x93, 0x9c,
-	0x08, 0x43, 0x03, 0x01, 0xf0, 0x4c, 0x69, 0xa8, 0x18, 0xa7, 0xc8, 0xb2, 0xba, 0x26, 0xb2, 0x74,
-	0xbe, 0x84, 0x4e, 0x6e, 0x6f, 0x02, 0xb0, 0x2d, 0x2a, 0xa3, 0xef, 0x49, 0x54, 0x38, 0xf8, 0x26,
-	0x70, 0x67, 0xbe, 0x67, 0x19, 0x84, 0x40, 0x57, 0xe4, 0x37, 0xdf, 0x9d, 0x7e, 0x35, 0xe6, 0x91,
-	0x1f, 0x5c, 0x59, 0x15, 0xb2, 0x0b, 0x1d, 0x4d, 0x93, 0xe8, 0xcf, 0x4c, 0x81, 0x60, 0xd5, 0x71,
-	0x12, 0x1f, 0x97, 0xb8, 0x57, 0x3f, 0x8d, 0x91, 0x3e, 0x8d, 0x73, 0x0b, 0x90, 0x1e, 0x8a, 0x7c,
-	0x0a, 0xf5, 0x6b, 0xe6, 0x5e, 0xb0, 0x28, 0xbe, 0x33, 0xe9, 0xeb, 0x9c, 0x4c, 0xb5, 0x34, 0xf9,
-	0x21, 0x54, 0xa3, 0xf0, 0xbd, 0x0e, 0x80, 0x7b, 0xb4, 0x50, 0xd4, 0xf9, 0x50, 0x61, 0x53, 0x4d,
-	0x16, 0xd7, 0xec, 0xb1, 0xe9, 0x54, 0xbb, 0xa9, 0x9c, 0x38, 0x7f, 0xd2, 0x55, 0xaa, 0xc4, 0xfb,
-	0xc9, 0x59, 0x06, 0x87, 0xa8, 0x00, 0x92, 0xe7, 0x3e, 0x28, 0x3d, 0x41, 0x31, 0x78, 0x8a, 0xca,
-	0x25, 0x00, 0xb3, 0xf2, 0xdf, 0x03, 0x98, 0xe6, 0x7f, 0x0a, 0x30, 0xed, 0x14, 0x26, 0xca, 0x52,
-	0x97, 0x40, 0xc4, 0x03, 0xe8, 0xa8, 0x21, 0x65, 0x6e, 0x1c, 0xca, 0xc2, 0xd6, 0xa4, 0x79, 0xa2,
-	0xf3, 0x9d, 0x09, 0xfb, 0x65, 0xf6, 0x93, 0x07, 0x09, 0xc6, 0x37, 0x70, 0x5d, 0x8d, 0xef, 0x9f,
-	0x82, 0x15, 0x31, 0x2f, 0x7c, 0xc7, 0x22, 0xf1, 0x36, 0x08, 0x37, 0x65, 0x17, 0x40, 0x97, 0xe8,
-	0xc4, 0x81, 0x36, 0x13, 0x03, 0x0d, 0x9d, 0x64, 0x38, 0xe4, 0x68, 0x88, 0x64, 0xb9, 0xeb, 0xdd,
-	0x4c, 0x22, 0xd7, 0x93, 0xb1, 0x21, 0x90, 0x6c, 0x42, 0x21, 0x0e, 0x40, 0x8c, 0xc9, 0x79, 0x7c,
-	0x1d, 0x72, 0xb4, 0xa1, 0x8d, 0xe0, 0x2b, 0x43, 0x5d, 0x46, 0xd4, 0xdb, 0x65, 0x88, 0xda, 0x86,
-	0xba, 0xba, 0x58, 0x05, 0xc7, 0xf5, 0x94, 0xbc, 0x82, 0x26, 0x9e, 0x09, 0xf3, 0x41, 0x03, 0xf3,
-	0xc1, 0xe1, 0x3a, 0x4e, 0x72, 0x38, 0xd4, 0x5a, 0x34, 0x5d, 0x40, 0xe0, 0x90, 0x4b, 0xf9, 0x3a,
-	0x69, 0x55, 0x41, 0x0c, 0xdf, 0xa6, 0xcb, 0x0c, 0xfc, 0x67, 0xca, 0xd4, 0x25, 0xc0, 0xba, 0x94,
-	0x25, 0x39, 0xcf, 0xa0, 0x99, 0xec, 0x23, 0x72, 0xdb, 0xf1, 0x78, 0x3c, 0xa4, 0x93, 0xd1, 0xeb,
-	0x33, 0x6b, 0x8b, 0x58, 0xd0, 0x7e, 0x33, 0xa4, 0xa3, 0xd3, 0xd1, 0xc9, 0x31, 0x52, 0x0c, 0xe7,
-	0x3b, 0x03, 0xac, 0xa2, 0xdb, 0x14, 0x2e, 0xd9, 0x28, 0xb9, 0xe4, 0xfc, 0x43, 0x55, 0x4a, 0x1e,
-	0x2a, 0xff, 0x10, 0xe6, 0xaa, 0x87, 0xc8, 0xc3, 0xbb, 0x6a, 0x19, 0xbc, 0x2b, 0xbd, 0xa0, 0xda,
-	0x8a, 0x0b, 0x72, 0xfe, 0xb1, 0xad, 0x0c, 0x1a, 0x2f, 0x7c, 0xce, 0x94, 0x77, 0x1e, 0xcb, 0xdf,
-	0x39, 0x39, 0x93, 0x59, 0xa1, 0xb5, 0xdc, 0x60, 0x24, 0xbf, 0x79, 0x2a, 0xa6, 0xb3, 0x3a, 0xff,
-	0xa3, 0xf1, 0x9c, 0x86, 0x5d, 0xb5, 0x18, 0x76, 0xe2, 0xf0, 0xf1, 0x29, 0x4e, 0xe5, 0x07, 0x52,
-	0x0d, 0x2f, 0x77, 0x89, 0xbe, 0x66, 0x38, 0x08, 0xc7, 0x5b, 0x78, 0x1e, 0x8b, 0x63, 0xea, 0x72,
-	0xf9, 0xdf, 0x51, 0xa1, 0x59, 0x92, 0x90, 0x60, 0xc1, 0x3b, 0x3f, 0x0a, 0x03, 0xfc, 0x47, 0x69,
-	0xc8, 0x2f, 0xd0, 0x0c, 0x29, 0x01, 0x0f, 0x4d, 0x55, 0x35, 0x04, 0xa0, 0xe8, 0x41, 0x6b, 0x1e,
-	0x85, 0x5f, 0x33, 0x8f, 0xe3, 0x1f, 0x07, 0x48, 0xad, 0x0c, 0x49, 0x34, 0x25, 0xdc, 0x9f, 0xb1,
-	0x98, 0xbb, 0xb3, 0xb9, 0xea, 0x51, 0x53, 0x82, 0xf0, 0x0e, 0xb4, 0x68, 0x2c, 0xf3, 0x94, 0x34,
-	0xb5, 0x8d, 0xa6, 0x2e, 0x33, 0xca, 0x40, 0x66, 0x67, 0x7d, 0x90, 0xd9, 0x5d, 0xbb, 0x45, 0xdc,
-	0xd9, 0xa4, 0x45, 0xb4, 0x36, 0x6d, 0x11, 0x77, 0x37, 0x85, 0xaf, 0x64, 0xf5, 0x1f, 0xa3, 0x0d,
-	0x75, 0xef, 0x7a, 0x11, 0xdc, 0xb0, 0x0b, 0x7b, 0x4f, 0x56, 0x04, 0x35, 0x15, 0xf7, 0x8e, 0xc3,
-	0xb1, 0xff, 0x2d, 0xb3, 0xf7, 0x65, 0x33, 0x98, 0x10, 0x9c, 0x3f, 0x9b, 0xb0, 0x53, 0x08, 0x18,
-	0x04, 0x67, 0x9a, 0x74, 0x37, 0xee, 0x15, 0x3a, 0xa9, 0x2c, 0x16, 0x1f, 0xd5, 0xf2, 0xc8, 0x07,
-	0x54, 0x7d, 0x5e, 0x8e, 0x28, 0x8c, 0xd3, 0x84, 0xac, 0x5f, 0xcb, 0x2e, 0xaf, 0x8c, 0xb5, 0x32,
-	0x3c, 0x9e, 0xc3, 0x9e, 0x1c, 0x25, 0x0d, 0x16, 0x15, 0x90, 0x43, 0xf4, 0x1b, 0x35, 0x5a, 0xc6,
-	0x5a, 0xbf, 0x66, 0xe8, 0xf2, 0x5a, 0xcf, 0x97, 0xd7, 0x23, 0xd8, 0xd7, 0x07, 0xcc, 0x79, 0x6a,
-	0x03, 0x0f, 0x5f, 0xca, 0x43, 0x1d, 0x39, 0xcf, 0x1f, 0xb3, 0x89, 0xc7, 0x2c, 0xe5, 0x91, 0x8f,
-	0x61, 0x9b, 0xa5, 0xbf, 0x3c, 0xad, 0xa3, 0x0f, 0x96, 0x7e, 0x51, 0x04, 0x97, 0x2a, 0x21, 0xe7,
-	0x2f, 0x06, 0xd4, 0x64, 0xf1, 0xfd, 0x04, 0xaa, 0x3c, 0xc5, 0xb7, 0x4f, 0x4a, 0xd5, 0x32, 0x05,
-	0x0c, 0x85, 0xf5, 0xef, 0x24, 0x82, 0xd7, 0x4a, 0xfa, 0x3b, 0x89, 0x00, 0xf6, 0x31, 0xc0, 0xd4,
-	0x0f, 0xd8, 0xd9, 0x62, 0xf6, 0x56, 0x41, 0xf5, 0x1a, 0xcd, 0x50, 0xb2, 0xf5, 0x55, 0x96, 0x71,
-	0x3d, 0x75, 0x8e, 0xb2, 0x15, 0x6c, 0x07, 0x5a, 0xe7, 0xc7, 0x74, 0x3c, 0xfc, 0x6a, 0x48, 0xe9,
-	0x6b, 0x6a, 0x6d, 0x91, 0x7d, 0xb0, 0xde, 0x1c, 0xbf, 0x1a, 0x0d, 0xb0, 0x82, 0x29, 0xaa, 0xe1,
-	0xfc, 0xd6, 0x80, 0x6e, 0x82, 0xed, 0xde, 0x20, 0xaa, 0xc6, 0xcf, 0x0c, 0x35, 0x51, 0x45, 0x2c,
-	0x25, 0x90, 0x17, 0xf0, 0x20, 0x81, 0xe6, 0xfe, 0xb7, 0xec, 0x22, 0xd1, 0x53, 0x86, 0xac, 0xe0,
-	0xaa, 0xd6, 0x5a, 0x72, 0x64, 0xef, 0x2c, 0x5b, 0x6b, 0x45, 0x79, 0xfa, 0x39, 0xec, 0x14, 0x3e,
-	0xaa, 0x84, 0x09, 0x67, 0xaf, 0x27, 0xc3, 0x5f, 0x0f, 0x4f, 0x7e, 0x35, 0x19, 0x0e, 0xac, 0x2d,
-	0x01, 0xcc, 0xcf, 0x45, 0x59, 0x1e, 0x58, 0x86, 0x18, 0x9f, 0x1e, 0x8f, 0x5e, 0x0d, 0x07, 0x56,
-	0x45, 0x80, 0xf4, 0xf1, 0x2f, 0x47, 0xe7, 0xe7, 0xc3, 0x81, 0x65, 0x7e, 0xf6, 0x7d, 0xd1, 0xd8,
-	0xcd, 0x0e, 0xf9, 0x75, 0xb8, 0xb8, 0xba, 0xe6, 0xef, 0xc3, 0xe8, 0x26, 0x96, 0x8f, 0xf2, 0x87,
-	0x4a, 0xf7, 0x73, 0x7c, 0x1c, 0x9d, 0x7f, 0xde, 0x6e, 0x63, 0x24, 0x7d, 0xf2, 0xef, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x62, 0x96, 0xcc, 0xd0, 0xaf, 0x1a, 0x00, 0x00,
+	// 2082 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdb, 0x6e, 0x1c, 0x49,
+	0x19, 0x4e, 0x4f, 0xf7, 0x9c, 0xfe, 0x39, 0xb8, 0x53, 0xf6, 0x86, 0x26, 0x0a, 0x9b, 0x51, 0x2b,
+	0xab, 0x35, 0x51, 0x76, 0x08, 0x5e, 0xc8, 0x0a, 0x21, 0x40, 0x5e, 0xcf, 0x78, 0x33, 0x90, 0x4d,
+	0xac, 0x9a, 0x21, 0x42, 0xe1, 0x62, 0xe9, 0xf4, 0x94, 0xed, 0x5e, 0xcf, 0x74, 0xb7, 0xba, 0x6b,
+	0x62, 0xef, 0x3e, 0x00, 0x0f, 0xc0, 0x0d, 0xef, 0xc0, 0x53, 0x20, 0x21, 0x71, 0x83, 0xb4, 0x8f,
+	0xc0, 0x5e, 0xc3, 0x43, 0x20, 0x54, 0x7f, 0x55, 0x1f, 0xa7, 0xc7, 0x1e, 0x23, 0x2e, 0xf6, 0xae,
+	0xea, 0x3f, 0xd4, 0xe1, 0xaf, 0xff, 0xf0, 0xfd, 0x05, 0x10, 0x87, 0xcc, 0x1d, 0x86, 0x51, 0xc0,
+	0x03, 0xd2, 0x3f, 0x73, 0x56, 0x67, 0x6c, 0xb8, 0x64, 0x71, 0xec, 0x9c, 0xb1, 0xd8, 0xfe, 0x8f,
+	0x01, 0xed, 0x13, 0xc1, 0x99, 0x86, 0xcc, 0x25, 0x03, 0xe8, 0x08, 0xd9, 0xe7, 0xcc, 0x99, 0x7b,
+	0xfe, 0x99, 0xa5, 0x0d, 0xb4, 0xfd, 0x36, 0xcd, 0x93, 0xc8, 0x8f, 0xa0, 0xee, 0x71, 0xb6, 0x8c,
+	0xad, 0xda, 0x40, 0xdf, 0xef, 0x1c, 0x7c, 0x7f, 0x58, 0x5c, 0x6f, 0x88, 0x6b, 0x4d, 0x38, 0x5b,
+	0x52, 0x29, 0x47, 0x1e, 0x41, 0xcf, 0x8b, 0x67, 0xce, 0xdb, 0x05, 0x1b, 0x45, 0xde, 0x3b, 0xe6,
+	0x5b, 0xfa, 0x40, 0xdb, 0x6f, 0xd1, 0x22, 0x91, 0xfc, 0x1a, 0x76, 0xc2, 0x88, 0x3d, 0x0f, 0x82,
+	0x8b, 0x63, 0xc7, 0x5b, 0xac, 0x22, 0x16, 0x5b, 0x06, 0x6e, 0x30, 0xa8, 0xdc, 0x20, 0x27, 0x48,
+	0xcb, 0x8a, 0xe4, 0x05, 0x98, 0x61, 0x10, 0xf3, 0xc2, 0x62, 0xf5, 0x2d, 0x17, 0x5b, 0xd3, 0x24,
+	0xf7, 0xa1, 0x75, 0xea, 0x2d, 0xd8, 0x4b, 0x67, 0xc9, 0xac, 0x06, 0xda, 0x23, 0x9d, 0x13, 0x02,
+	0x06, 0x77, 0xce, 0x62, 0xab, 0x39, 0xd0, 0xf7, 0xdb, 0x14, 0xc7, 0x64, 0x3f, 0xbd, 0xc9, 0xe7,
+	0x6a, 0x17, 0xab, 0x85, 0xec, 0x32, 0x99, 0x3c, 0xce, 0xce, 0x99, 0x8a, 0xb6, 0x51, 0x74, 0x8d,
+	0x4e, 0x1e, 0x43, 0xbf, 0xa8, 0x6e, 0x81, 0x90, 0xfc, 0xb4, 0x66, 0x69, 0xb4, 0xc4, 0x21, 0x4f,
+	0x60, 0xa7, 0xa4, 0x6f, 0x75, 0x52, 0xe1, 0x32, 0x8b, 0x0c, 0x81, 0x28, 0xfd, 0xa9, 0x1b, 0x31,
+	0xe6, 0xc7, 0xe7, 0x01, 0x8f, 0xad, 0xee, 0x40, 0xdf, 0xef, 0xd2, 0x0a, 0x0e, 0x79, 0x0a, 0xbb,
+	0xc9, 0x12, 0x79, 0x85, 0x1e, 0x2a, 0x54, 0xb1, 0xc8, 0x03, 0x68, 0x0b, 0x57, 0x38, 0x0a, 0x56,
+	0x3e, 0xb7, 0xfa, 0x03, 0x6d, 0x5f, 0xa7, 0x19, 0xc1, 0xfe, 0x77, 0xe2, 0x80, 0xc2, 0x69, 0xc8,
+	0x2f, 0xa1, 0x25, 0x58, 0xb3, 0xaf, 0x42, 0x86, 0xde, 0xd7, 0x3f, 0xb0, 0x37, 0x7a, 0xd8, 0x70,
+	0xa2, 0x24, 0x69, 0xaa, 0x43, 0x3e, 0x02, 0x23, 0xe6, 0x2c, 0xb4, 0x6a, 0x03, 0x6d, 0xa3, 0x77,
+	0x4e, 0x39, 0x0b, 0x29, 0x8a, 0x91, 0x67, 0xd0, 0x74, 0x03, 0xdf, 0x65, 0x21, 0x47, 0xb7, 0xec,
+	0x1c, 0x3c, 0xa8, 0xd4, 0x38, 0x92, 0x32, 0x34, 0x11, 0x26, 0x3f, 0x83, 0x56, 0xec, 0x32, 0xdf,
+	0x89, 0xbc, 0xc0, 0x32, 0x50, 0xf1, 0x07, 0xd5, 0x5b, 0x29, 0x21, 0x9a, 0x8a, 0x93, 0x37, 0xb0,
+	0xcb, 0x33, 0xc7, 0x4f, 0x04, 0xac, 0x3a, 0xae, 0xb2, 0x5f, 0xb9, 0xca, 0x6c, 0x5d, 0x9e, 0x56,
+	0x2d, 0x22, 0xaf, 0xb3, 0x5c, 0x32, 0x9f, 0x5b, 0x8d, 0x6b, 0xaf, 0x83, 0x32, 0x34, 0x11, 0x26,
+	0x4f, 0xa1, 0x8e, 0xcb, 0x59, 0x4d, 0xd4, 0xba, 0xbf, 0xf9, 0x14, 0x54, 0x0a, 0x0a, 0x3b, 0xa3,
+	0xe7, 0xb7, 0xae, 0xb1, 0xf3, 0xcc, 0x39, 0x8b, 0x55, 0x50, 0xe4, 0x83, 0xa8, 0x5d, 0x0c, 0x22,
+	0xfb, 0x4b, 0x68, 0x25, 0x0f, 0x49, 0x5a, 0x60, 0x88, 0xd7, 0x31, 0xef, 0x90, 0x0e, 0x34, 0xd5,
+	0x31, 0x4d, 0x4d, 0x4e, 0xd0, 0xf2, 0x66, 0x8d, 0x74, 0xa1, 0x95, 0x5c, 0xd8, 0xd4, 0xc9, 0xf7,
+	0x60, 0xb7, 0xc2, 0x3c, 0xa6, 0x41, 0xda, 0x50, 0x47, 0x86, 0x59, 0x17, 0xab, 0x8a, 0xb3, 0x98,
+	0x0d, 0xfb, 0xdb, 0x26, 0xf4, 0x0a, 0x0f, 0x23, 0xc2, 0x35, 0x79, 0x9a, 0x62, 0xd6, 0x2b, 0x93,
+	0xc9, 0x7d, 0x68, 0x9c, 0x3a, 0xde, 0x82, 0xcd, 0xd1, 0xb9, 0x5a, 0x18, 0x4d, 0x8a, 0x42, 0x7e,
+	0x0a, 0x2d, 0x37, 0xf0, 0x39, 0xbb, 0xe2, 0xb1, 0xa5, 0xdf, 0x94, 0x18, 0x53, 0x51, 0xf2, 0x2b,
+	0xe8, 0x25, 0xbb, 0x4c, 0x30, 0xa9, 0x1a, 0x37, 0xe9, 0x16, 0xe5, 0xc9, 0xf3, 0x34, 0x2d, 0xa8,
+	0x7c, 0xa5, 0xfc, 0xe8, 0xe6, 0x44, 0x57, 0xd2, 0xc3, 0x04, 0x5c, 0x4c, 0x7d, 0x56, 0x63, 0xcb,
+	0xa5, 0xca, 0x8a, 0x95, 0x69, 0xf1, 0x11, 0xf4, 0xd8, 0x15, 0x73, 0x57, 0xdc, 0x0b, 0xfc, 0x99,
+	0xb7, 0x64, 0xe8, 0x39, 0x3a, 0x2d, 0x12, 0xc9, 0x03, 0x68, 0xc6, 0x17, 0x5e, 0x18, 0xb2, 0xb9,
+	0xd5, 0x4e, 0x8d, 0x9c, 0x90, 0xc8, 0xfb, 0x00, 0x62, 0x38, 0x8e, 0xa2, 0x20, 0x8a, 0x65, 0x02,
+	0xa4, 0x39, 0x0a, 0xe9, 0x43, 0x6d, 0x32, 0xb2, 0x3a, 0xf8, 0x7c, 0xb5, 0xc9, 0x48, 0x98, 0x97,
+	0x33, 0x27, 0x1a, 0x05, 0x97, 0xbe, 0xf0, 0x2a, 0x99, 0xd5, 0xae, 0x37, 0x6f, 0x41, 0x9e, 0xec,
+	0x83, 0x11, 0x87, 0x8e, 0x6f, 0xf5, 0xd0, 0x12, 0x7b, 0x65, 0xbd, 0x69, 0xe8, 0xf8, 0x14, 0x25,
+	0xc8, 0x04, 0x76, 0xd2, 0x9b, 0x4c, 0xb9, 0xc3, 0x57, 0x31, 0x66, 0xba, 0xfe, 0xc1, 0xc3, 0xb2,
+	0xd2, 0xb8, 0x28, 0x46, 0xcb, 0x7a, 0x55, 0x05, 0x64, 0x67, 0xfb, 0x02, 0x62, 0x6e, 0x5d, 0x40,
+	0xee, 0xde, 0xa6, 0x80, 0x90, 0xdb, 0x16, 0x90, 0xdd, 0xdb, 0x16, 0x90, 0xbd, 0xcd, 0x05, 0xe4,
+	0x31, 0x98, 0xec, 0xca, 0x5d, 0xac, 0xe6, 0x6c, 0x2e, 0x93, 0x50, 0x70, 0x69, 0xbd, 0x41, 0x14,
+	0xb1, 0x46, 0xb7, 0x4f, 0xc1, 0x10, 0xcf, 0x42, 0xf6, 0xa0, 0x1e, 0x73, 0x27, 0xe2, 0x18, 0xcd,
+	0x3a, 0x95, 0x13, 0x62, 0x82, 0xce, 0x7c, 0x19, 0xc0, 0x3a, 0x15, 0x43, 0x51, 0x9c, 0x90, 0x75,
+	0x74, 0xee, 0x44, 0x58, 0x03, 0x74, 0x9a, 0x11, 0x88, 0x05, 0x4d, 0xe6, 0xcf, 0x91, 0x67, 0x20,
+	0x2f, 0x99, 0xda, 0xdf, 0xd6, 0xc0, 0xda, 0x94, 0x9c, 0x0b, 0xe5, 0x41, 0xbb, 0x5d, 0x79, 0x78,
+	0x04, 0x3d, 0xae, 0xee, 0x32, 0xf1, 0xe7, 0xec, 0x0a, 0xcf, 0x5a, 0xa7, 0x45, 0x22, 0xf9, 0x09,
+	0xbc, 0x97, 0x68, 0xcc, 0x0a, 0xd2, 0x3a, 0x4a, 0x57, 0x33, 0xc9, 0x13, 0xb8, 0xeb, 0xc5, 0x02,
+	0xe7, 0xe5, 0xe1, 0x98, 0x81, 0x86, 0x5c, 0x67, 0x88, 0x3d, 0xbc, 0x78, 0x9a, 0x5f, 0x48, 0x69,
+	0xd4, 0x51, 0xa3, 0x9a, 0x49, 0x9e, 0xc3, 0xdd, 0x64, 0xf3, 0x91, 0xc3, 0x1d, 0x64, 0x59, 0x8d,
+	0x1b, 0xcb, 0xca, 0xba, 0x92, 0xfd, 0x67, 0x3d, 0x41, 0xa6, 0xa2, 0x52, 0xbf, 0x0f, 0xe0, 0xb8,
+	0x7c, 0xe5, 0x2c, 0x66, 0xec, 0x8a, 0xab, 0x14, 0x9d, 0xa3, 0x08, 0x7e, 0xe8, 0x44, 0x31, 0x9b,
+	0x23, 0xbf, 0x26, 0xf9, 0x19, 0x85, 0x3c, 0x83, 0xf6, 0x69, 0xe4, 0x9c, 0x89, 0x82, 0x92, 0xa4,
+	0x68, 0xab, 0x7c, 0x9e, 0x63, 0x25, 0x40, 0x33, 0x51, 0x51, 0xae, 0x63, 0xce, 0xc2, 0x34, 0x6a,
+	0x29, 0x8b, 0x57, 0x0b, 0x6e, 0x19, 0xd7, 0x94, 0xeb, 0xe9, 0xba, 0x3c, 0xad, 0x5a, 0xa4, 0x2a,
+	0xd2, 0xeb, 0xdb, 0x47, 0x7a, 0x63, 0x43, 0xa4, 0x57, 0xc7, 0x63, 0xf3, 0xb6, 0xf1, 0xd8, 0xda,
+	0x18, 0x8f, 0xf6, 0x3f, 0x35, 0xe8, 0xe6, 0x71, 0x11, 0xf9, 0x39, 0x74, 0x14, 0x32, 0x12, 0x77,
+	0x57, 0x2e, 0x7f, 0x0d, 0xf8, 0xca, 0x4b, 0x8b, 0x8e, 0x22, 0xc6, 0xec, 0x7c, 0x73, 0x47, 0x81,
+	0x72, 0xe4, 0x0f, 0x70, 0x4f, 0xe9, 0x97, 0x5f, 0x45, 0xbf, 0xe5, 0xab, 0x6c, 0x58, 0xc7, 0x7e,
+	0xa8, 0x3c, 0x4f, 0xa0, 0x86, 0xb4, 0x9a, 0x69, 0x59, 0x35, 0xb3, 0xff, 0xa1, 0x41, 0x2b, 0xf1,
+	0x16, 0x32, 0x81, 0x6e, 0xe2, 0x2f, 0x39, 0xdc, 0xfa, 0xc1, 0x26, 0xef, 0x1a, 0x1e, 0xe7, 0x84,
+	0x69, 0x41, 0x15, 0xf7, 0xca, 0xfc, 0x17, 0xc7, 0xe4, 0x13, 0x68, 0x87, 0x4e, 0xe4, 0x2c, 0x19,
+	0x67, 0x91, 0xba, 0xe1, 0xba, 0x8d, 0x12, 0x01, 0x9a, 0xc9, 0xda, 0x1f, 0x42, 0x37, 0xbf, 0x15,
+	0xc2, 0x20, 0x76, 0xc5, 0xcd, 0x3b, 0xa4, 0x07, 0xed, 0x54, 0xc3, 0xd4, 0xec, 0x3f, 0xd5, 0x72,
+	0x73, 0xf2, 0x39, 0xf4, 0xd2, 0x35, 0x72, 0xf7, 0xf9, 0x70, 0xe3, 0x9e, 0xc3, 0x93, 0xbc, 0x38,
+	0x2d, 0x6a, 0x8b, 0x44, 0xfc, 0xce, 0x59, 0xac, 0x98, 0xba, 0x93, 0x9c, 0x88, 0x8b, 0xfa, 0x02,
+	0x0c, 0xea, 0xf2, 0xa2, 0x62, 0x9c, 0xa1, 0x50, 0x63, 0x4b, 0x14, 0x6a, 0xbf, 0x81, 0x5e, 0x61,
+	0x6f, 0x02, 0xd0, 0x10, 0x55, 0xd4, 0x73, 0x25, 0x82, 0x1c, 0x7d, 0xe5, 0x3b, 0x4b, 0xcf, 0x35,
+	0x35, 0x42, 0xa0, 0x2f, 0xf2, 0x9b, 0xe7, 0x2c, 0xbe, 0x98, 0xf2, 0xc8, 0xf3, 0xcf, 0xcc, 0x1a,
+	0xb9, 0x0b, 0xbd, 0x84, 0x26, 0x91, 0xa2, 0x9e, 0x81, 0x46, 0xc3, 0xb6, 0x53, 0x1f, 0x97, 0x18,
+	0x39, 0x79, 0x1a, 0x2d, 0x7b, 0x1a, 0xfb, 0x0a, 0x20, 0x3b, 0x14, 0xf9, 0x04, 0x9a, 0xe7, 0xcc,
+	0x99, 0xb3, 0x28, 0xbe, 0x36, 0xe9, 0x27, 0x39, 0x99, 0x26, 0xd2, 0xe4, 0xc7, 0x60, 0x44, 0xc1,
+	0x65, 0x12, 0x00, 0x37, 0x68, 0xa1, 0xa8, 0xfd, 0x01, 0xf4, 0x0a, 0x64, 0x61, 0x66, 0x97, 0x2d,
+	0x16, 0x89, 0x9b, 0xca, 0x89, 0xfd, 0xd7, 0xa4, 0x4a, 0x55, 0x78, 0x3f, 0x79, 0x99, 0xc3, 0x2c,
+	0x2a, 0x80, 0xe4, 0xb9, 0x1f, 0x55, 0x9e, 0xa0, 0x1c, 0x3c, 0x65, 0xe5, 0x0a, 0x30, 0x5a, 0xfb,
+	0xff, 0x81, 0x51, 0xfd, 0x7f, 0x05, 0xa3, 0x56, 0x06, 0x29, 0x65, 0xa9, 0x4b, 0xa6, 0xa2, 0xd4,
+	0xaa, 0x21, 0x65, 0x4e, 0x1c, 0xc8, 0xc2, 0xd6, 0xa6, 0x45, 0xa2, 0xfd, 0x8d, 0x0e, 0x7b, 0x55,
+	0xf7, 0x27, 0xf7, 0xd2, 0x7e, 0x40, 0xc3, 0x75, 0xd5, 0x4c, 0xe4, 0xea, 0x88, 0xb9, 0xc1, 0x3b,
+	0x16, 0x89, 0xb7, 0x41, 0x68, 0x2a, 0x3b, 0x06, 0xba, 0x46, 0x27, 0x36, 0x74, 0x99, 0x18, 0x24,
+	0x30, 0x4b, 0x86, 0x43, 0x81, 0x86, 0xa8, 0x97, 0x3b, 0xee, 0xc5, 0x2c, 0x72, 0x5c, 0x19, 0x1b,
+	0x6d, 0x9a, 0xa3, 0x10, 0x1b, 0x20, 0xc6, 0xe4, 0x3c, 0x3d, 0x0f, 0x38, 0xde, 0xa1, 0x8b, 0x40,
+	0x2d, 0x47, 0x5d, 0x47, 0xdf, 0x8d, 0x2a, 0xf4, 0x6d, 0x41, 0x53, 0x19, 0x56, 0x41, 0xf7, 0x64,
+	0x4a, 0x5e, 0x40, 0x1b, 0xcf, 0x84, 0xf9, 0xa0, 0x85, 0xf9, 0x60, 0xb8, 0x8d, 0x93, 0x0c, 0xc7,
+	0x89, 0x16, 0xcd, 0x16, 0x10, 0x38, 0xe4, 0x54, 0xbe, 0x4e, 0x56, 0x55, 0x10, 0xef, 0x77, 0xe9,
+	0x3a, 0x03, 0xff, 0xa4, 0x72, 0x75, 0x09, 0xb0, 0x2e, 0xe5, 0x49, 0xf6, 0x13, 0x68, 0xa7, 0xfb,
+	0x88, 0xdc, 0x76, 0x38, 0x9d, 0x8e, 0xe9, 0x6c, 0xf2, 0xea, 0xa5, 0x79, 0x87, 0x98, 0xd0, 0x7d,
+	0x3d, 0xa6, 0x93, 0xe3, 0xc9, 0xd1, 0x21, 0x52, 0x34, 0xfb, 0x1b, 0x0d, 0xcc, 0xb2, 0xdb, 0x94,
+	0x8c, 0xac, 0x55, 0x18, 0xb9, 0xf8, 0x50, 0xb5, 0x8a, 0x87, 0x2a, 0x3e, 0x84, 0xbe, 0xe9, 0x21,
+	0x8a, 0xf0, 0xce, 0xa8, 0x82, 0x77, 0x95, 0x06, 0xaa, 0x6f, 0x30, 0x90, 0xfd, 0xaf, 0xa6, 0xba,
+	0xd0, 0x74, 0xe5, 0x71, 0xa6, 0xbc, 0xf3, 0x50, 0xfe, 0xe4, 0xc9, 0x99, 0xcc, 0x0a, 0x9d, 0xf5,
+	0x66, 0x24, 0xfd, 0xf9, 0x53, 0x31, 0x9d, 0xd7, 0xf9, 0x8e, 0xc6, 0x73, 0x16, 0x76, 0x46, 0x39,
+	0xec, 0xc4, 0xe1, 0xe3, 0x63, 0x9c, 0xca, 0xcf, 0xa6, 0x3a, 0x1a, 0x77, 0x8d, 0xbe, 0x65, 0x38,
+	0x08, 0xc7, 0x5b, 0xb9, 0x2e, 0x8b, 0x63, 0xea, 0x70, 0xf9, 0x37, 0x52, 0xa3, 0x79, 0x92, 0x90,
+	0x60, 0xfe, 0x3b, 0x2f, 0x0a, 0x7c, 0xfc, 0x73, 0x69, 0xc9, 0xef, 0xd2, 0x1c, 0x29, 0x05, 0x0f,
+	0x6d, 0x55, 0x35, 0x04, 0xa0, 0x18, 0x40, 0x27, 0x8c, 0x82, 0x2f, 0x99, 0xcb, 0xf1, 0x3f, 0x04,
+	0xa4, 0x56, 0x8e, 0x24, 0x9a, 0x12, 0xee, 0x2d, 0x59, 0xcc, 0x9d, 0x65, 0xa8, 0xfa, 0xd9, 0x8c,
+	0x20, 0xbc, 0x03, 0x6f, 0x34, 0x95, 0x79, 0x4a, 0x5e, 0xb5, 0x8b, 0x57, 0x5d, 0x67, 0x54, 0x81,
+	0xcc, 0xde, 0xf6, 0x20, 0xb3, 0xbf, 0x75, 0x3b, 0xb9, 0x73, 0x9b, 0x76, 0xd2, 0xbc, 0x6d, 0x3b,
+	0x79, 0xf7, 0xb6, 0xf0, 0x95, 0x6c, 0x6e, 0x27, 0x2d, 0x68, 0xba, 0xe7, 0x2b, 0xff, 0x82, 0xcd,
+	0xad, 0x5d, 0x59, 0x11, 0xd4, 0x54, 0xd8, 0x1d, 0x87, 0x53, 0xef, 0x6b, 0x66, 0xed, 0xc9, 0x66,
+	0x30, 0x25, 0x90, 0x5f, 0x00, 0xc4, 0x22, 0xc2, 0xa6, 0x8c, 0xaf, 0x42, 0x6c, 0x40, 0x6f, 0xec,
+	0xeb, 0x72, 0x0a, 0xe4, 0x08, 0x7a, 0x38, 0x9b, 0x31, 0x27, 0x9a, 0x07, 0x97, 0xbe, 0xf5, 0xfb,
+	0x6d, 0x56, 0x28, 0xea, 0xd8, 0x7f, 0xd3, 0x61, 0xa7, 0x14, 0xb4, 0x08, 0x10, 0x13, 0xd2, 0xf5,
+	0xd8, 0x5b, 0xe8, 0x64, 0xb2, 0x58, 0x00, 0xd5, 0x3e, 0xd2, 0x89, 0x54, 0xaf, 0x59, 0x20, 0x0a,
+	0x03, 0x27, 0x84, 0x7c, 0x6c, 0xc9, 0x4e, 0xb3, 0x8a, 0xb5, 0x31, 0x44, 0x9f, 0xc2, 0xae, 0x1c,
+	0xa5, 0x4d, 0x1e, 0x0d, 0x2e, 0x65, 0xcf, 0x53, 0xa7, 0x55, 0xac, 0xed, 0xeb, 0x56, 0x52, 0xe2,
+	0x9b, 0xc5, 0x12, 0x7f, 0x00, 0x7b, 0xc9, 0x01, 0x0b, 0xd1, 0xd2, 0xc2, 0xc3, 0x57, 0xf2, 0x50,
+	0x47, 0xce, 0x8b, 0xc7, 0x6c, 0xe3, 0x31, 0x2b, 0x79, 0xe4, 0x23, 0x68, 0xb0, 0xec, 0x57, 0xaa,
+	0x73, 0xf0, 0xde, 0xda, 0xaf, 0x8f, 0xe0, 0x52, 0x25, 0x64, 0xff, 0x5d, 0x83, 0x3a, 0x52, 0xc8,
+	0xc7, 0x60, 0xf0, 0x0c, 0x63, 0x3f, 0xac, 0x54, 0xcb, 0x15, 0x51, 0x14, 0x4e, 0x7e, 0x53, 0x11,
+	0x40, 0xd7, 0xb2, 0xdf, 0x54, 0x31, 0x17, 0x85, 0x6c, 0xe1, 0xf9, 0xec, 0xe5, 0x6a, 0xf9, 0x56,
+	0xb5, 0x0b, 0x75, 0x9a, 0xa3, 0xe4, 0x6b, 0xbc, 0x84, 0x12, 0xc9, 0xd4, 0x3e, 0xc8, 0x57, 0xd1,
+	0x1d, 0xe8, 0x9c, 0x1c, 0xd2, 0xe9, 0xf8, 0x8b, 0x31, 0xa5, 0xaf, 0xa8, 0x79, 0x87, 0xec, 0x81,
+	0xf9, 0xfa, 0xf0, 0xc5, 0x64, 0x84, 0x55, 0x54, 0x51, 0x35, 0xfb, 0x8f, 0x1a, 0xf4, 0x53, 0x7c,
+	0xf9, 0x1a, 0x91, 0x3d, 0x7e, 0xa8, 0xa8, 0x89, 0x2a, 0xa4, 0x19, 0x81, 0x3c, 0x83, 0x7b, 0x69,
+	0x7b, 0xe0, 0x7d, 0xcd, 0xe6, 0xa9, 0x9e, 0xba, 0xc8, 0x06, 0xae, 0x6a, 0xef, 0x25, 0x47, 0xf6,
+	0xef, 0x6d, 0x9a, 0xa3, 0x3c, 0xfe, 0x0c, 0x76, 0x4a, 0x1f, 0x6b, 0xe2, 0x0a, 0x2f, 0x5f, 0xcd,
+	0xc6, 0xbf, 0x1b, 0x1f, 0xfd, 0x76, 0x36, 0x1e, 0x99, 0x77, 0x44, 0x73, 0x70, 0x22, 0xa0, 0xc1,
+	0xc8, 0xd4, 0xc4, 0xf8, 0xf8, 0x70, 0xf2, 0x62, 0x3c, 0x32, 0x6b, 0xa2, 0x51, 0x98, 0xfe, 0x66,
+	0x72, 0x72, 0x32, 0x1e, 0x99, 0xfa, 0xa7, 0x3f, 0x14, 0xcd, 0xe5, 0x72, 0xc8, 0xcf, 0x83, 0xd5,
+	0xd9, 0x39, 0xbf, 0x0c, 0xa2, 0x8b, 0x58, 0x3e, 0xca, 0x5f, 0x6a, 0xfd, 0xcf, 0xf0, 0x71, 0x92,
+	0x1c, 0xf8, 0xb6, 0x81, 0x91, 0xf4, 0xf1, 0x7f, 0x07, 0x00, 0x4f, 0xa2, 0x24, 0x94, 0x5f, 0x1b,
+	0x00, 0x00,
 }
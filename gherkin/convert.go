@@ -0,0 +1,333 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package gherkin
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/formatter"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+)
+
+var (
+	placeholder      = regexp.MustCompile(`<([^<>"]+)>`)
+	quotedParameter  = regexp.MustCompile(`"<([^<>"]+)>"`)
+	conceptNameChars = strings.NewReplacer(`"`, "", "<", "", ">", "", "{", "", "}", "")
+)
+
+// Conversion is a feature converted to a Gauge spec, and to concepts for the backgrounds of its rules.
+type Conversion struct {
+	Spec     string
+	Concepts string
+	// Notes are the constructs of the feature which could not be converted.
+	Notes []string
+}
+
+type converter struct {
+	feature *Feature
+	// specTable is the data table of the spec, converted from the Examples of the only Scenario Outline of a feature
+	// when scenarios can not have data tables.
+	specTable      [][]string
+	scenarioTables bool
+	outlines       int
+	spec           bytes.Buffer
+	concepts       bytes.Buffer
+	notes          []string
+}
+
+// Convert converts the feature to the contents of specFile and conceptFile. The Background of the feature becomes
+// the contexts of the spec and the Background of a rule becomes a concept used by the scenarios of the rule. The
+// Examples of a Scenario Outline become the data table of the spec, or of the scenario if the feature has more than
+// one Scenario Outline. Step keywords are dropped, doc strings become static parameters.
+func Convert(f *Feature, specFile, conceptFile string) (*Conversion, error) {
+	c := &converter{feature: f}
+	c.convert(strings.TrimSuffix(filepath.Base(specFile), filepath.Ext(specFile)))
+	spec, res, err := new(parser.SpecParser).Parse(c.spec.String(), gauge.NewConceptDictionary(), specFile)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.ParseErrors) > 0 {
+		return nil, parseErrors(res.ParseErrors)
+	}
+	conversion := &Conversion{Spec: formatter.FormatSpecification(spec), Notes: c.notes}
+	if c.concepts.Len() == 0 {
+		return conversion, nil
+	}
+	steps, res := new(parser.ConceptParser).Parse(c.concepts.String(), conceptFile)
+	if len(res.ParseErrors) > 0 {
+		return nil, parseErrors(res.ParseErrors)
+	}
+	dictionary := gauge.NewConceptDictionary()
+	if errs, err := parser.AddConcept(steps, conceptFile, dictionary); err != nil {
+		return nil, err
+	} else if len(errs) > 0 {
+		return nil, parseErrors(errs)
+	}
+	conversion.Concepts = formatter.FormatConcepts(dictionary)[conceptFile]
+	return conversion, nil
+}
+
+func parseErrors(errs []parser.ParseError) error {
+	var messages []string
+	for _, e := range errs {
+		messages = append(messages, e.Error())
+	}
+	return fmt.Errorf("the converted spec is not valid. %s", strings.Join(messages, ", "))
+}
+
+func (c *converter) note(lineNo int, format string, args ...interface{}) {
+	c.notes = append(c.notes, fmt.Sprintf("line %d: %s", lineNo, fmt.Sprintf(format, args...)))
+}
+
+func (c *converter) convert(defaultName string) {
+	f := c.feature
+	name := f.Name
+	if name == "" {
+		name = defaultName
+	}
+	fmt.Fprintf(&c.spec, "# %s\n\n", name)
+	c.writeTags(f.Tags)
+	c.writeDescription(f.Description)
+	c.scenarioTables = env.AllowScenarioDatatable()
+	for _, s := range f.Scenarios {
+		if s.Outline {
+			c.outlines++
+		}
+	}
+	if !c.scenarioTables && c.outlines == 1 {
+		for _, s := range f.Scenarios {
+			if !s.Outline {
+				continue
+			}
+			c.specTable = c.examplesTable(s)
+			c.writeTable(c.specTable, "")
+			if len(c.specTable) > 0 && len(f.Scenarios) > 1 {
+				c.note(s.LineNo, "Examples of '%s' become the data table of the spec, the other scenarios run for each of its rows as allow_scenario_datatable = false in the project properties", s.Name)
+			}
+		}
+	}
+	if f.Background != nil {
+		for _, step := range f.Background.Steps {
+			c.writeStep(&c.spec, step, nil)
+		}
+		c.spec.WriteString("\n")
+	}
+	var rule *Rule
+	for _, s := range f.Scenarios {
+		if s.Rule != rule {
+			rule = s.Rule
+			c.writeRule(rule)
+		}
+		c.writeScenario(s)
+	}
+}
+
+func (c *converter) writeTags(tags []string) {
+	if len(tags) > 0 {
+		fmt.Fprintf(&c.spec, "tags: %s\n\n", strings.Join(tags, ", "))
+	}
+}
+
+// writeDescription writes the description as comments. Lines which would be read as steps, tables, tags or headings
+// are quoted.
+func (c *converter) writeDescription(description []string) {
+	for _, line := range description {
+		if strings.HasPrefix(line, "*") || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "|") || strings.HasPrefix(strings.ToLower(line), "tags:") || strings.HasPrefix(strings.ToLower(line), "table:") {
+			line = "> " + line
+		}
+		fmt.Fprintf(&c.spec, "%s\n", line)
+	}
+	if len(description) > 0 {
+		c.spec.WriteString("\n")
+	}
+}
+
+func (c *converter) writeTable(table [][]string, indent string) {
+	if len(table) == 0 {
+		return
+	}
+	for i, row := range table {
+		fmt.Fprintf(&c.spec, "%s|%s|\n", indent, strings.Join(row, "|"))
+		if i == 0 {
+			fmt.Fprintf(&c.spec, "%s|%s\n", indent, strings.Repeat("---|", len(row)))
+		}
+	}
+	c.spec.WriteString("\n")
+}
+
+func (c *converter) writeRule(r *Rule) {
+	if r == nil {
+		return
+	}
+	fmt.Fprintf(&c.spec, "Rule: %s\n\n", r.Name)
+	c.writeDescription(r.Description)
+	if r.Background == nil || len(r.Background.Steps) == 0 {
+		return
+	}
+	name := strings.TrimSpace(conceptNameChars.Replace(r.Name))
+	if name == "" {
+		name = fmt.Sprintf("line %d", r.Background.LineNo)
+	}
+	fmt.Fprintf(&c.concepts, "# Background of %s\n\n", name)
+	for _, step := range r.Background.Steps {
+		c.writeStep(&c.concepts, step, nil)
+	}
+	c.concepts.WriteString("\n")
+}
+
+func (c *converter) writeScenario(s *Scenario) {
+	var table [][]string
+	if s.Outline {
+		switch {
+		case c.scenarioTables:
+			table = c.examplesTable(s)
+		case c.outlines == 1:
+			table = c.specTable
+		default:
+			c.note(s.LineNo, "Scenario Outline '%s' is not converted, Examples of more than one Scenario Outline in a feature need scenario data tables, turned off by allow_scenario_datatable = false in the project properties", s.Name)
+			return
+		}
+		if len(table) == 0 {
+			c.note(s.LineNo, "Scenario Outline '%s' is not converted, it has no Examples", s.Name)
+			return
+		}
+	}
+	name := s.Name
+	if name == "" {
+		name = fmt.Sprintf("Scenario at line %d", s.LineNo)
+	}
+	fmt.Fprintf(&c.spec, "## %s\n\n", name)
+	tags := s.Tags
+	if s.Rule != nil {
+		tags = append(append([]string{}, s.Rule.Tags...), tags...)
+	}
+	c.writeTags(tags)
+	if c.scenarioTables {
+		c.writeTable(table, "")
+	}
+	c.writeDescription(s.Description)
+	if s.Rule != nil && s.Rule.Background != nil && len(s.Rule.Background.Steps) > 0 {
+		name := strings.TrimSpace(conceptNameChars.Replace(s.Rule.Name))
+		if name == "" {
+			name = fmt.Sprintf("line %d", s.Rule.Background.LineNo)
+		}
+		fmt.Fprintf(&c.spec, "* Background of %s\n", name)
+	}
+	var columns map[string]bool
+	if len(table) > 0 {
+		columns = make(map[string]bool)
+		for _, h := range table[0] {
+			columns[h] = true
+		}
+	}
+	for _, step := range s.Steps {
+		c.writeStep(&c.spec, step, columns)
+	}
+	c.spec.WriteString("\n")
+}
+
+// examplesTable combines the Examples of the outline which have the same columns as its first Examples.
+func (c *converter) examplesTable(s *Scenario) [][]string {
+	var table [][]string
+	for _, e := range s.Examples {
+		if len(e.Tags) > 0 {
+			c.note(e.LineNo, "tags %s of Examples are not converted", strings.Join(e.Tags, ", "))
+		}
+		if e.Name != "" {
+			c.note(e.LineNo, "name '%s' of Examples is not converted", e.Name)
+		}
+		if len(e.Table) == 0 {
+			continue
+		}
+		if table == nil {
+			table = append(table, e.Table...)
+			continue
+		}
+		if strings.Join(e.Table[0], "|") != strings.Join(table[0], "|") {
+			c.note(e.LineNo, "Examples are not converted, their columns differ from the first Examples of '%s'", s.Name)
+			continue
+		}
+		table = append(table, e.Table[1:]...)
+	}
+	return table
+}
+
+// writeStep writes a step without its keyword. For a step of an outline, the placeholders of the columns of its
+// Examples become dynamic parameters, other text in angle brackets becomes a static parameter.
+func (c *converter) writeStep(b *bytes.Buffer, step *Step, columns map[string]bool) {
+	text := quotedParameter.ReplaceAllStringFunc(step.Text, func(p string) string {
+		if columns[p[2:len(p)-2]] {
+			return p[1 : len(p)-1]
+		}
+		return p
+	})
+	text = replaceOutsideQuotes(text, placeholder, func(p string) string {
+		if columns[p[1:len(p)-1]] {
+			return p
+		}
+		c.note(step.LineNo, "%s is not a column of Examples, it is converted to the static parameter \"%s\"", p, p)
+		return `"` + p + `"`
+	})
+	for _, m := range placeholder.FindAllStringIndex(text, -1) {
+		if p := text[m[0]:m[1]]; columns[p[1:len(p)-1]] && strings.Count(text[:m[0]], `"`)%2 == 1 {
+			c.note(step.LineNo, "%s inside the text of a static parameter is not replaced by the values of Examples", p)
+		}
+	}
+	text = strings.NewReplacer("{", `\{`, "}", `\}`).Replace(text)
+	if d := step.DocString; d != nil {
+		if d.ContentType != "" {
+			c.note(d.LineNo, "content type '%s' of doc string is not converted", d.ContentType)
+		}
+		text += ` "` + escapeParameter(d.Content) + `"`
+	}
+	fmt.Fprintf(b, "* %s\n", text)
+	if len(step.Table) > 0 {
+		for i, row := range step.Table {
+			fmt.Fprintf(b, "   |%s|\n", strings.Join(row, "|"))
+			if i == 0 {
+				fmt.Fprintf(b, "   |%s\n", strings.Repeat("---|", len(row)))
+			}
+		}
+	}
+}
+
+// replaceOutsideQuotes replaces the matches of re which are not inside a quoted parameter.
+func replaceOutsideQuotes(text string, re *regexp.Regexp, replace func(string) string) string {
+	var b strings.Builder
+	last := 0
+	for _, m := range re.FindAllStringIndex(text, -1) {
+		b.WriteString(text[last:m[0]])
+		if strings.Count(text[:m[0]], `"`)%2 == 0 {
+			b.WriteString(replace(text[m[0]:m[1]]))
+		} else {
+			b.WriteString(text[m[0]:m[1]])
+		}
+		last = m[1]
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+func escapeParameter(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`, "\r", "").Replace(value)
+}
@@ -0,0 +1,175 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package gherkin
+
+import (
+	"strings"
+
+	"github.com/getgauge/gauge/env"
+	. "gopkg.in/check.v1"
+)
+
+func convert(c *C, text string) *Conversion {
+	f, err := Parse(text)
+	c.Assert(err, IsNil)
+	conversion, err := Convert(f, "specs/example.spec", "specs/example.cpt")
+	c.Assert(err, IsNil)
+	return conversion
+}
+
+func (s *MySuite) TestConvertBackgroundToContexts(c *C) {
+	conversion := convert(c, `@web
+Feature: Cart
+  Background:
+    Given I am on the "home" page
+
+  Scenario: Add
+    When I add {an item}
+    Then the cart shows
+      """
+      1 "item"
+      """
+`)
+
+	c.Assert(conversion.Spec, Equals, `# Cart
+
+tags: web
+
+* I am on the "home" page
+
+## Add
+
+* I add \{an item\}
+* the cart shows "1 \"item\""
+
+`)
+	c.Assert(conversion.Concepts, Equals, "")
+	c.Assert(conversion.Notes, HasLen, 0)
+}
+
+func (s *MySuite) TestConvertExamplesToSpecDataTable(c *C) {
+	old := env.AllowScenarioDatatable
+	env.AllowScenarioDatatable = func() bool { return false }
+	defer func() { env.AllowScenarioDatatable = old }()
+
+	conversion := convert(c, `Feature: Login
+  Scenario Outline: Login
+    Given I login as "<user>" with <password> to <site>
+
+    Examples:
+      | user  | password |
+      | alice | a1       |
+
+    Examples:
+      | user | password |
+      | bob  | b1       |
+`)
+
+	c.Assert(conversion.Spec, Equals, `# Login
+
+   |user |password|
+   |-----|--------|
+   |alice|a1      |
+   |bob  |b1      |
+
+## Login
+
+* I login as <user> with <password> to "<site>"
+
+`)
+	c.Assert(conversion.Notes, DeepEquals, []string{`line 3: <site> is not a column of Examples, it is converted to the static parameter "<site>"`})
+}
+
+func (s *MySuite) TestConvertSkipsOutlinesWithoutScenarioDataTables(c *C) {
+	old := env.AllowScenarioDatatable
+	env.AllowScenarioDatatable = func() bool { return false }
+	defer func() { env.AllowScenarioDatatable = old }()
+
+	conversion := convert(c, `Feature: Login
+  Scenario: Plain
+    Given a step
+  Scenario Outline: One
+    Given <a>
+    Examples:
+      | a |
+      | 1 |
+  Scenario Outline: Two
+    Given <b>
+    Examples:
+      | b |
+      | 2 |
+`)
+
+	c.Assert(strings.Contains(conversion.Spec, "## One"), Equals, false)
+	c.Assert(conversion.Notes, HasLen, 2)
+	c.Assert(conversion.Notes[0], Matches, "line 4: Scenario Outline 'One' is not converted.*")
+}
+
+func (s *MySuite) TestConvertExamplesToScenarioDataTables(c *C) {
+	old := env.AllowScenarioDatatable
+	env.AllowScenarioDatatable = func() bool { return true }
+	defer func() { env.AllowScenarioDatatable = old }()
+
+	conversion := convert(c, `Feature: Login
+  Scenario Outline: One
+    Given <a>
+    Examples:
+      | a |
+      | 1 |
+`)
+
+	c.Assert(conversion.Spec, Equals, `# Login
+
+## One
+
+   |a|
+   |-|
+   |1|
+
+* <a>
+
+`)
+	c.Assert(conversion.Notes, HasLen, 0)
+}
+
+func (s *MySuite) TestConvertRuleBackgroundToConcept(c *C) {
+	conversion := convert(c, `Feature: Shop
+  Rule: Payments
+    Background:
+      Given I have a "card"
+
+    Scenario: Pay
+      * I pay
+
+`)
+
+	c.Assert(conversion.Spec, Equals, `# Shop
+
+Rule: Payments
+
+## Pay
+
+* Background of Payments
+* I pay
+
+`)
+	c.Assert(conversion.Concepts, Equals, `# Background of Payments
+
+* I have a "card"
+
+`)
+}
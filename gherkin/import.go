@@ -0,0 +1,99 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package gherkin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/logger"
+)
+
+const featureExtension = ".feature"
+
+// Import converts the feature files in dir to spec and concept files in outDir, keeping the directory structure.
+// Existing files are not overwritten. It returns false if a feature file could not be converted.
+func Import(dir, outDir string) bool {
+	features := common.FindFilesInDir(dir, func(path string) bool {
+		return strings.ToLower(filepath.Ext(path)) == featureExtension
+	}, func(path string, f os.FileInfo) bool {
+		return false
+	})
+	if len(features) == 0 {
+		logger.Errorf(true, "No feature files found in %s", dir)
+		return false
+	}
+	converted, failed := 0, 0
+	for _, file := range features {
+		rel, err := filepath.Rel(dir, file)
+		if err != nil {
+			rel = filepath.Base(file)
+		}
+		base := filepath.Join(outDir, strings.TrimSuffix(rel, filepath.Ext(rel)))
+		if err := importFeature(file, base+".spec", base+".cpt"); err != nil {
+			logger.Errorf(true, "Failed to import %s. %s", file, err.Error())
+			failed++
+			continue
+		}
+		converted++
+	}
+	logger.Infof(true, "Imported %d of %d feature files.", converted, len(features))
+	return failed == 0
+}
+
+func importFeature(file, specFile, conceptFile string) error {
+	text, err := common.ReadFileContents(file)
+	if err != nil {
+		return err
+	}
+	f, err := Parse(text)
+	if err != nil {
+		return err
+	}
+	c, err := Convert(f, specFile, conceptFile)
+	if err != nil {
+		return err
+	}
+	files := map[string]string{specFile: c.Spec}
+	if c.Concepts != "" {
+		files[conceptFile] = c.Concepts
+	}
+	for file := range files {
+		if common.FileExists(file) {
+			return &os.PathError{Op: "import", Path: file, Err: os.ErrExist}
+		}
+	}
+	for file, contents := range files {
+		if err := os.MkdirAll(filepath.Dir(file), common.NewDirectoryPermissions); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(file, []byte(contents), common.NewFilePermissions); err != nil {
+			return err
+		}
+	}
+	logger.Infof(true, "Imported %s to %s", file, specFile)
+	if len(c.Notes) > 0 {
+		logger.Warningf(true, "%d constructs of %s could not be converted:", len(c.Notes), file)
+	}
+	for _, n := range c.Notes {
+		logger.Warningf(true, "%s:%s", file, strings.TrimPrefix(n, "line "))
+	}
+	return nil
+}
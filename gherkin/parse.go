@@ -0,0 +1,304 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package gherkin
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Feature is a parsed Gherkin feature file.
+type Feature struct {
+	Name        string
+	Description []string
+	Tags        []string
+	Background  *Background
+	Scenarios   []*Scenario
+	LineNo      int
+}
+
+// Rule groups scenarios of a feature, it can have a background of its own.
+type Rule struct {
+	Name        string
+	Description []string
+	Tags        []string
+	Background  *Background
+}
+
+// Background is the steps executed before every scenario of a feature or rule.
+type Background struct {
+	Steps  []*Step
+	LineNo int
+}
+
+// Scenario is a Scenario or Scenario Outline of a feature.
+type Scenario struct {
+	Name        string
+	Description []string
+	Tags        []string
+	Outline     bool
+	Steps       []*Step
+	Examples    []*Examples
+	Rule        *Rule
+	LineNo      int
+}
+
+// Step is a step with its doc string or data table, if any.
+type Step struct {
+	Keyword   string
+	Text      string
+	DocString *DocString
+	Table     [][]string
+	LineNo    int
+}
+
+// DocString is the multi-line argument of a step.
+type DocString struct {
+	ContentType string
+	Content     string
+	LineNo      int
+}
+
+// Examples is an Examples table of a Scenario Outline.
+type Examples struct {
+	Name   string
+	Tags   []string
+	Table  [][]string
+	LineNo int
+}
+
+var stepKeywords = []string{"Given ", "When ", "Then ", "And ", "But ", "* "}
+
+// featureParser keeps the state of parsing a feature file line by line.
+type featureParser struct {
+	feature     *Feature
+	rule        *Rule
+	scenario    *Scenario
+	background  *Background
+	examples    *Examples
+	step        *Step
+	tags        []string
+	description *[]string
+	docString   *DocString
+	delimiter   string
+	indent      int
+}
+
+// Parse parses the text of a feature file. Only the English keywords are supported.
+func Parse(text string) (*Feature, error) {
+	p := &featureParser{}
+	for i, line := range strings.Split(strings.Replace(text, "\r\n", "\n", -1), "\n") {
+		if err := p.parseLine(line, i+1); err != nil {
+			return nil, fmt.Errorf("line %d: %s", i+1, err.Error())
+		}
+	}
+	if p.docString != nil {
+		return nil, fmt.Errorf("line %d: doc string is not closed", p.docString.LineNo)
+	}
+	if p.feature == nil {
+		return nil, fmt.Errorf("no Feature found")
+	}
+	return p.feature, nil
+}
+
+func (p *featureParser) parseLine(line string, lineNo int) error {
+	trimmed := strings.TrimSpace(line)
+	if p.docString != nil {
+		p.parseDocStringLine(line, trimmed)
+		return nil
+	}
+	switch {
+	case trimmed == "":
+		return nil
+	case strings.HasPrefix(trimmed, "#"):
+		return p.parseComment(trimmed, lineNo)
+	case strings.HasPrefix(trimmed, "@"):
+		p.tags = append(p.tags, parseTags(trimmed)...)
+		return nil
+	case strings.HasPrefix(trimmed, `"""`) || strings.HasPrefix(trimmed, "```"):
+		return p.startDocString(line, trimmed, lineNo)
+	case strings.HasPrefix(trimmed, "|"):
+		return p.parseTableRow(trimmed)
+	}
+	if keyword, name, ok := headingKeyword(trimmed); ok {
+		return p.parseHeading(keyword, name, lineNo)
+	}
+	for _, k := range stepKeywords {
+		if strings.HasPrefix(trimmed, k) {
+			return p.parseStep(strings.TrimSpace(k), strings.TrimSpace(trimmed[len(k):]), lineNo)
+		}
+	}
+	if p.description == nil {
+		return fmt.Errorf("unexpected text '%s'", trimmed)
+	}
+	*p.description = append(*p.description, trimmed)
+	return nil
+}
+
+func (p *featureParser) parseComment(trimmed string, lineNo int) error {
+	comment := strings.TrimSpace(strings.TrimPrefix(trimmed, "#"))
+	if strings.HasPrefix(comment, "language:") {
+		if language := strings.TrimSpace(strings.TrimPrefix(comment, "language:")); language != "en" {
+			return fmt.Errorf("language '%s' is not supported, only English keywords can be converted", language)
+		}
+		return nil
+	}
+	if p.description != nil && comment != "" {
+		*p.description = append(*p.description, comment)
+	}
+	return nil
+}
+
+func parseTags(line string) []string {
+	var tags []string
+	for _, t := range strings.Fields(line) {
+		if strings.HasPrefix(t, "#") {
+			break
+		}
+		tags = append(tags, strings.TrimPrefix(t, "@"))
+	}
+	return tags
+}
+
+func headingKeyword(line string) (string, string, bool) {
+	for _, k := range []string{"Feature", "Rule", "Background", "Scenario Outline", "Scenario Template", "Scenario", "Example", "Examples", "Scenarios"} {
+		if strings.HasPrefix(line, k+":") {
+			return k, strings.TrimSpace(line[len(k)+1:]), true
+		}
+	}
+	return "", "", false
+}
+
+func (p *featureParser) parseHeading(keyword, name string, lineNo int) error {
+	if keyword != "Feature" && p.feature == nil {
+		return fmt.Errorf("%s found before Feature", keyword)
+	}
+	tags := p.tags
+	p.tags = nil
+	p.step = nil
+	switch keyword {
+	case "Feature":
+		if p.feature != nil {
+			return fmt.Errorf("a feature file can have only one Feature")
+		}
+		p.feature = &Feature{Name: name, Tags: tags, LineNo: lineNo}
+		p.description = &p.feature.Description
+	case "Rule":
+		p.rule = &Rule{Name: name, Tags: tags}
+		p.scenario, p.background, p.examples = nil, nil, nil
+		p.description = &p.rule.Description
+	case "Background":
+		p.background = &Background{LineNo: lineNo}
+		if p.rule != nil {
+			p.rule.Background = p.background
+		} else {
+			p.feature.Background = p.background
+		}
+		p.scenario, p.examples = nil, nil
+		p.description = nil
+	case "Examples", "Scenarios":
+		if p.scenario == nil || !p.scenario.Outline {
+			return fmt.Errorf("%s found outside of a Scenario Outline", keyword)
+		}
+		p.examples = &Examples{Name: name, Tags: tags, LineNo: lineNo}
+		p.scenario.Examples = append(p.scenario.Examples, p.examples)
+		p.description = nil
+	default:
+		p.scenario = &Scenario{Name: name, Tags: tags, Outline: strings.HasPrefix(keyword, "Scenario "), Rule: p.rule, LineNo: lineNo}
+		p.feature.Scenarios = append(p.feature.Scenarios, p.scenario)
+		p.background, p.examples = nil, nil
+		p.description = &p.scenario.Description
+	}
+	return nil
+}
+
+func (p *featureParser) parseStep(keyword, text string, lineNo int) error {
+	p.step = &Step{Keyword: keyword, Text: text, LineNo: lineNo}
+	switch {
+	case p.background != nil:
+		p.background.Steps = append(p.background.Steps, p.step)
+	case p.scenario != nil && p.examples == nil:
+		p.scenario.Steps = append(p.scenario.Steps, p.step)
+	default:
+		return fmt.Errorf("step '%s' found outside of a Scenario or Background", text)
+	}
+	p.description = nil
+	return nil
+}
+
+func (p *featureParser) parseTableRow(trimmed string) error {
+	row := parseTableCells(trimmed)
+	switch {
+	case p.examples != nil:
+		p.examples.Table = append(p.examples.Table, row)
+	case p.step != nil && p.step.DocString == nil:
+		p.step.Table = append(p.step.Table, row)
+	default:
+		return fmt.Errorf("table found outside of a step or Examples")
+	}
+	return nil
+}
+
+// parseTableCells splits a table row into its cells, `\|`, `\n` and `\\` are unescaped.
+func parseTableCells(row string) []string {
+	var cells []string
+	var cell strings.Builder
+	escaped := false
+	for _, r := range strings.TrimSuffix(strings.TrimPrefix(row, "|"), "|") {
+		switch {
+		case escaped:
+			escaped = false
+			if r == 'n' {
+				cell.WriteRune('\n')
+			} else {
+				cell.WriteRune(r)
+			}
+		case r == '\\':
+			escaped = true
+		case r == '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteRune(r)
+		}
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+func (p *featureParser) startDocString(line, trimmed string, lineNo int) error {
+	if p.step == nil || p.step.Table != nil {
+		return fmt.Errorf("doc string found outside of a step")
+	}
+	p.delimiter = trimmed[:3]
+	p.indent = len(line) - len(strings.TrimLeft(line, " \t"))
+	p.docString = &DocString{ContentType: strings.TrimSpace(trimmed[3:]), LineNo: lineNo}
+	return nil
+}
+
+func (p *featureParser) parseDocStringLine(line, trimmed string) {
+	if trimmed == p.delimiter {
+		p.docString.Content = strings.TrimSuffix(p.docString.Content, "\n")
+		p.step.DocString = p.docString
+		p.docString = nil
+		return
+	}
+	indent := len(line) - len(strings.TrimLeft(line, " \t"))
+	if indent > p.indent {
+		indent = p.indent
+	}
+	p.docString.Content += line[indent:] + "\n"
+}
@@ -0,0 +1,109 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package gherkin
+
+import (
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type MySuite struct{}
+
+var _ = Suite(&MySuite{})
+
+func (s *MySuite) TestParseFeature(c *C) {
+	f, err := Parse(`# a comment
+@web @smoke
+Feature: Shopping cart
+  As a customer
+
+  Background:
+    Given I am on the home page
+
+  Scenario: Add an item
+    When I add "shoes" with:
+      | name | qty |
+      | a \| b | 2 |
+    Then the cart shows
+      """json
+      {"items": 1}
+        nested
+      """
+`)
+
+	c.Assert(err, IsNil)
+	c.Assert(f.Name, Equals, "Shopping cart")
+	c.Assert(f.Tags, DeepEquals, []string{"web", "smoke"})
+	c.Assert(f.Description, DeepEquals, []string{"As a customer"})
+	c.Assert(len(f.Background.Steps), Equals, 1)
+	c.Assert(f.Background.Steps[0].Text, Equals, "I am on the home page")
+	c.Assert(len(f.Scenarios), Equals, 1)
+	steps := f.Scenarios[0].Steps
+	c.Assert(steps[0].Keyword, Equals, "When")
+	c.Assert(steps[0].Table, DeepEquals, [][]string{{"name", "qty"}, {"a | b", "2"}})
+	c.Assert(steps[1].DocString.ContentType, Equals, "json")
+	c.Assert(steps[1].DocString.Content, Equals, "{\"items\": 1}\n  nested")
+}
+
+func (s *MySuite) TestParseScenarioOutlineAndRule(c *C) {
+	f, err := Parse(`Feature: Login
+  Scenario Outline: Login as <user>
+    Given I login as "<user>"
+
+    @extra
+    Examples: admins
+      | user  |
+      | admin |
+
+  Rule: Payments
+    Background:
+      Given I have a card
+
+    @pay
+    Example: Pay
+      * I pay
+`)
+
+	c.Assert(err, IsNil)
+	c.Assert(len(f.Scenarios), Equals, 2)
+	outline := f.Scenarios[0]
+	c.Assert(outline.Outline, Equals, true)
+	c.Assert(len(outline.Examples), Equals, 1)
+	c.Assert(outline.Examples[0].Name, Equals, "admins")
+	c.Assert(outline.Examples[0].Tags, DeepEquals, []string{"extra"})
+	c.Assert(outline.Examples[0].Table, DeepEquals, [][]string{{"user"}, {"admin"}})
+	pay := f.Scenarios[1]
+	c.Assert(pay.Tags, DeepEquals, []string{"pay"})
+	c.Assert(pay.Rule.Name, Equals, "Payments")
+	c.Assert(pay.Rule.Background.Steps[0].Text, Equals, "I have a card")
+	c.Assert(pay.Steps[0].Keyword, Equals, "*")
+}
+
+func (s *MySuite) TestParseReportsTheLineOfAnError(c *C) {
+	_, err := Parse("Feature: Bad\n  Scenario: x\n    Given a\n   oops\n")
+
+	c.Assert(err, ErrorMatches, "line 4: .*")
+}
+
+func (s *MySuite) TestParseRejectsOtherLanguages(c *C) {
+	_, err := Parse("# language: fr\nFonctionnalité: x\n")
+
+	c.Assert(err, ErrorMatches, "line 1: .*")
+}
@@ -18,13 +18,66 @@
 package order
 
 import (
+	"fmt"
+	"math/rand"
 	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/getgauge/gauge/gauge"
 )
 
+const (
+	randomOrder          = "random"
+	randomScenariosOrder = "random-scenarios"
+)
+
 var Sorted bool
 
+// Order is the value of the --order flag. Specs are shuffled for random[:seed],
+// and so are the scenarios within them for random-scenarios[:seed].
+var Order string
+
+type shuffle struct {
+	scenarios bool
+	seed      int64
+	seeded    bool
+}
+
+func parseOrder(o string) (*shuffle, error) {
+	parts := strings.SplitN(o, ":", 2)
+	s := &shuffle{}
+	switch parts[0] {
+	case randomOrder:
+	case randomScenariosOrder:
+		s.scenarios = true
+	default:
+		return nil, fmt.Errorf("invalid input(%s) to --order flag. Possible options are: random[:seed], random-scenarios[:seed]", o)
+	}
+	if len(parts) == 2 {
+		seed, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid seed(%s) to --order flag, it should be an integer", parts[1])
+		}
+		s.seed, s.seeded = seed, true
+	}
+	return s, nil
+}
+
+// WithSeed returns the given order along with its seed, generating a seed if the order has none.
+// Saving the returned order is enough to repeat the same execution order.
+func WithSeed(o string) (string, int64, error) {
+	s, err := parseOrder(o)
+	if err != nil {
+		return "", 0, err
+	}
+	if !s.seeded {
+		s.seed = time.Now().UnixNano()
+	}
+	return fmt.Sprintf("%s:%d", strings.SplitN(o, ":", 2)[0], s.seed), s.seed, nil
+}
+
 type byFileName []*gauge.Specification
 
 func (s byFileName) Len() int {
@@ -43,5 +96,33 @@ func Sort(specs []*gauge.Specification) []*gauge.Specification {
 	if Sorted {
 		sort.Sort(byFileName(specs))
 	}
+	if Order != "" {
+		if s, err := parseOrder(Order); err == nil {
+			s.shuffle(specs)
+		}
+	}
 	return specs
 }
+
+func (s *shuffle) shuffle(specs []*gauge.Specification) {
+	if !s.seeded {
+		s.seed = time.Now().UnixNano()
+	}
+	r := rand.New(rand.NewSource(s.seed))
+	r.Shuffle(len(specs), func(i, j int) {
+		specs[i], specs[j] = specs[j], specs[i]
+	})
+	if !s.scenarios {
+		return
+	}
+	for _, spec := range specs {
+		// Scenarios of a spec with a data table are executed row by row, so their order is kept.
+		if spec.DataTable.Table.GetRowCount() > 0 {
+			continue
+		}
+		scns := spec.Scenarios
+		r.Shuffle(len(scns), func(i, j int) {
+			scns[i], scns[j] = scns[j], scns[i]
+		})
+	}
+}
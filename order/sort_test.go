@@ -17,6 +17,8 @@
 package order
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/getgauge/gauge/gauge"
@@ -41,3 +43,73 @@ func TestToSortSpecs(t *testing.T) {
 		}
 	}
 }
+
+func specsNamed(names ...string) []*gauge.Specification {
+	var specs []*gauge.Specification
+	for _, n := range names {
+		specs = append(specs, &gauge.Specification{FileName: n, Scenarios: []*gauge.Scenario{
+			{Heading: &gauge.Heading{Value: n + "1"}},
+			{Heading: &gauge.Heading{Value: n + "2"}},
+			{Heading: &gauge.Heading{Value: n + "3"}},
+		}})
+	}
+	return specs
+}
+
+func orderOf(specs []*gauge.Specification) string {
+	var got []string
+	for _, s := range specs {
+		got = append(got, s.FileName)
+		for _, scn := range s.Scenarios {
+			got = append(got, scn.Heading.Value)
+		}
+	}
+	return strings.Join(got, ",")
+}
+
+func TestRandomOrderIsReproducibleWithSeed(t *testing.T) {
+	Sorted = false
+	Order = "random-scenarios:42"
+	defer func() { Order = "" }()
+
+	first := orderOf(Sort(specsNamed("a", "b", "c", "d", "e")))
+	second := orderOf(Sort(specsNamed("a", "b", "c", "d", "e")))
+
+	if first != second {
+		t.Errorf("Expected the same order for the same seed, got %s and %s", first, second)
+	}
+}
+
+func TestRandomOrderShufflesScenariosOnlyWhenAsked(t *testing.T) {
+	Sorted = false
+	Order = "random:7"
+	defer func() { Order = "" }()
+
+	for _, s := range Sort(specsNamed("a", "b", "c", "d", "e")) {
+		for i, scn := range s.Scenarios {
+			if want := fmt.Sprintf("%s%d", s.FileName, i+1); scn.Heading.Value != want {
+				t.Errorf("Expected scenario %s at position %d, got %s", want, i, scn.Heading.Value)
+			}
+		}
+	}
+}
+
+func TestWithSeed(t *testing.T) {
+	got, seed, err := WithSeed("random:12")
+	if err != nil || got != "random:12" || seed != 12 {
+		t.Errorf("Expected random:12 with seed 12, got %s with seed %d, err: %v", got, seed, err)
+	}
+
+	got, seed, err = WithSeed("random-scenarios")
+	if err != nil || got != fmt.Sprintf("random-scenarios:%d", seed) {
+		t.Errorf("Expected a generated seed for random-scenarios, got %s, err: %v", got, err)
+	}
+}
+
+func TestWithSeedForInvalidOrder(t *testing.T) {
+	for _, o := range []string{"alphabetical", "random:abc", "random-scenario"} {
+		if _, _, err := WithSeed(o); err == nil {
+			t.Errorf("Expected an error for order %s", o)
+		}
+	}
+}
@@ -19,6 +19,7 @@ package parser
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/getgauge/common"
@@ -156,6 +157,10 @@ func (parser *ConceptParser) isTableDataRow(token *Token) bool {
 func (parser *ConceptParser) processConceptHeading(token *Token, fileName string) (*gauge.Step, *ParseResult) {
 	processStep(new(SpecParser), token)
 	token.LineText = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(token.LineText), "#"))
+	defaults, err := extractParamDefaults(token)
+	if err != nil {
+		return nil, &ParseResult{ParseErrors: []ParseError{ParseError{FileName: fileName, LineNo: token.LineNo, Message: err.Error(), LineText: token.LineText}}}
+	}
 	var concept *gauge.Step
 	var parseRes *ParseResult
 	concept, parseRes = CreateStepUsingLookup(token, nil, fileName)
@@ -167,12 +172,51 @@ func (parser *ConceptParser) processConceptHeading(token *Token, fileName string
 		return nil, parseRes
 	}
 
+	for i, d := range defaults {
+		concept.Args[i].Default = d
+	}
 	concept.IsConcept = true
 	parser.createConceptLookup(concept)
 	concept.Items = append(concept.Items, concept)
 	return concept, parseRes
 }
 
+var argPlaceholders = regexp.MustCompile("{(dynamic|static|special)}")
+
+// extractParamDefaults takes the default values, given as <name = "value">, out of the dynamic params of a concept heading token.
+// Only the trailing params can have a default value, as a call can leave out only the arguments at its end.
+func extractParamDefaults(token *Token) ([]*string, error) {
+	argTypes := argPlaceholders.FindAllString(token.Value, -1)
+	if len(argTypes) != len(token.Args) {
+		return nil, nil
+	}
+	defaults := make([]*string, len(token.Args))
+	for i, arg := range token.Args {
+		parts := strings.SplitN(arg, "=", 2)
+		if argTypes[i] == "{static}" || len(parts) < 2 {
+			if i > 0 && defaults[i-1] != nil {
+				return nil, fmt.Errorf("Parameter <%s> should have a default value as it follows a parameter with one", arg)
+			}
+			continue
+		}
+		name, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		if len(value) < 2 || !strings.HasPrefix(value, "\"") || !strings.HasSuffix(value, "\"") {
+			return nil, fmt.Errorf("Default value of parameter <%s> should be in quotes", name)
+		}
+		value = value[1 : len(value)-1]
+		token.Args[i] = name
+		defaults[i] = &value
+		// a ':' in the default value makes the param look like a special one
+		argTypes[i] = "{dynamic}"
+	}
+	i := -1
+	token.Value = argPlaceholders.ReplaceAllStringFunc(token.Value, func(string) string {
+		i++
+		return argTypes[i]
+	})
+	return defaults, nil
+}
+
 func (parser *ConceptParser) processConceptStep(token *Token, fileName string) []ParseError {
 	processStep(new(SpecParser), token)
 	conceptStep, parseRes := CreateStepUsingLookup(token, &parser.currentConcept.Lookup, fileName)
@@ -263,7 +307,25 @@ func AddConcept(concepts []*gauge.Step, file string, conceptDictionary *gauge.Co
 				LineText: dupConcept.ConceptStep.LineText,
 			})
 		}
-		conceptDictionary.ConceptsMap[conceptStep.Value] = &gauge.Concept{ConceptStep: conceptStep, FileName: file}
+		concept := &gauge.Concept{ConceptStep: conceptStep, FileName: file}
+		for _, ambiguousConcept := range conceptDictionary.Ambiguities(concept) {
+			parseErrors = append(parseErrors, ParseError{
+				FileName: file,
+				LineNo:   conceptStep.LineNo,
+				Message:  fmt.Sprintf("Ambiguous concept definition found, a call can also refer to \"%s\" as it leaves out parameters with a default value", ambiguousConcept.ConceptStep.LineText),
+				LineText: conceptStep.LineText,
+			})
+			parseErrors = append(parseErrors, ParseError{
+				FileName: ambiguousConcept.FileName,
+				LineNo:   ambiguousConcept.ConceptStep.LineNo,
+				Message:  fmt.Sprintf("Ambiguous concept definition found, a call can also refer to \"%s\" as it leaves out parameters with a default value", conceptStep.LineText),
+				LineText: ambiguousConcept.ConceptStep.LineText,
+			})
+		}
+		conceptDictionary.ConceptsMap[conceptStep.Value] = concept
+		if err := conceptDictionary.AddShortForms(concept); err != nil {
+			return nil, err
+		}
 		if err := conceptDictionary.ReplaceNestedConceptSteps(conceptStep); err != nil {
 			return nil, err
 		}
@@ -729,3 +729,70 @@ func containsAny(errs []ParseError, msg string) bool {
 	}
 	return false
 }
+
+func (s *MySuite) TestParsingConceptHeadingWithDefaultValues(c *C) {
+	concepts, parseRes := new(ConceptParser).Parse("# login as <user> with <password = \"secret: x\">\n* enter <user> and <password>", "")
+
+	c.Assert(len(parseRes.ParseErrors), Equals, 0)
+	c.Assert(len(concepts), Equals, 1)
+	concept := concepts[0]
+	c.Assert(concept.Value, Equals, "login as {} with {}")
+	c.Assert(concept.Args[0].Default, IsNil)
+	c.Assert(concept.Args[1].Value, Equals, "password")
+	c.Assert(*concept.Args[1].Default, Equals, "secret: x")
+	c.Assert(concept.Lookup.ContainsArg("password"), Equals, true)
+}
+
+func (s *MySuite) TestParsingConceptHeadingWithDefaultValueBeforeParamWithoutOne(c *C) {
+	_, parseRes := new(ConceptParser).Parse("# login as <user = \"bob\"> with <password>\n* enter <user> and <password>", "foo.cpt")
+
+	c.Assert(len(parseRes.ParseErrors), Not(Equals), 0)
+	c.Assert(parseRes.ParseErrors[0].Message, Equals, "Parameter <password> should have a default value as it follows a parameter with one")
+}
+
+func (s *MySuite) TestParsingConceptHeadingWithUnquotedDefaultValue(c *C) {
+	_, parseRes := new(ConceptParser).Parse("# login as <user = bob>\n* enter <user>", "foo.cpt")
+
+	c.Assert(len(parseRes.ParseErrors), Not(Equals), 0)
+	c.Assert(parseRes.ParseErrors[0].Message, Equals, "Default value of parameter <user> should be in quotes")
+}
+
+func (s *MySuite) TestConceptCalledWithFewerArgsUsesDefaultValues(c *C) {
+	dictionary := gauge.NewConceptDictionary()
+	concepts, _ := new(ConceptParser).Parse("# login as <user> with <password = \"secret\">\n* enter <user> and <password>\n\n# sign in as admin\n* login as \"admin\"", "foo.cpt")
+	errs, err := AddConcept(concepts, "foo.cpt", dictionary)
+	c.Assert(err, IsNil)
+	c.Assert(len(errs), Equals, 0)
+
+	spec, parseRes, err := new(SpecParser).Parse("# spec\n## scenario\n* login as \"bob\"\n* login as \"bob\" with \"pw\"", dictionary, "")
+	c.Assert(err, IsNil)
+	c.Assert(len(parseRes.ParseErrors), Equals, 0)
+
+	short := spec.Scenarios[0].Steps[0]
+	c.Assert(short.IsConcept, Equals, true)
+	c.Assert(short.Value, Equals, "login as {}")
+	password, _ := short.Lookup.GetArg("password")
+	c.Assert(password.Value, Equals, "secret")
+
+	full := spec.Scenarios[0].Steps[1]
+	c.Assert(full.Value, Equals, "login as {} with {}")
+	password, _ = full.Lookup.GetArg("password")
+	c.Assert(password.Value, Equals, "pw")
+
+	nested := dictionary.Search("sign in as admin").ConceptStep.ConceptSteps[0]
+	c.Assert(nested.IsConcept, Equals, true)
+	password, _ = nested.Lookup.GetArg("password")
+	c.Assert(password.Value, Equals, "secret")
+}
+
+func (s *MySuite) TestAmbiguousConceptsWithDefaultValues(c *C) {
+	dictionary := gauge.NewConceptDictionary()
+	concepts, _ := new(ConceptParser).Parse("# login as <user> with <password = \"secret\">\n* enter <user> and <password>\n\n# login as <user>\n* enter <user>", "foo.cpt")
+
+	errs, err := AddConcept(concepts, "foo.cpt", dictionary)
+
+	c.Assert(err, IsNil)
+	c.Assert(len(errs), Equals, 2)
+	c.Assert(hasParseError("Ambiguous concept definition found, a call can also refer to \"login as <user> with <password = \"secret\">\" as it leaves out parameters with a default value", "foo.cpt", 4, errs), Equals, true)
+	c.Assert(hasParseError("Ambiguous concept definition found, a call can also refer to \"login as <user>\" as it leaves out parameters with a default value", "foo.cpt", 1, errs), Equals, true)
+}
@@ -133,7 +133,7 @@ func (parser *SpecParser) initializeConverters() []func(*Token, *int, *gauge.Spe
 		return token.Kind == gauge.DataTableKind
 	}, func(token *Token, spec *gauge.Specification, state *int) ParseResult {
 		resolvedArg, err := newSpecialTypeResolver().resolve(token.Value)
-		if resolvedArg == nil || err != nil {
+		if resolvedArg == nil || err != nil || resolvedArg.ArgType != gauge.SpecialTable {
 			e := ParseError{FileName: spec.FileName, LineNo: token.LineNo, LineText: token.LineText, Message: fmt.Sprintf("Could not resolve table from %s", token.LineText)}
 			return ParseResult{ParseErrors: []ParseError{e}, Ok: false}
 		}
@@ -237,9 +237,9 @@ func (parser *SpecParser) initializeConverters() []func(*Token, *int, *gauge.Spe
 				spec.AddComment(&gauge.Comment{Value: token.LineText, LineNo: token.LineNo})
 			}
 		} else {
-			t := spec.DataTable
+			t := &spec.DataTable
 			if isInState(*state, scenarioScope) && env.AllowScenarioDatatable() {
-				t = spec.LatestScenario().DataTable
+				t = &spec.LatestScenario().DataTable
 			}
 
 			tableValues, warnings, err := validateTableRows(token, new(gauge.ArgLookup).FromDataTables(&t.Table), spec.FileName)
@@ -247,6 +247,7 @@ func (parser *SpecParser) initializeConverters() []func(*Token, *int, *gauge.Spe
 				result = ParseResult{Ok: false, Warnings: warnings, ParseErrors: err}
 			} else {
 				t.Table.AddRowValues(tableValues)
+				t.RowLineNos = append(t.RowLineNos, token.LineNo)
 				result = ParseResult{Ok: true, Warnings: warnings}
 			}
 		}
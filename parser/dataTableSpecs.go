@@ -88,11 +88,12 @@ func copyScenarios(scenarios []*gauge.Scenario, table gauge.Table, i int, errMap
 			Steps:                 scn.Steps,
 			Items:                 scn.Items,
 			Heading:               scn.Heading,
+			DataTable:             scn.DataTable,
 			SpecDataTableRow:      table,
 			SpecDataTableRowIndex: i,
-			Tags:     scn.Tags,
-			Comments: scn.Comments,
-			Span:     scn.Span,
+			Tags:                  scn.Tags,
+			Comments:              scn.Comments,
+			Span:                  scn.Span,
 		}
 		if scnTableRow.IsInitialized() {
 			newScn.ScenarioDataTableRow = scnTableRow
@@ -106,6 +107,9 @@ func copyScenarios(scenarios []*gauge.Scenario, table gauge.Table, i int, errMap
 	for _, scn := range scenarios {
 		if scn.DataTable.IsInitialized() && env.AllowScenarioDatatable() {
 			for i := range scn.DataTable.Table.Rows() {
+				if !isSelectedRow(scn, i) {
+					continue
+				}
 				t := getTableWithOneRow(scn.DataTable.Table, i)
 				scns = append(scns, create(scn, *t, i))
 			}
@@ -116,6 +120,18 @@ func copyScenarios(scenarios []*gauge.Scenario, table gauge.Table, i int, errMap
 	return
 }
 
+func isSelectedRow(scn *gauge.Scenario, row int) bool {
+	if len(scn.SelectedDataTableRows) == 0 {
+		return true
+	}
+	for _, r := range scn.SelectedDataTableRows {
+		if r == row {
+			return true
+		}
+	}
+	return false
+}
+
 func getTableWithOneRow(t gauge.Table, i int) *gauge.Table {
 	var row [][]gauge.TableCell
 	for _, c := range t.Columns {
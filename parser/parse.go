@@ -140,6 +140,7 @@ func parseSpecsInDirs(conceptDictionary *gauge.ConceptDictionary, specDirs []str
 		specFile := specFiles[i]
 		if len(specFile.indices) > 0 {
 			s, _ := spec.Filter(filter.NewScenarioFilterBasedOnSpan(specFile.indices))
+			selectDataTableRows(s.Scenarios, specFile.indices)
 			allSpecs[i] = s
 		} else {
 			allSpecs[i] = spec
@@ -148,12 +149,43 @@ func parseSpecsInDirs(conceptDictionary *gauge.ConceptDictionary, specDirs []str
 	return allSpecs, !passed
 }
 
+// selectDataTableRows limits the scenarios to the rows of their data tables in the given lines. A scenario is executed
+// for all its rows if any other line of it is given.
+func selectDataTableRows(scenarios []*gauge.Scenario, lines []int) {
+	for _, scn := range scenarios {
+		var rows []int
+		for _, l := range lines {
+			if !scn.InSpan(l) {
+				continue
+			}
+			row := scn.DataTableRowAt(l)
+			if row < 0 {
+				rows = nil
+				break
+			}
+			rows = append(rows, row)
+		}
+		scn.SelectedDataTableRows = rows
+	}
+}
+
+// withoutSuiteSpec removes the suite spec from the files, as it is not executed like the other specs.
+func withoutSuiteSpec(files []string) []string {
+	var specFiles []string
+	for _, f := range files {
+		if !IsSuiteSpec(f) {
+			specFiles = append(specFiles, f)
+		}
+	}
+	return specFiles
+}
+
 func getAllSpecFiles(specDirs []string) (givenSpecs []string, specFiles []*specFile) {
 	for _, specSource := range specDirs {
 		if isIndexedSpec(specSource) {
 			var specName string
 			specName, index := getIndexedSpecName(specSource)
-			files := util.GetSpecFiles([]string{specName})
+			files := withoutSuiteSpec(util.GetSpecFiles([]string{specName}))
 			if len(files) < 1 {
 				continue
 			}
@@ -163,7 +195,7 @@ func getAllSpecFiles(specDirs []string) (givenSpecs []string, specFiles []*specF
 			}
 			givenSpecs = append(givenSpecs, files[0])
 		} else {
-			files := util.GetSpecFiles([]string{specSource})
+			files := withoutSuiteSpec(util.GetSpecFiles([]string{specSource}))
 			for _, file := range files {
 				specificationFile, _ := addSpecFile(&specFiles, file)
 				specificationFile.indices = specificationFile.indices[0:0]
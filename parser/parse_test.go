@@ -289,3 +289,24 @@ func specialTableArg(val string) *gauge.StepArg {
 func specialStringArg(val string) *gauge.StepArg {
 	return &gauge.StepArg{ArgType: gauge.SpecialString, Name: val}
 }
+
+func (s *MySuite) TestSelectDataTableRowsByLine(c *C) {
+	specText := newSpecBuilder().specHeading("Spec").scenarioHeading("Scenario").tableHeader("id").tableRow("1").tableRow("2").tableRow("3").step("step <id>").String()
+	spec, res, err := new(SpecParser).Parse(specText, gauge.NewConceptDictionary(), "")
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	scn := spec.Scenarios[0]
+	c.Assert(scn.DataTable.RowLineNos, DeepEquals, []int{4, 5, 6})
+
+	selectDataTableRows(spec.Scenarios, []int{4, 6})
+	c.Assert(scn.SelectedDataTableRows, DeepEquals, []int{0, 2})
+
+	specs := GetSpecsForDataTableRows([]*gauge.Specification{spec}, gauge.NewBuildErrors())
+	c.Assert(len(specs[0].Scenarios), Equals, 2)
+	c.Assert(specs[0].Scenarios[0].ScenarioDataTableRowIndex, Equals, 0)
+	c.Assert(specs[0].Scenarios[1].ScenarioDataTableRowIndex, Equals, 2)
+	c.Assert(specs[0].Scenarios[1].DataTable.Table.GetRowCount(), Equals, 3)
+
+	selectDataTableRows([]*gauge.Scenario{scn}, []int{2, 5})
+	c.Assert(scn.SelectedDataTableRows, IsNil)
+}
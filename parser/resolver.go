@@ -19,6 +19,8 @@ package parser
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 
@@ -145,9 +147,29 @@ func createProtoStepTable(table *gauge.Table, lookup *gauge.ArgLookup) (*gauge_m
 	return protoTable, nil
 }
 
+// customResolvers are the resolvers of special param prefixes registered by language runners and plugins.
+var customResolvers = make(map[string]resolverFn)
+
+// RegisterSpecialParamPrefixes registers special param prefixes which are resolved by the language runner.
+// A param <prefix:value> is sent to the runner as a special string, with the value after the prefix as its value
+// and the param as its name, so that the runner can resolve it during execution.
+// Prefixes of predefined special params cannot be overridden.
+func RegisterSpecialParamPrefixes(prefixes ...string) {
+	for _, prefix := range prefixes {
+		customResolvers[strings.TrimSpace(prefix)] = func(value string) (*gauge.StepArg, error) {
+			return &gauge.StepArg{Value: value, ArgType: gauge.SpecialString}, nil
+		}
+	}
+}
+
 func newSpecialTypeResolver() *specialTypeResolver {
 	resolver := new(specialTypeResolver)
 	resolver.predefinedResolvers = initializePredefinedResolvers()
+	for prefix, fn := range customResolvers {
+		if _, ok := resolver.predefinedResolvers[prefix]; !ok {
+			resolver.predefinedResolvers[prefix] = fn
+		}
+	}
 	return resolver
 }
 
@@ -161,19 +183,51 @@ func initializePredefinedResolvers() map[string]resolverFn {
 			return &gauge.StepArg{Value: fileContent, ArgType: gauge.SpecialString}, nil
 		},
 		"table": func(filePath string) (*gauge.StepArg, error) {
-			csv, err := util.GetFileContents(filePath)
+			table, err := readTable(filePath)
 			if err != nil {
 				return nil, err
 			}
-			csvTable, err := convertCsvToTable(csv)
+			return &gauge.StepArg{Table: *table, ArgType: gauge.SpecialTable}, nil
+		},
+		"json": func(filePath string) (*gauge.StepArg, error) {
+			contents, err := util.GetFileContents(filePath)
 			if err != nil {
 				return nil, err
 			}
-			return &gauge.StepArg{Table: *csvTable, ArgType: gauge.SpecialTable}, nil
+			table, err := convertJSONToTable(contents)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", filePath, err.Error())
+			}
+			if table == nil {
+				return &gauge.StepArg{Value: contents, ArgType: gauge.SpecialString}, nil
+			}
+			return &gauge.StepArg{Table: *table, ArgType: gauge.SpecialTable}, nil
+		},
+		"env": func(name string) (*gauge.StepArg, error) {
+			value, ok := os.LookupEnv(name)
+			if !ok {
+				return nil, fmt.Errorf("Environment variable %s is not set", name)
+			}
+			return &gauge.StepArg{Value: value, ArgType: gauge.SpecialString}, nil
 		},
 	}
 }
 
+// readTable reads a table from a CSV, TSV or XLSX file, based on its extension.
+func readTable(filePath string) (*gauge.Table, error) {
+	if strings.ToLower(filepath.Ext(filePath)) == ".xlsx" {
+		return convertXlsxToTable(util.GetPathToFile(filePath))
+	}
+	contents, err := util.GetFileContents(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if strings.ToLower(filepath.Ext(filePath)) == ".tsv" {
+		return convertDelimitedTextToTable(contents, '\t')
+	}
+	return convertCsvToTable(contents)
+}
+
 func (resolver *specialTypeResolver) resolve(arg string) (*gauge.StepArg, error) {
 	if util.IsWindows() {
 		arg = GetUnescapedString(arg)
@@ -18,6 +18,7 @@
 package parser
 
 import (
+	"os"
 	"path/filepath"
 
 	"github.com/getgauge/gauge/gauge"
@@ -60,6 +61,39 @@ func (s *MySuite) TestParsingInvalidSpecialType(c *C) {
 	c.Assert(err.Error(), Equals, "Resolver not found for special param <unknown:foo>")
 }
 
+func (s *MySuite) TestParsingEnvSpecialType(c *C) {
+	os.Setenv("GAUGE_TEST_SPECIAL_PARAM", "bar")
+	defer os.Unsetenv("GAUGE_TEST_SPECIAL_PARAM")
+
+	stepArg, err := newSpecialTypeResolver().resolve("env:GAUGE_TEST_SPECIAL_PARAM")
+
+	c.Assert(err, IsNil)
+	c.Assert(stepArg.Value, Equals, "bar")
+	c.Assert(stepArg.ArgType, Equals, gauge.SpecialString)
+	c.Assert(stepArg.Name, Equals, "env:GAUGE_TEST_SPECIAL_PARAM")
+}
+
+func (s *MySuite) TestParsingEnvSpecialTypeWithUnsetVariable(c *C) {
+	_, err := newSpecialTypeResolver().resolve("env:GAUGE_TEST_UNSET_SPECIAL_PARAM")
+
+	c.Assert(err.Error(), Equals, "Environment variable GAUGE_TEST_UNSET_SPECIAL_PARAM is not set")
+}
+
+func (s *MySuite) TestParsingRegisteredSpecialParamPrefix(c *C) {
+	RegisterSpecialParamPrefixes("vault", "file")
+	defer func() { customResolvers = make(map[string]resolverFn) }()
+
+	stepArg, err := newSpecialTypeResolver().resolve("vault:db/password")
+
+	c.Assert(err, IsNil)
+	c.Assert(stepArg.Value, Equals, "db/password")
+	c.Assert(stepArg.ArgType, Equals, gauge.SpecialString)
+	c.Assert(stepArg.Name, Equals, "vault:db/password")
+
+	_, err = newSpecialTypeResolver().resolve("file:" + filepath.Join("testdata", "unknown.txt"))
+	c.Assert(err, NotNil)
+}
+
 func (s *MySuite) TestConvertCsvToTable(c *C) {
 	table, _ := convertCsvToTable("id,name\n1,foo\n2,bar")
 
@@ -0,0 +1,36 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package parser
+
+import "github.com/getgauge/gauge/gauge"
+
+// GetSpecsForScenarios creates a spec for each scenario, so that scenarios of a spec can be executed independently.
+// The created specs share the contexts, teardown steps and tags of the original spec. Data table driven specs are
+// already split for each data table row and are left untouched.
+func GetSpecsForScenarios(s []*gauge.Specification, errMap *gauge.BuildErrors) (specs []*gauge.Specification) {
+	for _, spec := range s {
+		if spec.DataTable.IsInitialized() || len(spec.Scenarios) < 2 {
+			specs = append(specs, spec)
+			continue
+		}
+		for _, scn := range spec.Scenarios {
+			specs = append(specs, createSpec([]*gauge.Scenario{scn}, &spec.DataTable.Table, spec, errMap))
+		}
+	}
+	return
+}
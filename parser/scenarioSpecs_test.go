@@ -0,0 +1,73 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+func TestGetSpecsForScenarios(t *testing.T) {
+	scn1 := &gauge.Scenario{Heading: &gauge.Heading{Value: "scenario 1"}}
+	scn2 := &gauge.Scenario{Heading: &gauge.Heading{Value: "scenario 2"}}
+	context := &gauge.Step{Value: "context"}
+	spec := &gauge.Specification{
+		FileName:  "foo.spec",
+		Heading:   &gauge.Heading{},
+		Scenarios: []*gauge.Scenario{scn1, scn2},
+		Contexts:  []*gauge.Step{context},
+		Items:     []gauge.Item{context, scn1, scn2},
+	}
+	errMap := gauge.NewBuildErrors()
+	errMap.SpecErrs[spec] = []error{ParseError{Message: "error"}}
+
+	got := GetSpecsForScenarios([]*gauge.Specification{spec}, errMap)
+
+	if len(got) != 2 {
+		t.Fatalf("Wanted: 2 specs, Got: %d specs", len(got))
+	}
+	for i, s := range got {
+		if len(s.Scenarios) != 1 || s.Scenarios[0] != spec.Scenarios[i] {
+			t.Errorf("Wanted spec %d to have only scenario %s, Got: %v", i, spec.Scenarios[i].Heading.Value, s.Scenarios)
+		}
+		if s.FileName != spec.FileName || len(s.Contexts) != 1 {
+			t.Errorf("Wanted spec %d to share file name and contexts of original spec", i)
+		}
+		if len(s.Items) != 2 || s.Items[1] != spec.Scenarios[i] {
+			t.Errorf("Wanted spec %d to have items [context scenario], Got: %v", i, s.Items)
+		}
+		if len(errMap.SpecErrs[s]) != 1 {
+			t.Errorf("Wanted spec %d to have errors of original spec", i)
+		}
+	}
+}
+
+func TestGetSpecsForScenariosDoesNotSplitDataTableDrivenSpecs(t *testing.T) {
+	spec := &gauge.Specification{
+		Heading:   &gauge.Heading{},
+		Scenarios: []*gauge.Scenario{{}, {}},
+		DataTable: gauge.DataTable{Table: *gauge.NewTable([]string{"header"}, [][]gauge.TableCell{{{Value: "row1", CellType: gauge.Static}}}, 0)},
+	}
+
+	got := GetSpecsForScenarios([]*gauge.Specification{spec}, gauge.NewBuildErrors())
+
+	if len(got) != 1 || got[0] != spec {
+		t.Errorf("Wanted data table driven spec to be left untouched, Got: %v", got)
+	}
+}
@@ -116,6 +116,9 @@ func (parser *SpecParser) validateSpec(specification *gauge.Specification) error
 	if len(strings.TrimSpace(specification.Heading.Value)) < 1 {
 		return ParseError{FileName: specification.FileName, LineNo: specification.Heading.LineNo, Message: "Spec heading should have at least one character"}
 	}
+	if IsSuiteSpec(specification.FileName) {
+		return validateSuiteSpec(specification)
+	}
 
 	dataTable := specification.DataTable.Table
 	if dataTable.IsInitialized() && dataTable.GetRowCount() == 0 {
@@ -0,0 +1,71 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+package parser
+
+import (
+	"path/filepath"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
+)
+
+// IsSuiteSpec checks if the file is the suite spec of the project. The contexts of the suite spec run once before the
+// suite and its teardown steps once after it, it is not executed as a spec.
+func IsSuiteSpec(file string) bool {
+	suiteSpec := suiteSpecFile()
+	if suiteSpec == "" {
+		return false
+	}
+	abs, err := filepath.Abs(file)
+	return err == nil && abs == suiteSpec
+}
+
+func suiteSpecFile() string {
+	file := env.SuiteSpec()
+	if file == "" {
+		return ""
+	}
+	if !filepath.IsAbs(file) {
+		file = filepath.Join(config.ProjectRoot, file)
+	}
+	return filepath.Clean(file)
+}
+
+// ParseSuiteSpec parses the suite spec of the project. The spec is nil if the project does not have one.
+func ParseSuiteSpec(conceptDictionary *gauge.ConceptDictionary) (*gauge.Specification, bool) {
+	file := suiteSpecFile()
+	if file == "" || !common.FileExists(file) {
+		return nil, false
+	}
+	specs, results := ParseSpecFiles([]string{file}, conceptDictionary, gauge.NewBuildErrors())
+	if HandleParseResult(results...) || len(specs) == 0 {
+		return nil, true
+	}
+	return specs[0], false
+}
+
+func validateSuiteSpec(spec *gauge.Specification) error {
+	if spec.DataTable.IsInitialized() {
+		return ParseError{FileName: spec.FileName, LineNo: spec.DataTable.Table.LineNo, Message: "Suite spec should not have a data table"}
+	}
+	if len(spec.Scenarios) > 0 {
+		return ParseError{FileName: spec.FileName, LineNo: spec.Scenarios[0].Heading.LineNo, Message: "Suite spec should not have scenarios, its contexts run before the suite and its teardown steps after it"}
+	}
+	return nil
+}
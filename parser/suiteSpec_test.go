@@ -0,0 +1,80 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package parser
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	. "gopkg.in/check.v1"
+)
+
+func setSuiteSpec(file string) string {
+	config.ProjectRoot, _ = filepath.Abs("testdata")
+	os.Setenv("suite_spec", file)
+	return filepath.Join(config.ProjectRoot, file)
+}
+
+func (s *MySuite) TestIsSuiteSpec(c *C) {
+	defer os.Unsetenv("suite_spec")
+	file := setSuiteSpec(filepath.Join("specs", "_suite.spec"))
+
+	c.Assert(IsSuiteSpec(file), Equals, true)
+	c.Assert(IsSuiteSpec(filepath.Join(config.ProjectRoot, "specs", "a.spec")), Equals, false)
+
+	os.Setenv("suite_spec", "")
+	c.Assert(IsSuiteSpec(file), Equals, false)
+}
+
+func (s *MySuite) TestSuiteSpecWithoutScenarios(c *C) {
+	defer os.Unsetenv("suite_spec")
+	file := setSuiteSpec(filepath.Join("specs", "_suite.spec"))
+	specText := newSpecBuilder().specHeading("Suite").step("open the browser").text("___").step("close the browser").String()
+
+	spec, res, err := new(SpecParser).Parse(specText, gauge.NewConceptDictionary(), file)
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(len(spec.Contexts), Equals, 1)
+	c.Assert(len(spec.TearDownSteps), Equals, 1)
+}
+
+func (s *MySuite) TestSuiteSpecWithScenarios(c *C) {
+	defer os.Unsetenv("suite_spec")
+	file := setSuiteSpec(filepath.Join("specs", "_suite.spec"))
+	specText := newSpecBuilder().specHeading("Suite").step("open the browser").scenarioHeading("Scenario").step("do it").String()
+
+	_, res, _ := new(SpecParser).Parse(specText, gauge.NewConceptDictionary(), file)
+
+	c.Assert(res.Ok, Equals, false)
+	c.Assert(res.ParseErrors[0].Message, Equals, "Suite spec should not have scenarios, its contexts run before the suite and its teardown steps after it")
+	c.Assert(res.ParseErrors[0].LineNo, Equals, 3)
+}
+
+func (s *MySuite) TestSpecWithoutScenariosIsNotTheSuiteSpec(c *C) {
+	defer os.Unsetenv("suite_spec")
+	setSuiteSpec(filepath.Join("specs", "_suite.spec"))
+	specText := newSpecBuilder().specHeading("Spec").step("open the browser").String()
+
+	_, res, _ := new(SpecParser).Parse(specText, gauge.NewConceptDictionary(), filepath.Join(config.ProjectRoot, "specs", "a.spec"))
+
+	c.Assert(res.Ok, Equals, false)
+	c.Assert(res.ParseErrors[0].Message, Equals, "Spec should have atleast one scenario")
+}
@@ -18,7 +18,11 @@
 package parser
 
 import (
+	"bytes"
 	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"os"
 	"strings"
 
@@ -27,11 +31,17 @@ import (
 )
 
 func convertCsvToTable(csvContents string) (*gauge.Table, error) {
-	r := csv.NewReader(strings.NewReader(csvContents))
+	delimiter := ','
 	var de = os.Getenv(env.CsvDelimiter)
 	if de != "" {
-		r.Comma = []rune(os.Getenv(env.CsvDelimiter))[0]
+		delimiter = []rune(os.Getenv(env.CsvDelimiter))[0]
 	}
+	return convertDelimitedTextToTable(csvContents, delimiter)
+}
+
+func convertDelimitedTextToTable(contents string, delimiter rune) (*gauge.Table, error) {
+	r := csv.NewReader(strings.NewReader(contents))
+	r.Comma = delimiter
 	r.Comment = '#'
 	lines, err := r.ReadAll()
 	if err != nil {
@@ -47,3 +57,122 @@ func convertCsvToTable(csvContents string) (*gauge.Table, error) {
 	}
 	return table, nil
 }
+
+// convertJSONToTable converts a JSON array of objects, or of arrays with the first one as headers, into a table.
+// It returns nil if the JSON is valid but not tabular.
+func convertJSONToTable(jsonContents string) (*gauge.Table, error) {
+	if !json.Valid([]byte(jsonContents)) {
+		return nil, errors.New("invalid JSON")
+	}
+	var rows []json.RawMessage
+	if err := json.Unmarshal([]byte(jsonContents), &rows); err != nil || len(rows) == 0 {
+		return nil, nil
+	}
+	table, ok := jsonObjectsToTable(rows)
+	if !ok {
+		var err error
+		if table, ok, err = jsonArraysToTable(rows); err != nil || !ok {
+			return nil, err
+		}
+	}
+	if len(table.Headers) == 0 {
+		return nil, errors.New("JSON table has no columns")
+	}
+	return table, nil
+}
+
+func jsonObjectsToTable(rows []json.RawMessage) (*gauge.Table, bool) {
+	var headers []string
+	hasHeader := make(map[string]bool)
+	var records []map[string]string
+	for _, row := range rows {
+		keys, values, err := jsonObject(row)
+		if err != nil {
+			return nil, false
+		}
+		for _, k := range keys {
+			if !hasHeader[k] {
+				hasHeader[k] = true
+				headers = append(headers, k)
+			}
+		}
+		records = append(records, values)
+	}
+	table := new(gauge.Table)
+	table.AddHeaders(headers)
+	for _, record := range records {
+		cells := make([]string, len(headers))
+		for i, h := range headers {
+			cells[i] = record[h]
+		}
+		table.AddRowValues(table.CreateTableCells(cells))
+	}
+	return table, true
+}
+
+func jsonArraysToTable(rows []json.RawMessage) (*gauge.Table, bool, error) {
+	table := new(gauge.Table)
+	for i, row := range rows {
+		var values []json.RawMessage
+		if err := json.Unmarshal(row, &values); err != nil {
+			return nil, false, nil
+		}
+		if i > 0 && len(values) != len(table.Headers) {
+			return nil, false, fmt.Errorf("JSON table row %d has %d cells, expected %d", i, len(values), len(table.Headers))
+		}
+		cells := make([]string, len(values))
+		for j, v := range values {
+			cells[j] = jsonCellValue(v)
+		}
+		if i == 0 {
+			table.AddHeaders(cells)
+		} else {
+			table.AddRowValues(table.CreateTableCells(cells))
+		}
+	}
+	return table, true, nil
+}
+
+// jsonObject returns the keys of a JSON object in the order they appear, and their values as table cell values.
+func jsonObject(raw json.RawMessage) ([]string, map[string]string, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	t, err := dec.Token()
+	if err != nil {
+		return nil, nil, err
+	}
+	if d, ok := t.(json.Delim); !ok || d != '{' {
+		return nil, nil, errors.New("not a JSON object")
+	}
+	var keys []string
+	values := make(map[string]string)
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		key := t.(string)
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, key)
+		values[key] = jsonCellValue(value)
+	}
+	return keys, values, nil
+}
+
+// jsonCellValue returns strings unquoted, null as empty and any other value as compact JSON.
+func jsonCellValue(value json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		return s
+	}
+	if string(value) == "null" {
+		return ""
+	}
+	b := &bytes.Buffer{}
+	if err := json.Compact(b, value); err != nil {
+		return string(value)
+	}
+	return b.String()
+}
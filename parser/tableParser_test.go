@@ -34,3 +34,54 @@ func (s *MySuite) TestIgnoreCommentLines(c *C) {
 	c.Assert(table.Rows()[0][1], Equals, "bar")
 	c.Assert(table.Rows()[0][2], Equals, "baz")
 }
+
+func (s *MySuite) TestConvertTsvToTable(c *C) {
+	table, err := convertDelimitedTextToTable("id\tname\n1\tfoo, bar", '\t')
+
+	c.Assert(err, IsNil)
+	c.Assert(table.Headers, DeepEquals, []string{"id", "name"})
+	c.Assert(table.Rows(), DeepEquals, [][]string{{"1", "foo, bar"}})
+}
+
+func (s *MySuite) TestConvertJSONArrayOfObjectsToTable(c *C) {
+	table, err := convertJSONToTable(`[{"name": "foo", "id": 1}, {"id": 2, "tags": ["a"], "name": null}]`)
+
+	c.Assert(err, IsNil)
+	c.Assert(table.Headers, DeepEquals, []string{"name", "id", "tags"})
+	c.Assert(table.Rows(), DeepEquals, [][]string{{"foo", "1", ""}, {"", "2", `["a"]`}})
+}
+
+func (s *MySuite) TestConvertJSONArrayOfArraysToTable(c *C) {
+	table, err := convertJSONToTable(`[["id", "name"], [1, "foo"]]`)
+
+	c.Assert(err, IsNil)
+	c.Assert(table.Headers, DeepEquals, []string{"id", "name"})
+	c.Assert(table.Rows(), DeepEquals, [][]string{{"1", "foo"}})
+}
+
+func (s *MySuite) TestConvertNonTabularJSONToTable(c *C) {
+	table, err := convertJSONToTable(`{"id": 1}`)
+
+	c.Assert(err, IsNil)
+	c.Assert(table, IsNil)
+}
+
+func (s *MySuite) TestConvertInvalidJSONToTable(c *C) {
+	_, err := convertJSONToTable(`[{"id": 1}`)
+
+	c.Assert(err, NotNil)
+}
+
+func (s *MySuite) TestConvertJSONWithoutColumnsToTable(c *C) {
+	_, err := convertJSONToTable(`[{}]`)
+	c.Assert(err, ErrorMatches, "JSON table has no columns")
+
+	_, err = convertJSONToTable(`[[]]`)
+	c.Assert(err, ErrorMatches, "JSON table has no columns")
+}
+
+func (s *MySuite) TestConvertJSONArrayWithMismatchedRowToTable(c *C) {
+	_, err := convertJSONToTable(`[["id", "name"], [1]]`)
+
+	c.Assert(err, ErrorMatches, "JSON table row 1 has 1 cells, expected 2")
+}
@@ -0,0 +1,204 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package parser
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+const (
+	xlsxWorkbook     = "xl/workbook.xml"
+	xlsxWorkbookRels = "xl/_rels/workbook.xml.rels"
+	xlsxSharedString = "xl/sharedStrings.xml"
+)
+
+type xlsxWorkbookSheets struct {
+	Sheets []struct {
+		RelationID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxText struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	text := t.Text
+	for _, r := range t.Runs {
+		text += r.Text
+	}
+	return text
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+type xlsxCell struct {
+	Ref    string   `xml:"r,attr"`
+	Type   string   `xml:"t,attr"`
+	Value  string   `xml:"v"`
+	Inline xlsxText `xml:"is"`
+}
+
+type xlsxWorksheet struct {
+	Rows []struct {
+		Cells []xlsxCell `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// convertXlsxToTable reads the first sheet of a XLSX workbook into a table, with its first row as headers.
+// Empty rows are skipped and numbers and dates are taken as stored in the workbook.
+func convertXlsxToTable(filePath string) (*gauge.Table, error) {
+	r, err := zip.OpenReader(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	files := make(map[string]*zip.File)
+	for _, f := range r.File {
+		files[f.Name] = f
+	}
+	sheetPath, err := firstSheetPath(files)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", filePath, err.Error())
+	}
+	var sharedStrings xlsxSharedStrings
+	if _, ok := files[xlsxSharedString]; ok {
+		if err := readXlsxPart(files, xlsxSharedString, &sharedStrings); err != nil {
+			return nil, fmt.Errorf("%s: %s", filePath, err.Error())
+		}
+	}
+	var sheet xlsxWorksheet
+	if err := readXlsxPart(files, sheetPath, &sheet); err != nil {
+		return nil, fmt.Errorf("%s: %s", filePath, err.Error())
+	}
+	table := new(gauge.Table)
+	for _, row := range sheet.Rows {
+		values := xlsxRowValues(row.Cells, sharedStrings)
+		if len(strings.Join(values, "")) == 0 {
+			continue
+		}
+		if len(table.Headers) == 0 {
+			table.AddHeaders(values)
+		} else {
+			table.AddRowValues(table.CreateTableCells(values))
+		}
+	}
+	return table, nil
+}
+
+func firstSheetPath(files map[string]*zip.File) (string, error) {
+	var workbook xlsxWorkbookSheets
+	if err := readXlsxPart(files, xlsxWorkbook, &workbook); err != nil {
+		return "", err
+	}
+	if len(workbook.Sheets) == 0 {
+		return "", fmt.Errorf("workbook has no sheets")
+	}
+	var rels xlsxRelationships
+	if err := readXlsxPart(files, xlsxWorkbookRels, &rels); err != nil {
+		return "", err
+	}
+	for _, rel := range rels.Relationships {
+		if rel.ID != workbook.Sheets[0].RelationID {
+			continue
+		}
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), nil
+		}
+		return "xl/" + rel.Target, nil
+	}
+	return "", fmt.Errorf("sheet %s not found", workbook.Sheets[0].RelationID)
+}
+
+func readXlsxPart(files map[string]*zip.File, name string, v interface{}) error {
+	f, ok := files[name]
+	if !ok {
+		return fmt.Errorf("%s not found", name)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	contents, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return err
+	}
+	return xml.Unmarshal(contents, v)
+}
+
+func xlsxRowValues(cells []xlsxCell, sharedStrings xlsxSharedStrings) []string {
+	var values []string
+	for _, c := range cells {
+		if i := xlsxColumnIndex(c.Ref); i >= len(values) {
+			values = append(values, make([]string, i-len(values))...)
+		}
+		values = append(values, xlsxCellValue(c, sharedStrings))
+	}
+	return values
+}
+
+// xlsxColumnIndex returns the zero based column index of a cell reference like AB12, or -1 if there is none.
+func xlsxColumnIndex(ref string) int {
+	index := 0
+	for _, ch := range ref {
+		if ch < 'A' || ch > 'Z' {
+			break
+		}
+		index = index*26 + int(ch-'A') + 1
+	}
+	return index - 1
+}
+
+func xlsxCellValue(c xlsxCell, sharedStrings xlsxSharedStrings) string {
+	switch c.Type {
+	case "s":
+		i, err := strconv.Atoi(c.Value)
+		if err != nil || i < 0 || i >= len(sharedStrings.Items) {
+			return ""
+		}
+		return sharedStrings.Items[i].String()
+	case "inlineStr":
+		return c.Inline.String()
+	case "b":
+		if c.Value == "1" {
+			return "TRUE"
+		}
+		return "FALSE"
+	}
+	return c.Value
+}
@@ -0,0 +1,77 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package parser
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+func writeXlsx(c *C, parts map[string]string) string {
+	dir, err := ioutil.TempDir("", "xlsx")
+	c.Assert(err, IsNil)
+	path := filepath.Join(dir, "table.xlsx")
+	f, err := os.Create(path)
+	c.Assert(err, IsNil)
+	defer f.Close()
+	w := zip.NewWriter(f)
+	for name, contents := range parts {
+		pw, err := w.Create(name)
+		c.Assert(err, IsNil)
+		pw.Write([]byte(contents))
+	}
+	c.Assert(w.Close(), IsNil)
+	return path
+}
+
+func (s *MySuite) TestConvertXlsxToTable(c *C) {
+	path := writeXlsx(c, map[string]string{
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="Users" sheetId="1" r:id="rId2"/><sheet name="Other" sheetId="2" r:id="rId1"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Target="worksheets/sheet2.xml"/><Relationship Id="rId2" Target="worksheets/sheet1.xml"/></Relationships>`,
+		"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><si><t>id</t></si><si><t>name</t></si><si><r><t>fo</t></r><r><t>o</t></r></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="C1" t="inlineStr"><is><t>active</t></is></c></row>
+<row r="2"/>
+<row r="3"><c r="A3"><v>1</v></c><c r="B3" t="s"><v>2</v></c><c r="C3" t="b"><v>1</v></c></row>
+<row r="4"><c r="A4"><v>2</v></c><c r="C4" t="b"><v>0</v></c></row>
+</sheetData></worksheet>`,
+		"xl/worksheets/sheet2.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData/></worksheet>`,
+	})
+	defer os.RemoveAll(filepath.Dir(path))
+
+	table, err := convertXlsxToTable(path)
+
+	c.Assert(err, IsNil)
+	c.Assert(table.Headers, DeepEquals, []string{"id", "name", "active"})
+	c.Assert(table.Rows(), DeepEquals, [][]string{{"1", "foo", "TRUE"}, {"2", "", "FALSE"}})
+}
+
+func (s *MySuite) TestConvertXlsxWithoutWorkbookToTable(c *C) {
+	path := writeXlsx(c, map[string]string{"xl/worksheets/sheet1.xml": "<worksheet/>"})
+	defer os.RemoveAll(filepath.Dir(path))
+
+	_, err := convertXlsxToTable(path)
+
+	c.Assert(err, NotNil)
+}
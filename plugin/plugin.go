@@ -38,6 +38,7 @@ import (
 	"github.com/getgauge/gauge/manifest"
 	"github.com/getgauge/gauge/plugin/pluginInfo"
 	"github.com/getgauge/gauge/reporter"
+	"github.com/getgauge/gauge/util"
 	"github.com/getgauge/gauge/version"
 	"github.com/golang/protobuf/proto"
 )
@@ -184,7 +185,7 @@ func StartPlugin(pd *pluginDescriptor, action pluginScope) (*plugin, error) {
 		return nil, fmt.Errorf("Platform specific command not specified: %s.", runtime.GOOS)
 	}
 
-	cmd, err := common.ExecuteCommand(command, pd.pluginPath, reporter.Current(), reporter.Current())
+	cmd, err := util.ExecuteCommandWithEnv(command, pd.pluginPath, reporter.Current(), reporter.Current(), nil)
 
 	if err != nil {
 		return nil, err
@@ -192,6 +193,7 @@ func StartPlugin(pd *pluginDescriptor, action pluginScope) (*plugin, error) {
 	var mutex = &sync.Mutex{}
 	go func() {
 		pState, _ := cmd.Process.Wait()
+		util.KillProcessGroup(cmd.Process)
 		mutex.Lock()
 		cmd.ProcessState = pState
 		mutex.Unlock()
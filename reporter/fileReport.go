@@ -0,0 +1,284 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package reporter
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+)
+
+const (
+	// JUnit writes the execution result as JUnit XML
+	JUnit = "junit"
+	// TAP writes the execution result in Test Anything Protocol format
+	TAP = "tap"
+)
+
+// ReportFormat is the format in which the execution result is written to ReportOutput, reports are not written if empty.
+var ReportFormat string
+
+// ReportOutput is the file to which the execution result is written, defaults to a file in the reports directory.
+var ReportOutput string
+
+// IsSupportedReportFormat checks if a report can be written in the given format
+func IsSupportedReportFormat(format string) bool {
+	format = strings.ToLower(format)
+	return format == JUnit || format == TAP
+}
+
+type caseStatus int
+
+const (
+	casePassed caseStatus = iota
+	caseFailed
+	caseSkipped
+	caseErrored
+)
+
+// reportCase is a scenario, data table row or a hook failure as it appears in a report
+type reportCase struct {
+	name       string
+	time       int64
+	status     caseStatus
+	message    string
+	stackTrace string
+	retries    []string
+}
+
+// reportSuite is a specification as it appears in a report
+type reportSuite struct {
+	name  string
+	file  string
+	time  int64
+	cases []*reportCase
+}
+
+// retries holds the failure messages of the earlier attempts of the finally reported scenario
+type retries map[*gm.ProtoScenario][]string
+
+// ListenSuiteEndAndWriteReport listens to execution events and writes the suite result in ReportFormat to ReportOutput
+func ListenSuiteEndAndWriteReport(wg *sync.WaitGroup) {
+	ch := make(chan event.ExecutionEvent, 0)
+	event.Register(ch, event.ScenarioEnd, event.SuiteEnd)
+	wg.Add(1)
+
+	go func() {
+		attempts := make(map[*gauge.Scenario][]*gm.ProtoScenario)
+		for {
+			e := <-ch
+			switch e.Topic {
+			case event.ScenarioEnd:
+				s := e.Item.(*gauge.Scenario)
+				attempts[s] = append(attempts[s], e.Result.(*result.ScenarioResult).ProtoScenario)
+			case event.SuiteEnd:
+				r := make(retries)
+				for _, a := range attempts {
+					for _, previous := range a[:len(a)-1] {
+						msg, _ := scenarioFailure(previous)
+						r[a[len(a)-1]] = append(r[a[len(a)-1]], msg)
+					}
+				}
+				res := gauge.ConvertToProtoSuiteResult(e.Result.(*result.SuiteResult))
+				if err := writeReportFile(ReportFormat, reportOutputFile(ReportFormat), res, r); err != nil {
+					logger.Errorf(true, "Failed to write %s report. %s", ReportFormat, err.Error())
+				}
+				wg.Done()
+				return
+			}
+		}
+	}()
+}
+
+func reportOutputFile(format string) string {
+	if ReportOutput != "" {
+		return ReportOutput
+	}
+	if strings.ToLower(format) == TAP {
+		return filepath.Join(ReportsDir(), "result.tap")
+	}
+	return filepath.Join(ReportsDir(), "junit.xml")
+}
+
+// ReportsDir gives the absolute path of the reports directory of the project
+func ReportsDir() string {
+	reportsDir := os.Getenv(env.GaugeReportsDir)
+	if reportsDir == "" {
+		reportsDir = "reports"
+	}
+	if !filepath.IsAbs(reportsDir) {
+		reportsDir = filepath.Join(config.ProjectRoot, reportsDir)
+	}
+	return reportsDir
+}
+
+func writeReportFile(format, file string, res *gm.ProtoSuiteResult, r retries) error {
+	if err := os.MkdirAll(filepath.Dir(file), common.NewDirectoryPermissions); err != nil {
+		return err
+	}
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err = WriteReport(format, f, res, r); err != nil {
+		return err
+	}
+	logger.Debugf(true, "%s report written to %s", format, file)
+	return nil
+}
+
+// WriteReport writes the suite result in the given format. Retries of scenarios are reported if known.
+func WriteReport(format string, w io.Writer, res *gm.ProtoSuiteResult, r retries) error {
+	switch strings.ToLower(format) {
+	case JUnit:
+		return writeJUnit(w, res, r)
+	case TAP:
+		return writeTAP(w, res, r)
+	}
+	return fmt.Errorf("unsupported report format %s", format)
+}
+
+func reportSuites(res *gm.ProtoSuiteResult, r retries) []*reportSuite {
+	var suites []*reportSuite
+	if hooks := suiteHookCases(res); len(hooks) > 0 {
+		suites = append(suites, &reportSuite{name: "Suite hooks", cases: hooks})
+	}
+	for _, specRes := range res.GetSpecResults() {
+		spec := specRes.GetProtoSpec()
+		s := &reportSuite{name: spec.GetSpecHeading(), file: spec.GetFileName(), time: specRes.GetExecutionTime()}
+		if specRes.GetFailed() && len(specRes.GetErrors()) > 0 {
+			var msgs []string
+			for _, err := range specRes.GetErrors() {
+				msgs = append(msgs, err.GetMessage())
+			}
+			s.cases = append(s.cases, &reportCase{name: "Specification errors", status: caseErrored, message: strings.Join(msgs, "\n")})
+		}
+		s.cases = append(s.cases, hookCases("Before Spec", spec.GetPreHookFailures())...)
+		for _, item := range spec.GetItems() {
+			switch item.GetItemType() {
+			case gm.ProtoItem_Scenario:
+				s.cases = append(s.cases, scenarioCase(item.GetScenario(), item.GetScenario().GetScenarioHeading(), r))
+			case gm.ProtoItem_TableDrivenScenario:
+				scn := item.GetTableDrivenScenario().GetScenario()
+				if scn.GetExcludedTableRow() {
+					continue
+				}
+				s.cases = append(s.cases, scenarioCase(scn, tableDrivenScenarioName(item.GetTableDrivenScenario()), r))
+			}
+		}
+		s.cases = append(s.cases, hookCases("After Spec", spec.GetPostHookFailures())...)
+		suites = append(suites, s)
+	}
+	return suites
+}
+
+func suiteHookCases(res *gm.ProtoSuiteResult) []*reportCase {
+	var cases []*reportCase
+	if res.GetPreHookFailure() != nil {
+		cases = append(cases, hookCases("Before Suite", []*gm.ProtoHookFailure{res.GetPreHookFailure()})...)
+	}
+	if res.GetPostHookFailure() != nil {
+		cases = append(cases, hookCases("After Suite", []*gm.ProtoHookFailure{res.GetPostHookFailure()})...)
+	}
+	return cases
+}
+
+func hookCases(name string, failures []*gm.ProtoHookFailure) []*reportCase {
+	var cases []*reportCase
+	for _, f := range failures {
+		cases = append(cases, &reportCase{name: name, status: caseErrored, message: f.GetErrorMessage(), stackTrace: f.GetStackTrace()})
+	}
+	return cases
+}
+
+func scenarioCase(scn *gm.ProtoScenario, name string, r retries) *reportCase {
+	c := &reportCase{name: name, time: scn.GetExecutionTime(), retries: r[scn]}
+	switch scn.GetExecutionStatus() {
+	case gm.ExecutionStatus_FAILED:
+		c.status = caseFailed
+		c.message, c.stackTrace = scenarioFailure(scn)
+	case gm.ExecutionStatus_SKIPPED:
+		c.status = caseSkipped
+		c.message = strings.Join(scn.GetSkipErrors(), "\n")
+	}
+	return c
+}
+
+func tableDrivenScenarioName(t *gm.ProtoTableDrivenScenario) string {
+	heading := t.GetScenario().GetScenarioHeading()
+	if !t.GetIsScenarioTableDriven() {
+		return fmt.Sprintf("%s [row %d]", heading, t.GetTableRowIndex()+1)
+	}
+	if t.GetIsSpecTableDriven() {
+		return fmt.Sprintf("%s [row %d, scenario row %d]", heading, t.GetTableRowIndex()+1, t.GetScenarioTableRowIndex()+1)
+	}
+	return fmt.Sprintf("%s [scenario row %d]", heading, t.GetScenarioTableRowIndex()+1)
+}
+
+// scenarioFailure gives the error message and stacktrace of the first failure in a scenario
+func scenarioFailure(scn *gm.ProtoScenario) (string, string) {
+	if f := scn.GetPreHookFailure(); f != nil {
+		return "Before Scenario hook failed: " + f.GetErrorMessage(), f.GetStackTrace()
+	}
+	for _, items := range [][]*gm.ProtoItem{scn.GetContexts(), scn.GetScenarioItems(), scn.GetTearDownSteps()} {
+		if msg, stackTrace, ok := itemsFailure(items); ok {
+			return msg, stackTrace
+		}
+	}
+	if f := scn.GetPostHookFailure(); f != nil {
+		return "After Scenario hook failed: " + f.GetErrorMessage(), f.GetStackTrace()
+	}
+	return "", ""
+}
+
+func itemsFailure(items []*gm.ProtoItem) (string, string, bool) {
+	for _, item := range items {
+		switch item.GetItemType() {
+		case gm.ProtoItem_Step:
+			step := item.GetStep()
+			res := step.GetStepExecutionResult()
+			if f := res.GetPreHookFailure(); f != nil {
+				return fmt.Sprintf("Before Step hook failed for step '%s': %s", step.GetActualText(), f.GetErrorMessage()), f.GetStackTrace(), true
+			}
+			if res.GetExecutionResult().GetFailed() {
+				return fmt.Sprintf("Step '%s' failed: %s", step.GetActualText(), res.GetExecutionResult().GetErrorMessage()), res.GetExecutionResult().GetStackTrace(), true
+			}
+			if f := res.GetPostHookFailure(); f != nil {
+				return fmt.Sprintf("After Step hook failed for step '%s': %s", step.GetActualText(), f.GetErrorMessage()), f.GetStackTrace(), true
+			}
+		case gm.ProtoItem_Concept:
+			if msg, stackTrace, ok := itemsFailure(item.GetConcept().GetSteps()); ok {
+				return msg, stackTrace, true
+			}
+		}
+	}
+	return "", "", false
+}
@@ -0,0 +1,90 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package reporter
+
+import (
+	gm "github.com/getgauge/gauge/gauge_messages"
+	. "gopkg.in/check.v1"
+)
+
+func failingStep(text, message string) *gm.ProtoItem {
+	return &gm.ProtoItem{ItemType: gm.ProtoItem_Step, Step: &gm.ProtoStep{ActualText: text, StepExecutionResult: &gm.ProtoStepExecutionResult{
+		ExecutionResult: &gm.ProtoExecutionResult{Failed: true, ErrorMessage: message, StackTrace: "stacktrace"},
+	}}}
+}
+
+func reportTestSuiteResult() (*gm.ProtoSuiteResult, *gm.ProtoScenario) {
+	retried := &gm.ProtoScenario{ScenarioHeading: "Retried", ExecutionStatus: gm.ExecutionStatus_PASSED, ExecutionTime: 1500}
+	return &gm.ProtoSuiteResult{
+		ProjectName:    "project",
+		ExecutionTime:  4000,
+		PreHookFailure: &gm.ProtoHookFailure{ErrorMessage: "before suite failed"},
+		SpecResults: []*gm.ProtoSpecResult{{
+			ExecutionTime: 3000,
+			ProtoSpec: &gm.ProtoSpec{SpecHeading: "Login", FileName: "login.spec",
+				PostHookFailures: []*gm.ProtoHookFailure{{ErrorMessage: "after spec failed", StackTrace: "trace"}},
+				Items: []*gm.ProtoItem{
+					{ItemType: gm.ProtoItem_Scenario, Scenario: &gm.ProtoScenario{ScenarioHeading: "Fails", ExecutionStatus: gm.ExecutionStatus_FAILED,
+						ScenarioItems: []*gm.ProtoItem{failingStep("Login as admin", "wrong password")}}},
+					{ItemType: gm.ProtoItem_Scenario, Scenario: retried},
+					{ItemType: gm.ProtoItem_TableDrivenScenario, TableDrivenScenario: &gm.ProtoTableDrivenScenario{TableRowIndex: 1,
+						Scenario: &gm.ProtoScenario{ScenarioHeading: "Row", ExecutionStatus: gm.ExecutionStatus_SKIPPED, SkipErrors: []string{"Step implementation not found"}}}},
+					{ItemType: gm.ProtoItem_TableDrivenScenario, TableDrivenScenario: &gm.ProtoTableDrivenScenario{TableRowIndex: 2,
+						Scenario: &gm.ProtoScenario{ScenarioHeading: "Row", ExecutionStatus: gm.ExecutionStatus_SKIPPED, SkipErrors: []string{"Doesn't satisfy --table-rows flag condition"}, ExcludedTableRow: true}}},
+				},
+			},
+		}},
+	}, retried
+}
+
+func (s *MySuite) TestReportSuites(c *C) {
+	res, retried := reportTestSuiteResult()
+
+	suites := reportSuites(res, retries{retried: []string{"flaky"}})
+
+	c.Assert(len(suites), Equals, 2)
+	c.Assert(suites[0].name, Equals, "Suite hooks")
+	c.Assert(suites[0].cases, DeepEquals, []*reportCase{{name: "Before Suite", status: caseErrored, message: "before suite failed"}})
+	c.Assert(suites[1].name, Equals, "Login")
+	c.Assert(suites[1].file, Equals, "login.spec")
+	c.Assert(suites[1].cases, DeepEquals, []*reportCase{
+		{name: "Fails", status: caseFailed, message: "Step 'Login as admin' failed: wrong password", stackTrace: "stacktrace"},
+		{name: "Retried", status: casePassed, time: 1500, retries: []string{"flaky"}},
+		{name: "Row [row 2]", status: caseSkipped, message: "Step implementation not found"},
+		{name: "After Spec", status: caseErrored, message: "after spec failed", stackTrace: "trace"},
+	})
+}
+
+func (s *MySuite) TestScenarioFailureFromConceptAndHooks(c *C) {
+	scn := &gm.ProtoScenario{ScenarioItems: []*gm.ProtoItem{
+		{ItemType: gm.ProtoItem_Concept, Concept: &gm.ProtoConcept{Steps: []*gm.ProtoItem{failingStep("inner step", "boom")}}},
+	}}
+	msg, stackTrace := scenarioFailure(scn)
+	c.Assert(msg, Equals, "Step 'inner step' failed: boom")
+	c.Assert(stackTrace, Equals, "stacktrace")
+
+	scn.PreHookFailure = &gm.ProtoHookFailure{ErrorMessage: "no browser"}
+	msg, _ = scenarioFailure(scn)
+	c.Assert(msg, Equals, "Before Scenario hook failed: no browser")
+}
+
+func (s *MySuite) TestWriteReportWithUnsupportedFormat(c *C) {
+	err := WriteReport("html", newDummyWriter(), &gm.ProtoSuiteResult{}, nil)
+
+	c.Assert(err, ErrorMatches, "unsupported report format html")
+}
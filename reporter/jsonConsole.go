@@ -80,6 +80,8 @@ type executionResult struct {
 	BeforeHookFailure *executionError  `json:"beforeHookFailure,omitempty"`
 	AfterHookFailure  *executionError  `json:"afterHookFailure,omitempty"`
 	Table             *tableInfo       `json:"table,omitempty"`
+	ScenarioTable     *tableInfo       `json:"scenarioTable,omitempty"`
+	Flaky             bool             `json:"flaky,omitempty"`
 }
 
 type tableInfo struct {
@@ -163,13 +165,13 @@ func (c *jsonConsole) ScenarioStart(scenario *gauge.Scenario, i gm.ExecutionInfo
 	parentID := getIDWithRow(i.CurrentSpec.FileName, []*gauge.Scenario{scenario}, addRow)
 	e := executionEvent{
 		EventType: scenarioStart,
-		ID:        parentID + ":" + strconv.Itoa(scenario.Span.Start),
+		ID:        getScenarioID(parentID, scenario),
 		ParentID:  parentID,
 		Filename:  i.CurrentSpec.FileName,
 		Line:      scenario.Heading.LineNo,
 		Name:      scenario.Heading.Value,
 		Stream:    c.stream,
-		Res:       &executionResult{Table: getTable(scenario)},
+		Res:       &executionResult{Table: getTable(scenario), ScenarioTable: getScenarioTable(scenario)},
 	}
 	c.write(e)
 }
@@ -181,7 +183,7 @@ func (c *jsonConsole) ScenarioEnd(scenario *gauge.Scenario, res result.Result, i
 	parentID := getIDWithRow(i.CurrentSpec.FileName, []*gauge.Scenario{scenario}, addRow)
 	e := executionEvent{
 		EventType: scenarioEnd,
-		ID:        parentID + ":" + strconv.Itoa(scenario.Span.Start),
+		ID:        getScenarioID(parentID, scenario),
 		ParentID:  parentID,
 		Filename:  i.CurrentSpec.FileName,
 		Line:      scenario.Heading.LineNo,
@@ -194,6 +196,8 @@ func (c *jsonConsole) ScenarioEnd(scenario *gauge.Scenario, res result.Result, i
 			BeforeHookFailure: getHookFailure(res.GetPreHook(), "Before Scenario"),
 			AfterHookFailure:  getHookFailure(res.GetPostHook(), "After Scenario"),
 			Table:             getTable(scenario),
+			ScenarioTable:     getScenarioTable(scenario),
+			Flaky:             res.(*result.ScenarioResult).Flaky(),
 		},
 	}
 	c.write(e)
@@ -255,6 +259,15 @@ func getIDWithRow(name string, scenarios []*gauge.Scenario, isDataTable bool) st
 	return name + ":" + strconv.Itoa(scenarios[0].SpecDataTableRowIndex)
 }
 
+// getScenarioID gives the id of the scenario, a scenario executed for a row of its data table has an id for each row.
+func getScenarioID(parentID string, scenario *gauge.Scenario) string {
+	id := parentID + ":" + strconv.Itoa(scenario.Span.Start)
+	if scenario.ScenarioDataTableRow.IsInitialized() {
+		id += ":" + strconv.Itoa(scenario.ScenarioDataTableRowIndex)
+	}
+	return id
+}
+
 func getScenarioStatus(result *result.ScenarioResult) status {
 	return getStatus(result.ProtoScenario.GetExecutionStatus() == gm.ExecutionStatus_FAILED,
 		result.ProtoScenario.GetExecutionStatus() == gm.ExecutionStatus_SKIPPED)
@@ -334,6 +347,16 @@ func getTable(scenario *gauge.Scenario) *tableInfo {
 	return nil
 }
 
+func getScenarioTable(scenario *gauge.Scenario) *tableInfo {
+	if scenario.ScenarioDataTableRow.IsInitialized() {
+		return &tableInfo{
+			Text: formatter.FormatTable(&scenario.ScenarioDataTableRow),
+			Row:  scenario.ScenarioDataTableRowIndex,
+		}
+	}
+	return nil
+}
+
 func getHookFailure(hookFailure []*gm.ProtoHookFailure, text string) *executionError {
 	if len(hookFailure) > 0 {
 		return &executionError{
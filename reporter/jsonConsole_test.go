@@ -94,6 +94,27 @@ func (s *MySuite) TestScenarioStart_JSONConsole(c *C) {
 	c.Assert(dw.output, Equals, expected)
 }
 
+func (s *MySuite) TestScenarioStartForScenarioTableRow_JSONConsole(c *C) {
+	dw, jc := setupJSONConsole()
+
+	scenario := &gauge.Scenario{
+		Heading:                   &gauge.Heading{Value: "Scenario", LineNo: 2, HeadingType: 1},
+		Span:                      &gauge.Span{Start: 2, End: 7},
+		ScenarioDataTableRow:      *gauge.NewTable([]string{"id"}, [][]gauge.TableCell{{{Value: "2", CellType: gauge.Static}}}, 3),
+		ScenarioDataTableRowIndex: 1,
+	}
+
+	info := gauge_messages.ExecutionInfo{
+		CurrentSpec:     &gauge_messages.SpecInfo{Name: "Specification", FileName: "file"},
+		CurrentScenario: &gauge_messages.ScenarioInfo{Name: "Scenario"},
+	}
+
+	expected := `{"type":"scenarioStart","id":"file:2:1","parentId":"file","name":"Scenario","filename":"file","line":2,"result":{"time":0,"scenarioTable":{"text":"\n   |id|\n   |--|\n   |2 |\n","rowIndex":1}}}
+`
+	jc.ScenarioStart(scenario, info, &result.ScenarioResult{})
+	c.Assert(dw.output, Equals, expected)
+}
+
 func (s *MySuite) TestScenarioEnd_JSONConsole(c *C) {
 	dw, jc := setupJSONConsole()
 
@@ -133,6 +154,25 @@ func (s *MySuite) TestScenarioEnd_JSONConsole(c *C) {
 	c.Assert(dw.output, Equals, expected)
 }
 
+func (s *MySuite) TestScenarioEndForFlakyScenario_JSONConsole(c *C) {
+	dw, jc := setupJSONConsole()
+	protoScenario := &gauge_messages.ProtoScenario{ScenarioHeading: "Scenario", ExecutionStatus: gauge_messages.ExecutionStatus_PASSED}
+	scenario := &gauge.Scenario{
+		Heading: &gauge.Heading{Value: "Scenario", LineNo: 2},
+		Span:    &gauge.Span{Start: 2, End: 3},
+	}
+	info := gauge_messages.ExecutionInfo{
+		CurrentSpec:     &gauge_messages.SpecInfo{Name: "Specification", FileName: "file"},
+		CurrentScenario: &gauge_messages.ScenarioInfo{Name: "Scenario"},
+	}
+
+	expected := `{"type":"scenarioEnd","id":"file:2","parentId":"file","name":"Scenario","filename":"file","line":2,"result":{"status":"pass","time":0,"flaky":true}}
+`
+
+	jc.ScenarioEnd(scenario, &result.ScenarioResult{ProtoScenario: protoScenario, Retries: 1}, info)
+	c.Assert(dw.output, Equals, expected)
+}
+
 func (s *MySuite) TestScenarioEndWithPreHookFailure_JSONConsole(c *C) {
 	dw, jc := setupJSONConsole()
 
@@ -0,0 +1,132 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package reporter
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/getgauge/gauge/config"
+	gm "github.com/getgauge/gauge/gauge_messages"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name          `xml:"testsuites"`
+	Name     string            `xml:"name,attr"`
+	Tests    int               `xml:"tests,attr"`
+	Failures int               `xml:"failures,attr"`
+	Errors   int               `xml:"errors,attr"`
+	Skipped  int               `xml:"skipped,attr"`
+	Time     string            `xml:"time,attr"`
+	Suites   []*junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string           `xml:"name,attr"`
+	Tests     int              `xml:"tests,attr"`
+	Failures  int              `xml:"failures,attr"`
+	Errors    int              `xml:"errors,attr"`
+	Skipped   int              `xml:"skipped,attr"`
+	Time      string           `xml:"time,attr"`
+	Timestamp string           `xml:"timestamp,attr,omitempty"`
+	File      string           `xml:"file,attr,omitempty"`
+	TestCases []*junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name          string          `xml:"name,attr"`
+	ClassName     string          `xml:"classname,attr"`
+	Time          string          `xml:"time,attr"`
+	Failure       *junitFailure   `xml:"failure,omitempty"`
+	Error         *junitFailure   `xml:"error,omitempty"`
+	Skipped       *junitSkipped   `xml:"skipped,omitempty"`
+	FlakyFailures []*junitFailure `xml:"flakyFailure,omitempty"`
+	RerunFailures []*junitFailure `xml:"rerunFailure,omitempty"`
+}
+
+type junitFailure struct {
+	Message  string `xml:"message,attr"`
+	Contents string `xml:",chardata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr,omitempty"`
+}
+
+func writeJUnit(w io.Writer, res *gm.ProtoSuiteResult, r retries) error {
+	suites := &junitTestSuites{Name: res.GetProjectName(), Time: junitTime(res.GetExecutionTime())}
+	for _, s := range reportSuites(res, r) {
+		suite := &junitTestSuite{Name: s.name, File: s.file, Time: junitTime(s.time), Timestamp: junitTimestamp(res.GetTimestamp())}
+		for _, c := range s.cases {
+			suite.TestCases = append(suite.TestCases, junitCase(suite, s, c))
+		}
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Errors += suite.Errors
+		suites.Skipped += suite.Skipped
+		suites.Suites = append(suites.Suites, suite)
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	e := xml.NewEncoder(w)
+	e.Indent("", "  ")
+	if err := e.Encode(suites); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func junitCase(suite *junitTestSuite, s *reportSuite, c *reportCase) *junitTestCase {
+	tc := &junitTestCase{Name: c.name, ClassName: s.name, Time: junitTime(c.time)}
+	suite.Tests++
+	switch c.status {
+	case caseFailed:
+		suite.Failures++
+		tc.Failure = &junitFailure{Message: c.message, Contents: c.stackTrace}
+		for _, msg := range c.retries {
+			tc.RerunFailures = append(tc.RerunFailures, &junitFailure{Message: msg})
+		}
+	case caseErrored:
+		suite.Errors++
+		tc.Error = &junitFailure{Message: c.message, Contents: c.stackTrace}
+	case caseSkipped:
+		suite.Skipped++
+		tc.Skipped = &junitSkipped{Message: c.message}
+	default:
+		for _, msg := range c.retries {
+			tc.FlakyFailures = append(tc.FlakyFailures, &junitFailure{Message: msg})
+		}
+	}
+	return tc
+}
+
+func junitTime(ms int64) string {
+	return fmt.Sprintf("%.3f", float64(ms)/1000)
+}
+
+func junitTimestamp(timestamp string) string {
+	t, err := time.ParseInLocation(config.LayoutForTimeStamp, timestamp, time.Local)
+	if err != nil {
+		return ""
+	}
+	return t.Format("2006-01-02T15:04:05")
+}
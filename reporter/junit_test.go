@@ -0,0 +1,61 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package reporter
+
+import (
+	"bytes"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestWriteJUnit(c *C) {
+	res, retried := reportTestSuiteResult()
+	b := &bytes.Buffer{}
+
+	err := writeJUnit(b, res, retries{retried: []string{"flaky"}})
+
+	c.Assert(err, IsNil)
+	c.Assert(b.String(), Equals, `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="project" tests="5" failures="1" errors="2" skipped="1" time="4.000">
+  <testsuite name="Suite hooks" tests="1" failures="0" errors="1" skipped="0" time="0.000">
+    <testcase name="Before Suite" classname="Suite hooks" time="0.000">
+      <error message="before suite failed"></error>
+    </testcase>
+  </testsuite>
+  <testsuite name="Login" tests="4" failures="1" errors="1" skipped="1" time="3.000" file="login.spec">
+    <testcase name="Fails" classname="Login" time="0.000">
+      <failure message="Step &#39;Login as admin&#39; failed: wrong password">stacktrace</failure>
+    </testcase>
+    <testcase name="Retried" classname="Login" time="1.500">
+      <flakyFailure message="flaky"></flakyFailure>
+    </testcase>
+    <testcase name="Row [row 2]" classname="Login" time="0.000">
+      <skipped message="Step implementation not found"></skipped>
+    </testcase>
+    <testcase name="After Spec" classname="Login" time="0.000">
+      <error message="after spec failed">trace</error>
+    </testcase>
+  </testsuite>
+</testsuites>
+`)
+}
+
+func (s *MySuite) TestJUnitTimestamp(c *C) {
+	c.Assert(junitTimestamp("Jan 2, 2006 at 3:04pm"), Equals, "2006-01-02T15:04:00")
+	c.Assert(junitTimestamp("invalid"), Equals, "")
+}
@@ -130,7 +130,7 @@ func initParallelReporters() {
 func ListenExecutionEvents(wg *sync.WaitGroup) {
 	ch := make(chan event.ExecutionEvent, 0)
 	initParallelReporters()
-	event.Register(ch, event.SuiteStart, event.SpecStart, event.SpecEnd, event.ScenarioStart, event.ScenarioEnd, event.StepStart, event.StepEnd, event.ConceptStart, event.ConceptEnd, event.SuiteEnd)
+	event.Register(ch, event.SuiteStart, event.SpecStart, event.SpecEnd, event.ScenarioStart, event.ScenarioEnd, event.StepStart, event.StepEnd, event.ConceptStart, event.ConceptEnd, event.SuiteEnd, event.SuiteStepsStart, event.SuiteStepsEnd)
 	var r Reporter
 	wg.Add(1)
 
@@ -157,6 +157,8 @@ func ListenExecutionEvents(wg *sync.WaitGroup) {
 					}
 				}
 				r.ScenarioStart(sce, e.ExecutionInfo, e.Result)
+			case event.SuiteStepsStart:
+				r.ScenarioStart(e.Item.(*gauge.Scenario), e.ExecutionInfo, e.Result)
 			case event.ConceptStart:
 				r.ConceptStart(formatter.FormatStep(e.Item.(*gauge.Step)))
 			case event.StepStart:
@@ -165,13 +167,14 @@ func ListenExecutionEvents(wg *sync.WaitGroup) {
 				r.StepEnd(e.Item.(gauge.Step), e.Result, e.ExecutionInfo)
 			case event.ConceptEnd:
 				r.ConceptEnd(e.Result)
-			case event.ScenarioEnd:
+			case event.ScenarioEnd, event.SuiteStepsEnd:
 				r.ScenarioEnd(e.Item.(*gauge.Scenario), e.Result, e.ExecutionInfo)
 			case event.SpecEnd:
 				r.SpecEnd(e.Item.(*gauge.Specification), e.Result)
 			case event.SuiteEnd:
 				r.SuiteEnd(e.Result)
 				wg.Done()
+				return
 			}
 		}
 	}()
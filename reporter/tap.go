@@ -0,0 +1,76 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package reporter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	gm "github.com/getgauge/gauge/gauge_messages"
+)
+
+const tapVersion = "TAP version 13"
+
+func writeTAP(w io.Writer, res *gm.ProtoSuiteResult, r retries) error {
+	var lines []string
+	n := 0
+	for _, s := range reportSuites(res, r) {
+		for _, c := range s.cases {
+			n++
+			lines = append(lines, tapCase(n, s, c)...)
+		}
+	}
+	lines = append([]string{tapVersion, fmt.Sprintf("1..%d", n)}, lines...)
+	_, err := io.WriteString(w, strings.Join(lines, newline)+newline)
+	return err
+}
+
+func tapCase(n int, s *reportSuite, c *reportCase) []string {
+	description := strings.Replace(fmt.Sprintf("%s - %s", s.name, c.name), "#", "\\#", -1)
+	switch c.status {
+	case caseSkipped:
+		return []string{fmt.Sprintf("ok %d - %s # SKIP %s", n, description, strings.Replace(c.message, newline, " ", -1))}
+	case caseFailed, caseErrored:
+		lines := []string{fmt.Sprintf("not ok %d - %s", n, description), "  ---"}
+		lines = append(lines, tapBlock("message", c.message)...)
+		if c.stackTrace != "" {
+			lines = append(lines, tapBlock("stacktrace", c.stackTrace)...)
+		}
+		if s.file != "" {
+			lines = append(lines, fmt.Sprintf("  file: %q", s.file))
+		}
+		if len(c.retries) > 0 {
+			lines = append(lines, fmt.Sprintf("  retries: %d", len(c.retries)))
+		}
+		return append(lines, "  ...")
+	}
+	line := fmt.Sprintf("ok %d - %s", n, description)
+	if len(c.retries) > 0 {
+		return []string{line, "  ---", fmt.Sprintf("  retries: %d", len(c.retries)), "  ..."}
+	}
+	return []string{line}
+}
+
+func tapBlock(key, value string) []string {
+	lines := []string{fmt.Sprintf("  %s: |-", key)}
+	for _, l := range strings.Split(strings.TrimRight(value, newline), newline) {
+		lines = append(lines, "    "+l)
+	}
+	return lines
+}
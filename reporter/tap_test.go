@@ -0,0 +1,62 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package reporter
+
+import (
+	"bytes"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestWriteTAP(c *C) {
+	res, retried := reportTestSuiteResult()
+	b := &bytes.Buffer{}
+
+	err := writeTAP(b, res, retries{retried: []string{"flaky"}})
+
+	c.Assert(err, IsNil)
+	c.Assert(b.String(), Equals, `TAP version 13
+1..5
+not ok 1 - Suite hooks - Before Suite
+  ---
+  message: |-
+    before suite failed
+  ...
+not ok 2 - Login - Fails
+  ---
+  message: |-
+    Step 'Login as admin' failed: wrong password
+  stacktrace: |-
+    stacktrace
+  file: "login.spec"
+  ...
+ok 3 - Login - Retried
+  ---
+  retries: 1
+  ...
+ok 4 - Login - Row [row 2] # SKIP Step implementation not found
+not ok 5 - Login - After Spec
+  ---
+  message: |-
+    after spec failed
+  stacktrace: |-
+    trace
+  file: "login.spec"
+  ...
+`)
+}
@@ -40,6 +40,7 @@ import (
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/manifest"
 	"github.com/getgauge/gauge/plugin"
+	"github.com/getgauge/gauge/util"
 	"github.com/getgauge/gauge/version"
 )
 
@@ -152,6 +153,8 @@ type RunnerInfo struct {
 	Multithreaded       bool
 	GaugeVersionSupport version.VersionSupport
 	LspLangId           string
+	// SpecialParamPrefixes tells if the runner answers the request for the prefixes of the special params it resolves
+	SpecialParamPrefixes bool
 }
 
 func ExecuteInitHookForRunner(language string) error {
@@ -206,6 +209,24 @@ func GetRunnerInfo(language string) (*RunnerInfo, error) {
 	return runnerInfo, nil
 }
 
+// SpecialParamPrefixes asks the runner for the prefixes of the special params it resolves itself. Only runners which
+// declare support for the request in their runner info are asked, a runner which ignores the request would keep gauge
+// waiting for the response until the runner request timeout. Other runners resolve no special params other than the
+// predefined ones.
+func SpecialParamPrefixes(r Runner, info *RunnerInfo) []string {
+	if info == nil || !info.SpecialParamPrefixes {
+		return nil
+	}
+	m := &gauge_messages.Message{MessageType: gauge_messages.Message_SpecialParamPrefixesRequest,
+		SpecialParamPrefixesRequest: &gauge_messages.SpecialParamPrefixesRequest{}}
+	res, err := r.ExecuteMessageWithTimeout(m)
+	if err != nil {
+		logger.Debugf(true, "Runner did not tell its special param prefixes: %s", err.Error())
+		return nil
+	}
+	return res.GetSpecialParamPrefixesResponse().GetPrefixes()
+}
+
 func (r *LanguageRunner) Alive() bool {
 	r.mutex.Lock()
 	ps := r.Cmd.ProcessState
@@ -328,7 +349,7 @@ func runRunnerCommand(manifest *manifest.Manifest, port string, debug bool, outp
 	env := getCleanEnv(port, os.Environ(), debug, getPluginPaths())
 	env = append(env, fmt.Sprintf("GAUGE_UNIQUE_INSTALLATION_ID=%s", config.UniqueID()))
 	env = append(env, fmt.Sprintf("GAUGE_TELEMETRY_ENABLED=%v", config.TelemetryEnabled()))
-	cmd, err := common.ExecuteCommandWithEnv(command, runnerDir, outputStreamWriter, outputStreamWriter, env)
+	cmd, err := util.ExecuteCommandWithEnv(command, runnerDir, outputStreamWriter, outputStreamWriter, env)
 	return cmd, &r, err
 }
 
@@ -378,6 +399,7 @@ func getLanguageJSONFilePath(manifest *manifest.Manifest, r *RunnerInfo) (string
 func (r *LanguageRunner) waitAndGetErrorMessage() {
 	go func() {
 		pState, err := r.Cmd.Process.Wait()
+		util.KillProcessGroup(r.Cmd.Process)
 		r.mutex.Lock()
 		r.Cmd.ProcessState = pState
 		r.mutex.Unlock()
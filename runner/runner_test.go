@@ -18,12 +18,15 @@
 package runner
 
 import (
+	"errors"
+	"net"
 	"os"
 	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/gauge_messages"
 )
 
 func TestGetCleanEnvRemovesGAUGE_INTERNAL_PORTAndSetsPortNumber(t *testing.T) {
@@ -57,3 +60,50 @@ func TestGetCleanEnvAddsToPath(t *testing.T) {
 		t.Errorf("getCleanEnv failed. Did not append to path.\n\tWanted PATH to contain: `%s`", want)
 	}
 }
+
+type mockRunner struct {
+	response *gauge_messages.Message
+	err      error
+}
+
+func (r *mockRunner) ExecuteAndGetStatus(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+	return nil
+}
+func (r *mockRunner) ExecuteMessageWithTimeout(m *gauge_messages.Message) (*gauge_messages.Message, error) {
+	return r.response, r.err
+}
+func (r *mockRunner) Alive() bool           { return true }
+func (r *mockRunner) Kill() error           { return nil }
+func (r *mockRunner) Connection() net.Conn  { return nil }
+func (r *mockRunner) IsMultithreaded() bool { return false }
+func (r *mockRunner) Pid() int              { return 0 }
+
+func TestSpecialParamPrefixes(t *testing.T) {
+	r := &mockRunner{response: &gauge_messages.Message{MessageType: gauge_messages.Message_SpecialParamPrefixesResponse,
+		SpecialParamPrefixesResponse: &gauge_messages.SpecialParamPrefixesResponse{Prefixes: []string{"vault"}}}}
+
+	got := SpecialParamPrefixes(r, &RunnerInfo{SpecialParamPrefixes: true})
+
+	if !reflect.DeepEqual(got, []string{"vault"}) {
+		t.Errorf("Expected special param prefixes [vault], got %v", got)
+	}
+}
+
+func TestSpecialParamPrefixesOfRunnerWhichDoesNotSupportTheRequest(t *testing.T) {
+	for _, r := range []*mockRunner{{err: errors.New("Unsupported message")}, {}} {
+		if got := SpecialParamPrefixes(r, &RunnerInfo{SpecialParamPrefixes: true}); len(got) != 0 {
+			t.Errorf("Expected no special param prefixes, got %v", got)
+		}
+	}
+}
+
+func TestSpecialParamPrefixesAreNotAskedToRunnerWhichDoesNotDeclareSupport(t *testing.T) {
+	r := &mockRunner{response: &gauge_messages.Message{MessageType: gauge_messages.Message_SpecialParamPrefixesResponse,
+		SpecialParamPrefixesResponse: &gauge_messages.SpecialParamPrefixesResponse{Prefixes: []string{"vault"}}}}
+
+	for _, info := range []*RunnerInfo{nil, {}} {
+		if got := SpecialParamPrefixes(r, info); len(got) != 0 {
+			t.Errorf("Expected no special param prefixes, got %v", got)
+		}
+	}
+}
@@ -49,6 +49,12 @@ csv_delimiter = ,
 
 # Allows steps to be written in multiline
 allow_multiline_step = false
+
+# Set to true to keep the results of the runs in .gauge/history, used by gauge history to show trends.
+save_execution_history = false
+
+# The path of the suite spec, relative to the project directory. Its contexts run once before the suite and its teardown steps once after it, also in a parallel run.
+suite_spec = specs/_suite.spec
 `
 var ExampleSpec = `# Specification Heading
 
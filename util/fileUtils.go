@@ -155,6 +155,24 @@ func FindAllNestedDirs(dir string) []string {
 	return nestedDirs
 }
 
+// ProjectDirs returns the project root and all its nested directories, except hidden directories
+// and the ones ignored by gauge, like reports and logs.
+func ProjectDirs() []string {
+	addIgnoredDirectories()
+	var dirs []string
+	filepath.Walk(config.ProjectRoot, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.IsDir() {
+			return nil
+		}
+		if path != config.ProjectRoot && (strings.HasPrefix(info.Name(), ".") || ignoredDirectories[path]) {
+			return filepath.SkipDir
+		}
+		dirs = append(dirs, path)
+		return nil
+	})
+	return dirs
+}
+
 // IsDir reports whether path describes a directory.
 func IsDir(path string) bool {
 	fileInfo, err := os.Stat(path)
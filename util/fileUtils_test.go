@@ -208,3 +208,16 @@ func createDirIn(dir string, dirName string) (string, error) {
 	err = os.Rename(tempDir, fullDirName)
 	return fullDirName, err
 }
+
+func (s *MySuite) TestProjectDirsSkipsHiddenAndIgnoredDirs(c *C) {
+	old := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = old }()
+	for _, d := range []string{"specs/nested", ".gauge", "reports/html", "logs"} {
+		c.Assert(os.MkdirAll(filepath.Join(dir, d), 0755), IsNil)
+	}
+
+	dirs := ProjectDirs()
+
+	c.Assert(dirs, DeepEquals, []string{dir, filepath.Join(dir, "specs"), filepath.Join(dir, "specs", "nested")})
+}
@@ -0,0 +1,95 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package util
+
+import (
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+
+	"github.com/getgauge/common"
+)
+
+var processGroups = struct {
+	sync.Mutex
+	enabled   bool
+	processes map[int]*os.Process
+}{processes: make(map[int]*os.Process)}
+
+// ExecuteCommandWithEnv executes the command after setting the given environment, like common.ExecuteCommandWithEnv.
+// While process groups are enabled, the process is executed in a process group of its own. An interrupt from the
+// terminal is then sent only to gauge, which stops the process once the execution is cancelled, instead of the process
+// exiting midway. A nil env keeps the environment of gauge.
+func ExecuteCommandWithEnv(command []string, workingDir string, outputStreamWriter io.Writer, errorStreamWriter io.Writer, env []string) (*exec.Cmd, error) {
+	processGroups.Lock()
+	defer processGroups.Unlock()
+	if !processGroups.enabled {
+		return common.ExecuteCommandWithEnv(command, workingDir, outputStreamWriter, errorStreamWriter, env)
+	}
+	cmd := common.GetExecutableCommand(false, command...)
+	cmd.Dir = workingDir
+	cmd.Stdout = outputStreamWriter
+	cmd.Stderr = errorStreamWriter
+	cmd.Stdin = os.Stdin
+	cmd.Env = env
+	setOwnProcessGroup(cmd)
+	if err := cmd.Start(); err != nil {
+		return cmd, err
+	}
+	processGroups.processes[cmd.Process.Pid] = cmd.Process
+	return cmd, nil
+}
+
+// EnableProcessGroups makes ExecuteCommandWithEnv execute the commands in process groups of their own, until the
+// returned function is called. The returned function kills the process groups that are still running.
+func EnableProcessGroups() func() {
+	processGroups.Lock()
+	processGroups.enabled = true
+	processGroups.Unlock()
+	return func() {
+		processGroups.Lock()
+		processGroups.enabled = false
+		processGroups.Unlock()
+		KillProcessGroups()
+	}
+}
+
+// KillProcessGroup kills the process group of a process executed in a process group of its own and forgets the process.
+// It is called once the process has exited too, so that the processes it started do not outlive it. It does nothing
+// for other processes.
+func KillProcessGroup(p *os.Process) {
+	processGroups.Lock()
+	defer processGroups.Unlock()
+	if processGroups.processes[p.Pid] != p {
+		return
+	}
+	killProcessGroup(p)
+	delete(processGroups.processes, p.Pid)
+}
+
+// KillProcessGroups kills the process groups of all the processes executed in a process group of their own, which
+// have not been killed yet.
+func KillProcessGroups() {
+	processGroups.Lock()
+	defer processGroups.Unlock()
+	for pid, p := range processGroups.processes {
+		killProcessGroup(p)
+		delete(processGroups.processes, pid)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+// +build !windows
+
+package util
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+	"testing"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+// TestProcessGroupHelper stands for gauge in TestProcessInOwnProcessGroupIsNotInterruptedFromTerminal, it is executed by the test.
+func TestProcessGroupHelper(t *testing.T) {
+	if os.Getenv("GAUGE_TEST_PROCESS_GROUP_HELPER") != "1" {
+		return
+	}
+	interrupted := make(chan os.Signal, 1)
+	signal.Notify(interrupted, os.Interrupt)
+	sleep, _ := exec.LookPath("sleep")
+	killProcessGroups := EnableProcessGroups()
+	cmd, err := ExecuteCommandWithEnv([]string{sleep, "30"}, "", os.Stdout, os.Stderr, nil)
+	if err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+	exited := make(chan bool)
+	go func() {
+		cmd.Wait()
+		close(exited)
+	}()
+	fmt.Println("started")
+	<-interrupted
+	select {
+	case <-exited:
+		fmt.Println("interrupted")
+	case <-time.After(200 * time.Millisecond):
+		fmt.Println("running")
+	}
+	killProcessGroups()
+	<-exited
+	fmt.Println("killed")
+	os.Exit(0)
+}
+
+func (s *MySuite) TestProcessInOwnProcessGroupIsNotInterruptedFromTerminal(c *C) {
+	helper := exec.Command(os.Args[0], "-test.run=^TestProcessGroupHelper$")
+	helper.Env = append(os.Environ(), "GAUGE_TEST_PROCESS_GROUP_HELPER=1")
+	// the helper's process group stands for the foreground process group of the terminal, which gets its interrupts
+	helper.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	out, err := helper.StdoutPipe()
+	c.Assert(err, IsNil)
+	c.Assert(helper.Start(), IsNil)
+	timer := time.AfterFunc(10*time.Second, func() { syscall.Kill(-helper.Process.Pid, syscall.SIGKILL) })
+	defer timer.Stop()
+	lines := bufio.NewScanner(out)
+	c.Assert(lines.Scan(), Equals, true)
+	c.Assert(lines.Text(), Equals, "started")
+
+	c.Assert(syscall.Kill(-helper.Process.Pid, syscall.SIGINT), IsNil)
+
+	var got []string
+	for lines.Scan() {
+		got = append(got, lines.Text())
+	}
+	c.Assert(helper.Wait(), IsNil)
+	c.Assert(got, DeepEquals, []string{"running", "killed"})
+}
+
+func (s *MySuite) TestProcessIsForgottenOnceItsProcessGroupIsKilled(c *C) {
+	killProcessGroups := EnableProcessGroups()
+	defer killProcessGroups()
+	sleep, _ := exec.LookPath("sleep")
+	cmd, err := ExecuteCommandWithEnv([]string{sleep, "30"}, "", os.Stdout, os.Stderr, nil)
+	c.Assert(err, IsNil)
+	pgid, err := syscall.Getpgid(cmd.Process.Pid)
+	c.Assert(err, IsNil)
+	c.Assert(pgid, Equals, cmd.Process.Pid)
+
+	KillProcessGroup(cmd.Process)
+
+	c.Assert(cmd.Wait(), NotNil)
+	_, running := processGroups.processes[cmd.Process.Pid]
+	c.Assert(running, Equals, false)
+}
+
+func (s *MySuite) TestProcessIsInGaugeProcessGroupUnlessProcessGroupsAreEnabled(c *C) {
+	sleep, _ := exec.LookPath("sleep")
+	cmd, err := ExecuteCommandWithEnv([]string{sleep, "30"}, "", os.Stdout, os.Stderr, nil)
+	c.Assert(err, IsNil)
+	defer cmd.Process.Kill()
+
+	pgid, err := syscall.Getpgid(cmd.Process.Pid)
+	c.Assert(err, IsNil)
+	c.Assert(pgid, Equals, syscall.Getpgrp())
+	c.Assert(cmd.Stdin, Equals, os.Stdin)
+}
@@ -0,0 +1,151 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package validation
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/getgauge/gauge/gauge"
+	gm "github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/runner"
+	"github.com/getgauge/gauge/util"
+)
+
+// ReportUnused is used to decide whether unused step implementations and concepts should be reported or not based on the flag : --unused.
+var ReportUnused bool
+
+// UsedSteps returns the values of the steps and the concepts used by the specs, including the ones used within concepts.
+func UsedSteps(specs []*gauge.Specification) (steps map[string]bool, concepts map[string]bool) {
+	steps, concepts = make(map[string]bool), make(map[string]bool)
+	var addSteps func([]*gauge.Step)
+	addSteps = func(s []*gauge.Step) {
+		for _, step := range s {
+			if step.IsConcept {
+				concepts[step.Value] = true
+				addSteps(step.ConceptSteps)
+				continue
+			}
+			steps[step.Value] = true
+		}
+	}
+	for _, spec := range specs {
+		addSteps(spec.Contexts)
+		for _, scn := range spec.Scenarios {
+			addSteps(scn.Steps)
+		}
+		addSteps(spec.TearDownSteps)
+	}
+	return steps, concepts
+}
+
+// UnusedStepImplementations returns the step values of the step texts, as given by the runner, which are not used.
+func UnusedStepImplementations(stepNames []string, usedSteps map[string]bool) []*gauge.StepValue {
+	unused := make([]*gauge.StepValue, 0)
+	for _, name := range stepNames {
+		stepValue, err := parser.ExtractStepValueAndParams(name, false)
+		if err != nil || usedSteps[stepValue.StepValue] {
+			continue
+		}
+		unused = append(unused, stepValue)
+	}
+	sort.Slice(unused, func(i, j int) bool { return unused[i].StepValue < unused[j].StepValue })
+	return unused
+}
+
+// UnusedConcepts returns the concepts in the dictionary which are not used, ordered by file and line.
+func UnusedConcepts(dict *gauge.ConceptDictionary, usedConcepts map[string]bool) []*gauge.Concept {
+	unused := make([]*gauge.Concept, 0)
+	for value, c := range dict.ConceptsMap {
+		if !usedConcepts[value] && !isCalledByShortForm(c, usedConcepts) {
+			unused = append(unused, c)
+		}
+	}
+	sort.Slice(unused, func(i, j int) bool {
+		if unused[i].FileName != unused[j].FileName {
+			return unused[i].FileName < unused[j].FileName
+		}
+		return unused[i].ConceptStep.LineNo < unused[j].ConceptStep.LineNo
+	})
+	return unused
+}
+
+func isCalledByShortForm(c *gauge.Concept, usedConcepts map[string]bool) bool {
+	for _, form := range c.ShortForms() {
+		if usedConcepts[form] {
+			return true
+		}
+	}
+	return false
+}
+
+func getStepNames(r runner.Runner) ([]string, error) {
+	m := &gm.Message{MessageType: gm.Message_StepNamesRequest, StepNamesRequest: &gm.StepNamesRequest{}}
+	res, err := r.ExecuteMessageWithTimeout(m)
+	if err != nil {
+		return nil, err
+	}
+	if res.GetMessageType() != gm.Message_StepNamesResponse {
+		return nil, fmt.Errorf("Invalid response from runner for step names request")
+	}
+	return res.GetStepNamesResponse().GetSteps(), nil
+}
+
+// reportUnused prints the step implementations and concepts which are not used by any spec of the project.
+// All specs in the spec directories are scanned, not only the validated ones, as steps used by specs outside
+// of the validated directories are not unused.
+func reportUnused(res *ValidationResult) {
+	stepNames, err := getStepNames(res.Runner)
+	if err != nil {
+		logger.Errorf(true, "Unable to get step implementations from runner : %s", err.Error())
+		return
+	}
+	usedSteps, usedConcepts := UsedSteps(projectSpecs(res))
+	unusedSteps := UnusedStepImplementations(stepNames, usedSteps)
+	unusedConcepts := UnusedConcepts(res.ConceptDictionary, usedConcepts)
+	for _, s := range unusedSteps {
+		logger.Warningf(true, "[Unused] Step implementation is not used by any spec => '%s'", s.ParameterizedStepValue)
+	}
+	for _, c := range unusedConcepts {
+		logger.Warningf(true, "[Unused] %s:%d Concept is not used by any spec => '%s'", util.RelPathToProjectRoot(c.FileName), c.ConceptStep.LineNo, c.ConceptStep.LineText)
+	}
+	if len(unusedSteps) == 0 && len(unusedConcepts) == 0 {
+		logger.Infof(true, "No unused step implementations or concepts found.")
+	}
+}
+
+// projectSpecs gives the validated specs along with the other specs in the spec directories and the suite spec.
+func projectSpecs(res *ValidationResult) []*gauge.Specification {
+	specs := res.SpecCollection.Specs()
+	validated := make(map[string]bool)
+	for _, spec := range specs {
+		validated[spec.FileName] = true
+	}
+	others, _ := parser.ParseSpecFiles(util.GetSpecFiles(util.GetSpecDirs()), res.ConceptDictionary, gauge.NewBuildErrors())
+	for _, spec := range others {
+		if !validated[spec.FileName] {
+			specs = append(specs, spec)
+		}
+	}
+	if res.SuiteSpec != nil {
+		specs = append(specs, res.SuiteSpec)
+	}
+	return specs
+}
@@ -0,0 +1,78 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package validation
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestUnusedStepImplementationsAndConcepts(c *C) {
+	conceptDictionary := gauge.NewConceptDictionary()
+	cpts, _ := new(parser.ConceptParser).Parse(`# login as <user>
+* enter user <user>
+
+# logout
+* click logout
+`, "login.cpt")
+	parser.AddConcept(cpts, "login.cpt", conceptDictionary)
+	specText := `Specification Heading
+=====================
+* open app
+
+Scenario 1
+----------
+* login as "bob"
+`
+	spec, _, _ := new(parser.SpecParser).Parse(specText, conceptDictionary, "")
+
+	usedSteps, usedConcepts := UsedSteps([]*gauge.Specification{spec})
+	unusedSteps := UnusedStepImplementations([]string{"open app", "enter user <name>", "click logout", "close app"}, usedSteps)
+	unusedConcepts := UnusedConcepts(conceptDictionary, usedConcepts)
+
+	c.Assert(len(unusedSteps), Equals, 2)
+	c.Assert(unusedSteps[0].StepValue, Equals, "click logout")
+	c.Assert(unusedSteps[1].StepValue, Equals, "close app")
+	c.Assert(len(unusedConcepts), Equals, 1)
+	c.Assert(unusedConcepts[0].ConceptStep.Value, Equals, "logout")
+}
+
+func (s *MySuite) TestProjectSpecsIncludeSpecsOutsideOfValidatedDirectories(c *C) {
+	dir, err := ioutil.TempDir("", "unused")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	c.Assert(os.MkdirAll(filepath.Join(dir, "specs", "sub"), 0755), IsNil)
+	c.Assert(ioutil.WriteFile(filepath.Join(dir, "specs", "a.spec"), []byte("# A\n## S\n* open app\n"), 0644), IsNil)
+	c.Assert(ioutil.WriteFile(filepath.Join(dir, "specs", "sub", "b.spec"), []byte("# B\n## S\n* close app\n"), 0644), IsNil)
+	wd, _ := os.Getwd()
+	c.Assert(os.Chdir(dir), IsNil)
+	defer os.Chdir(wd)
+	dict := gauge.NewConceptDictionary()
+	validated, _ := parser.ParseSpecFiles([]string{filepath.Join(dir, "specs", "sub", "b.spec")}, dict, gauge.NewBuildErrors())
+	res := &ValidationResult{SpecCollection: gauge.NewSpecCollection(validated, false), ConceptDictionary: dict}
+
+	usedSteps, _ := UsedSteps(projectSpecs(res))
+
+	c.Assert(usedSteps, DeepEquals, map[string]bool{"open app": true, "close app": true})
+}
@@ -37,6 +37,7 @@ import (
 	"github.com/getgauge/gauge/gauge"
 	gm "github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/manifest"
 	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/reporter"
 	"github.com/getgauge/gauge/runner"
@@ -125,6 +126,9 @@ func Validate(args []string) {
 	if len(res.Errs) > 0 {
 		os.Exit(1)
 	}
+	if ReportUnused {
+		reportUnused(res)
+	}
 	if res.SpecCollection.Size() < 1 {
 		logger.Infof(true, "No specifications found in %s.", strings.Join(args, ", "))
 		res.Runner.Kill()
@@ -153,11 +157,14 @@ func startAPI(debug bool) runner.Runner {
 }
 
 type ValidationResult struct {
-	SpecCollection *gauge.SpecCollection
-	ErrMap         *gauge.BuildErrors
-	Runner         runner.Runner
-	Errs           []error
-	ParseOk        bool
+	SpecCollection    *gauge.SpecCollection
+	ErrMap            *gauge.BuildErrors
+	Runner            runner.Runner
+	Errs              []error
+	ParseOk           bool
+	ConceptDictionary *gauge.ConceptDictionary
+	// SuiteSpec is the spec whose contexts run before the suite and whose teardown steps run after it, if any.
+	SuiteSpec *gauge.Specification
 }
 
 // NewValidationResult creates a new Validation result
@@ -171,22 +178,66 @@ func ValidateSpecs(args []string, debug bool) *ValidationResult {
 	if err != nil {
 		logger.Fatalf(true, "Unable to validate : %s", err.Error())
 	}
+	r := startAPI(debug)
+	registerSpecialParamPrefixes(r)
 	errMap := gauge.NewBuildErrors()
 	s, specsFailed := parser.ParseSpecs(args, conceptDict, errMap)
-	r := startAPI(debug)
-	vErrs := NewValidator(s, r, conceptDict).Validate()
+	suiteSpec, suiteSpecFailed := parser.ParseSuiteSpec(conceptDict)
+	vRes := validateSpecs(s, suiteSpec, specsFailed, suiteSpecFailed, conceptDict, res, errMap, r)
+	if !res.Ok || suiteSpecFailed {
+		r.Kill()
+	}
+	return vRes
+}
+
+// registerSpecialParamPrefixes registers the prefixes of the special params resolved by the runner of the project.
+func registerSpecialParamPrefixes(r runner.Runner) {
+	m, err := manifest.ProjectManifest()
+	if err != nil {
+		return
+	}
+	info, err := runner.GetRunnerInfo(m.Language)
+	if err != nil {
+		logger.Debugf(true, "Unable to read the runner info of %s: %s", m.Language, err.Error())
+		return
+	}
+	parser.RegisterSpecialParamPrefixes(runner.SpecialParamPrefixes(r, info)...)
+}
+
+// ValidateSpecsWithRunner parses and validates the specs using an already started runner.
+// The runner is left running, even if parsing fails.
+func ValidateSpecsWithRunner(args []string, r runner.Runner) *ValidationResult {
+	conceptDict, res, err := parser.ParseConcepts()
+	if err != nil {
+		return NewValidationResult(nil, nil, nil, false, err)
+	}
+	registerSpecialParamPrefixes(r)
+	errMap := gauge.NewBuildErrors()
+	s, specsFailed := parser.ParseSpecs(args, conceptDict, errMap)
+	suiteSpec, suiteSpecFailed := parser.ParseSuiteSpec(conceptDict)
+	return validateSpecs(s, suiteSpec, specsFailed, suiteSpecFailed, conceptDict, res, errMap, r)
+}
+
+func validateSpecs(s []*gauge.Specification, suiteSpec *gauge.Specification, specsFailed, suiteSpecFailed bool, conceptDict *gauge.ConceptDictionary, res *parser.ParseResult, errMap *gauge.BuildErrors, r runner.Runner) *ValidationResult {
+	toValidate := s
+	if suiteSpec != nil {
+		toValidate = append([]*gauge.Specification{suiteSpec}, s...)
+	}
+	vErrs := NewValidator(toValidate, r, conceptDict).Validate()
 	errMap = getErrMap(errMap, vErrs)
 	s = parser.GetSpecsForDataTableRows(s, errMap)
 	printValidationFailures(vErrs)
 	showSuggestion(vErrs)
 	if !res.Ok {
-		r.Kill()
 		return NewValidationResult(nil, nil, nil, false, errors.New("Parsing failed."))
 	}
-	if specsFailed {
-		return NewValidationResult(gauge.NewSpecCollection(s, false), errMap, r, false)
+	if suiteSpecFailed {
+		return NewValidationResult(nil, nil, nil, false, errors.New("Parsing the suite spec failed."))
 	}
-	return NewValidationResult(gauge.NewSpecCollection(s, false), errMap, r, true)
+	vRes := NewValidationResult(gauge.NewSpecCollection(s, false), errMap, r, !specsFailed)
+	vRes.ConceptDictionary = conceptDict
+	vRes.SuiteSpec = suiteSpec
+	return vRes
 }
 
 func getErrMap(errMap *gauge.BuildErrors, validationErrors validationErrors) *gauge.BuildErrors {
@@ -395,12 +446,31 @@ func (v *SpecValidator) DataTable(dataTable *gauge.DataTable) {
 // Validates data table for the range, if any error found append to the validation errors
 func (v *SpecValidator) Specification(specification *gauge.Specification) {
 	v.validationErrors = make([]error, 0)
-	err := validateDataTableRange(specification.DataTable.Table.GetRowCount())
+	// The suite spec has no data tables and is not executed as a spec, --table-rows does not apply to it.
+	if parser.IsSuiteSpec(specification.FileName) {
+		return
+	}
+	err := validateDataTableRange(dataTableRowCount(specification))
 	if err != nil {
 		v.validationErrors = append(v.validationErrors, NewSpecValidationError(err.Error(), specification.FileName))
 	}
 }
 
+// dataTableRowCount gives the number of rows of the spec data table. In specs without one, --table-rows selects the rows
+// of the scenario data tables and the largest of them is given.
+func dataTableRowCount(spec *gauge.Specification) int {
+	if spec.DataTable.IsInitialized() {
+		return spec.DataTable.Table.GetRowCount()
+	}
+	count := 0
+	for _, scn := range spec.Scenarios {
+		if n := scn.DataTable.Table.GetRowCount(); n > count {
+			count = n
+		}
+	}
+	return count
+}
+
 func validateDataTableRange(rowCount int) error {
 	if TableRows == "" {
 		return nil
@@ -19,6 +19,8 @@ package validation
 
 import (
 	"net"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/getgauge/gauge/gauge"
@@ -282,6 +284,30 @@ func (s *MySuite) TestToValidateDataTableRowsRangeFromInputFlag(c *C) {
 	}
 }
 
+func (s *MySuite) TestTableRowsAreNotValidatedForSuiteSpec(c *C) {
+	old := os.Getenv("suite_spec")
+	defer os.Setenv("suite_spec", old)
+	defer func() { TableRows = "" }()
+	specText := `Suite
+=====
+* say hello
+`
+	file, _ := filepath.Abs(filepath.Join("specs", "_suite.spec"))
+	os.Setenv("suite_spec", file)
+	TableRows = "1"
+	spec, _, _ := new(parser.SpecParser).Parse(specText, gauge.NewConceptDictionary(), file)
+	v := &SpecValidator{specification: spec}
+
+	v.Specification(spec)
+
+	c.Assert(v.validationErrors, HasLen, 0)
+
+	os.Setenv("suite_spec", "")
+	v.Specification(spec)
+
+	c.Assert(v.validationErrors, HasLen, 1)
+}
+
 type mockRunner struct {
 	ExecuteMessageFunc func(m *gauge_messages.Message) (*gauge_messages.Message, error)
 }